@@ -0,0 +1,77 @@
+// Copyright IBM Corp. 2023, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package timetypes
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+var (
+	_ basetypes.StringTypable = (*ASN1GeneralizedTimeType)(nil)
+)
+
+// ASN1GeneralizedTimeType is an attribute type that represents a valid ASN.1 GeneralizedTime string, as used by X.509
+// certificates, such as `20261016120000Z`. Semantic equality logic is defined for ASN1GeneralizedTimeType such that
+// values representing the same instant as an ASN1UTCTime or RFC3339 value are considered equal.
+type ASN1GeneralizedTimeType struct {
+	basetypes.StringType
+}
+
+// String returns a human-readable string of the type name.
+func (t ASN1GeneralizedTimeType) String() string {
+	return "timetypes.ASN1GeneralizedTimeType"
+}
+
+// ValueType returns the Value type.
+func (t ASN1GeneralizedTimeType) ValueType(ctx context.Context) attr.Value {
+	return ASN1GeneralizedTime{}
+}
+
+// Equal returns true if the given type is equivalent.
+func (t ASN1GeneralizedTimeType) Equal(o attr.Type) bool {
+	other, ok := o.(ASN1GeneralizedTimeType)
+
+	if !ok {
+		return false
+	}
+
+	return t.StringType.Equal(other.StringType)
+}
+
+// ValueFromString returns a StringValuable type given a StringValue.
+func (t ASN1GeneralizedTimeType) ValueFromString(ctx context.Context, in basetypes.StringValue) (basetypes.StringValuable, diag.Diagnostics) {
+	return ASN1GeneralizedTime{
+		StringValue: in,
+	}, nil
+}
+
+// ValueFromTerraform returns a Value given a tftypes.Value.  This is meant to convert the tftypes.Value into a more convenient Go type
+// for the provider to consume the data with.
+func (t ASN1GeneralizedTimeType) ValueFromTerraform(ctx context.Context, in tftypes.Value) (attr.Value, error) {
+	attrValue, err := t.StringType.ValueFromTerraform(ctx, in)
+
+	if err != nil {
+		return nil, err
+	}
+
+	stringValue, ok := attrValue.(basetypes.StringValue)
+
+	if !ok {
+		return nil, fmt.Errorf("unexpected value type of %T", attrValue)
+	}
+
+	stringValuable, diags := t.ValueFromString(ctx, stringValue)
+
+	if diags.HasError() {
+		return nil, fmt.Errorf("unexpected error converting StringValue to StringValuable: %v", diags)
+	}
+
+	return stringValuable, nil
+}
@@ -0,0 +1,376 @@
+// Copyright IBM Corp. 2023, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package timetypes
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/attr/xattr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+)
+
+var (
+	_ basetypes.StringValuableWithSemanticEquals = (*ASN1GeneralizedTime)(nil)
+	_ xattr.ValidateableAttribute                = (*ASN1GeneralizedTime)(nil)
+	_ function.ValidateableParameter             = (*ASN1GeneralizedTime)(nil)
+)
+
+// asn1GeneralizedTimeLayout is the time.Time layout of a DER encoded GeneralizedTime, which omits trailing zeros of
+// fractional seconds and the decimal separator when there are no fractional seconds.
+const asn1GeneralizedTimeLayout = "20060102150405.999999999Z"
+
+// ASN1GeneralizedTime represents a valid ASN.1 GeneralizedTime string in the DER encoding used by X.509 certificates
+// (RFC 5280), such as `20261016120000Z`. The format is `YYYYMMDDHHMMSS[.fff]Z`, which requires seconds and the `Z`
+// suffix. Fractional seconds are optional, but must use a `.` decimal separator and must not have trailing zeros.
+// Semantic equality logic is defined for ASN1GeneralizedTime such that a value is equal to an ASN1UTCTime or RFC3339
+// value representing the same instant.
+type ASN1GeneralizedTime struct {
+	basetypes.StringValue
+}
+
+// Type returns an ASN1GeneralizedTimeType.
+func (v ASN1GeneralizedTime) Type(_ context.Context) attr.Type {
+	return ASN1GeneralizedTimeType{}
+}
+
+// Equal returns true if the given value is equivalent.
+func (v ASN1GeneralizedTime) Equal(o attr.Value) bool {
+	other, ok := o.(ASN1GeneralizedTime)
+
+	if !ok {
+		return false
+	}
+
+	return v.StringValue.Equal(other.StringValue)
+}
+
+// StringSemanticEquals returns true if the given ASN1GeneralizedTime, ASN1UTCTime or RFC3339 string value is
+// semantically equal to the current ASN1GeneralizedTime string value. An RFC3339 value must have a `Z` or `00:00` UTC
+// offset to be considered equal, as GeneralizedTime values are always UTC.
+//
+// Examples:
+//   - `20261016120000Z` is semantically equal to `261016120000Z`
+//   - `20261016120000.5Z` is semantically equal to `2026-10-16T12:00:00.5Z`
+//
+// Counterexamples:
+//   - `20261016120000Z` expresses the same time as `2026-10-16T14:00:00+02:00` but is NOT considered to be
+//     semantically equal.
+func (v ASN1GeneralizedTime) StringSemanticEquals(_ context.Context, newValuable basetypes.StringValuable) (bool, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	var newTime time.Time
+
+	// ASN.1 and RFC3339 strings are already validated at this point, ignoring errors
+	switch newValue := newValuable.(type) {
+	case ASN1GeneralizedTime:
+		newTime, _ = parseASN1GeneralizedTime(newValue.ValueString())
+	case ASN1UTCTime:
+		newTime, _ = parseASN1UTCTime(newValue.ValueString())
+	case RFC3339:
+		newTime, _ = time.Parse(time.RFC3339, newValue.ValueString())
+	default:
+		diags.AddError(
+			"Semantic Equality Check Error",
+			"An unexpected value type was received while performing semantic equality checks. "+
+				"Please report this to the provider developers.\n\n"+
+				"Expected Value Type: "+fmt.Sprintf("%T", v)+"\n"+
+				"Got Value Type: "+fmt.Sprintf("%T", newValuable),
+		)
+
+		return false, diags
+	}
+
+	currentTime, _ := parseASN1GeneralizedTime(v.ValueString())
+
+	_, newOffset := newTime.Zone()
+
+	return currentTime.Equal(newTime) && newOffset == 0, diags
+}
+
+// ValidateAttribute implements attribute value validation. This type requires the value to be a String value that
+// is valid DER encoded ASN.1 GeneralizedTime format.
+func (v ASN1GeneralizedTime) ValidateAttribute(ctx context.Context, req xattr.ValidateAttributeRequest, resp *xattr.ValidateAttributeResponse) {
+	if v.IsUnknown() || v.IsNull() {
+		return
+	}
+
+	if _, err := parseASN1GeneralizedTime(v.ValueString()); err != nil {
+		resp.Diagnostics.Append(diag.WithPath(req.Path, asn1GeneralizedTimeInvalidStringDiagnostic(v.ValueString(), err)))
+
+		return
+	}
+}
+
+// ValidateParameter implements provider-defined function parameter value validation. This type requires the value to
+// be a String value that is valid DER encoded ASN.1 GeneralizedTime format.
+func (v ASN1GeneralizedTime) ValidateParameter(ctx context.Context, req function.ValidateParameterRequest, resp *function.ValidateParameterResponse) {
+	if v.IsUnknown() || v.IsNull() {
+		return
+	}
+
+	if _, err := parseASN1GeneralizedTime(v.ValueString()); err != nil {
+		resp.Error = function.NewArgumentFuncError(
+			req.Position,
+			"Invalid ASN.1 GeneralizedTime String Value: "+
+				"A string value was provided that is not valid DER encoded ASN.1 GeneralizedTime string format, such as \"20261016120000Z\".\n\n"+
+				"Given Value: "+v.ValueString()+"\n"+
+				"Error: "+err.Error(),
+		)
+
+		return
+	}
+}
+
+// ValueRFC3339Time creates a new time.Time instance in UTC with the ASN1GeneralizedTime StringValue. A null or unknown
+// value will produce an error diagnostic.
+func (v ASN1GeneralizedTime) ValueRFC3339Time() (time.Time, diag.Diagnostics) {
+	return v.valueASN1GeneralizedTime("ValueRFC3339Time")
+}
+
+// ToRFC3339Value converts the ASN1GeneralizedTime to an RFC3339 value, such as `2026-10-16T12:00:00Z` for
+// `20261016120000Z`. Fractional seconds are preserved. Null and unknown values are converted to null and unknown RFC3339
+// values respectively.
+func (v ASN1GeneralizedTime) ToRFC3339Value() (RFC3339, diag.Diagnostics) {
+	if v.IsNull() {
+		return NewRFC3339Null(), nil
+	}
+
+	if v.IsUnknown() {
+		return NewRFC3339Unknown(), nil
+	}
+
+	t, diags := v.valueASN1GeneralizedTime("ToRFC3339Value")
+	if diags.HasError() {
+		return NewRFC3339Unknown(), diags
+	}
+
+	return newRFC3339TimeNanoValue(t), nil
+}
+
+// ToASN1UTCTimeValue converts the ASN1GeneralizedTime to an ASN1UTCTime value, such as `261016120000Z` for
+// `20261016120000Z`. Any fractional seconds are truncated, as UTCTime has a precision of seconds. A time outside of the
+// years 1950 through 2049 will produce an error diagnostic. Null and unknown values are converted to null and unknown
+// ASN1UTCTime values respectively.
+func (v ASN1GeneralizedTime) ToASN1UTCTimeValue() (ASN1UTCTime, diag.Diagnostics) {
+	if v.IsNull() {
+		return NewASN1UTCTimeNull(), nil
+	}
+
+	if v.IsUnknown() {
+		return NewASN1UTCTimeUnknown(), nil
+	}
+
+	t, diags := v.valueASN1GeneralizedTime("ToASN1UTCTimeValue")
+	if diags.HasError() {
+		return NewASN1UTCTimeUnknown(), diags
+	}
+
+	utcTime, err := formatASN1UTCTime(t)
+	if err != nil {
+		diags.AddError("ASN1GeneralizedTime ToASN1UTCTimeValue Error", err.Error())
+		return NewASN1UTCTimeUnknown(), diags
+	}
+
+	return ASN1UTCTime{
+		StringValue: basetypes.NewStringValue(utcTime),
+	}, nil
+}
+
+// valueASN1GeneralizedTime returns the parsed ASN1GeneralizedTime, with error diagnostics summarized by the given
+// accessor method name.
+func (v ASN1GeneralizedTime) valueASN1GeneralizedTime(accessor string) (time.Time, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	summary := "ASN1GeneralizedTime " + accessor + " Error"
+
+	if v.IsNull() {
+		diags.Append(diag.NewErrorDiagnostic(summary, "ASN.1 GeneralizedTime string value is null"))
+		return time.Time{}, diags
+	}
+
+	if v.IsUnknown() {
+		diags.Append(diag.NewErrorDiagnostic(summary, "ASN.1 GeneralizedTime string value is unknown"))
+		return time.Time{}, diags
+	}
+
+	t, err := parseASN1GeneralizedTime(v.ValueString())
+	if err != nil {
+		diags.Append(diag.NewErrorDiagnostic(summary, err.Error()))
+		return time.Time{}, diags
+	}
+
+	return t, nil
+}
+
+// NewASN1GeneralizedTimeNull creates an ASN1GeneralizedTime with a null value. Determine whether the value is null via IsNull method.
+func NewASN1GeneralizedTimeNull() ASN1GeneralizedTime {
+	return ASN1GeneralizedTime{
+		StringValue: basetypes.NewStringNull(),
+	}
+}
+
+// NewASN1GeneralizedTimeUnknown creates an ASN1GeneralizedTime with an unknown value. Determine whether the value is unknown via IsUnknown method.
+func NewASN1GeneralizedTimeUnknown() ASN1GeneralizedTime {
+	return ASN1GeneralizedTime{
+		StringValue: basetypes.NewStringUnknown(),
+	}
+}
+
+// NewASN1GeneralizedTimeTimeValue creates an ASN1GeneralizedTime with a known value of the time.Time in UTC, in DER
+// encoded GeneralizedTime format.
+func NewASN1GeneralizedTimeTimeValue(value time.Time) ASN1GeneralizedTime {
+	return ASN1GeneralizedTime{
+		StringValue: basetypes.NewStringValue(value.UTC().Format(asn1GeneralizedTimeLayout)),
+	}
+}
+
+// NewASN1GeneralizedTimeTimePointerValue creates an ASN1GeneralizedTime with a null value if nil or
+// a known value of the time.Time in UTC, in DER encoded GeneralizedTime format.
+func NewASN1GeneralizedTimeTimePointerValue(value *time.Time) ASN1GeneralizedTime {
+	if value == nil {
+		return NewASN1GeneralizedTimeNull()
+	}
+
+	return NewASN1GeneralizedTimeTimeValue(*value)
+}
+
+// NewASN1GeneralizedTimeValue creates an ASN1GeneralizedTime with a known value or raises an error
+// diagnostic if the string is not DER encoded ASN.1 GeneralizedTime format.
+func NewASN1GeneralizedTimeValue(value string) (ASN1GeneralizedTime, diag.Diagnostics) {
+	_, err := parseASN1GeneralizedTime(value)
+
+	if err != nil {
+		// Returning an unknown value will guarantee that, as a last resort,
+		// Terraform will return an error if attempting to store into state.
+		return NewASN1GeneralizedTimeUnknown(), diag.Diagnostics{asn1GeneralizedTimeInvalidStringDiagnostic(value, err)}
+	}
+
+	return ASN1GeneralizedTime{
+		StringValue: basetypes.NewStringValue(value),
+	}, nil
+}
+
+// NewASN1GeneralizedTimeValueMust creates an ASN1GeneralizedTime with a known value or raises a panic
+// if the string is not DER encoded ASN.1 GeneralizedTime format.
+//
+// This creation function is only recommended to create ASN1GeneralizedTime values which
+// either will not potentially affect practitioners, such as testing, or within
+// exhaustively tested provider logic.
+func NewASN1GeneralizedTimeValueMust(value string) ASN1GeneralizedTime {
+	_, err := parseASN1GeneralizedTime(value)
+
+	if err != nil {
+		panic(fmt.Sprintf("Invalid ASN.1 GeneralizedTime String Value (%s): %s", value, err))
+	}
+
+	return ASN1GeneralizedTime{
+		StringValue: basetypes.NewStringValue(value),
+	}
+}
+
+// NewASN1GeneralizedTimePointerValue creates an ASN1GeneralizedTime with a null value if nil, a known
+// value, or raises an error diagnostic if the string is not DER encoded ASN.1 GeneralizedTime
+// format.
+func NewASN1GeneralizedTimePointerValue(value *string) (ASN1GeneralizedTime, diag.Diagnostics) {
+	if value == nil {
+		return NewASN1GeneralizedTimeNull(), nil
+	}
+
+	return NewASN1GeneralizedTimeValue(*value)
+}
+
+// NewASN1GeneralizedTimePointerValueMust creates an ASN1GeneralizedTime with a null value if nil, a
+// known value, or raises a panic if the string is not DER encoded ASN.1 GeneralizedTime format.
+//
+// This creation function is only recommended to create ASN1GeneralizedTime values which
+// either will not potentially affect practitioners, such as testing, or within
+// exhaustively tested provider logic.
+func NewASN1GeneralizedTimePointerValueMust(value *string) ASN1GeneralizedTime {
+	if value == nil {
+		return NewASN1GeneralizedTimeNull()
+	}
+
+	return NewASN1GeneralizedTimeValueMust(*value)
+}
+
+// parseASN1GeneralizedTime parses a DER encoded GeneralizedTime string of the format `YYYYMMDDHHMMSS[.fff]Z`,
+// returning the time in UTC.
+func parseASN1GeneralizedTime(value string) (time.Time, error) {
+	year, rest, err := parseFixedDigits(value, 4, "year", 0, 9999)
+	if err != nil {
+		return time.Time{}, err
+	}
+
+	return parseASN1Time(year, rest, true)
+}
+
+// parseASN1Time parses the `MMDDHHMMSS[.fff]Z` remainder of a DER encoded UTCTime or GeneralizedTime string, after the
+// year, returning the time in UTC. Fractional seconds are only parsed if allowed, which is for GeneralizedTime.
+func parseASN1Time(year int, value string, fractionalSeconds bool) (time.Time, error) {
+	month, rest, err := parseFixedDigits(value, 2, "month", 1, 12)
+	if err != nil {
+		return time.Time{}, err
+	}
+
+	day, rest, err := parseFixedDigits(rest, 2, "day", 1, 31)
+	if err != nil {
+		return time.Time{}, err
+	}
+
+	if err := checkDayOfMonth(year, month, day); err != nil {
+		return time.Time{}, err
+	}
+
+	hour, rest, err := parseFixedDigits(rest, 2, "hour", 0, 23)
+	if err != nil {
+		return time.Time{}, err
+	}
+
+	minute, rest, err := parseFixedDigits(rest, 2, "minute", 0, 59)
+	if err != nil {
+		return time.Time{}, err
+	}
+
+	second, rest, err := parseFixedDigits(rest, 2, "second", 0, 59)
+	if err != nil {
+		return time.Time{}, err
+	}
+
+	var nanosecond int
+
+	if fractionalSeconds && rest != "" && rest[0] == '.' {
+		i := 1
+
+		for i < len(rest) && rest[i] >= '0' && rest[i] <= '9' {
+			nanosecond = nanosecond*10 + int(rest[i]-'0')
+			i++
+		}
+
+		switch {
+		case i == 1:
+			return time.Time{}, fmt.Errorf("expected digits after decimal separator in fractional seconds, got %q", rest[1:])
+		case i > 10:
+			return time.Time{}, fmt.Errorf("fractional seconds must not have more than 9 digits, got %q", rest[1:i])
+		case rest[i-1] == '0':
+			return time.Time{}, fmt.Errorf("fractional seconds must not have trailing zeros, got %q", rest[1:i])
+		}
+
+		for n := i - 1; n < 9; n++ {
+			nanosecond *= 10
+		}
+
+		rest = rest[i:]
+	}
+
+	if rest != "Z" {
+		return time.Time{}, fmt.Errorf(`expected "Z" after seconds, got %q`, rest)
+	}
+
+	return time.Date(year, time.Month(month), day, hour, minute, second, nanosecond, time.UTC), nil
+}
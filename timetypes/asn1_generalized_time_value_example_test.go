@@ -0,0 +1,34 @@
+// Copyright IBM Corp. 2023, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package timetypes_test
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework-timetypes/timetypes"
+)
+
+type CertificateDataSourceModel struct {
+	NotAfter timetypes.ASN1GeneralizedTime `tfsdk:"not_after"`
+}
+
+func ExampleASN1GeneralizedTime_ValueRFC3339Time() {
+	// For example purposes, typically the data model would be populated automatically by Plugin Framework via Config, Plan or State.
+	// https://developer.hashicorp.com/terraform/plugin/framework/handling-data/accessing-values
+	data := CertificateDataSourceModel{
+		NotAfter: timetypes.NewASN1GeneralizedTimeValueMust("20500101000000Z"),
+	}
+
+	// Check that the ASN.1 GeneralizedTime data is known and able to be converted to time.Time
+	if !data.NotAfter.IsNull() && !data.NotAfter.IsUnknown() {
+		t, diags := data.NotAfter.ValueRFC3339Time()
+		if diags.HasError() {
+			return
+		}
+
+		// Output: 2050-01-01T00:00:00Z
+		fmt.Println(t.Format(time.RFC3339))
+	}
+}
@@ -0,0 +1,445 @@
+// Copyright IBM Corp. 2023, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package timetypes_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-framework/attr/xattr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+
+	"github.com/hashicorp/terraform-plugin-framework-timetypes/timetypes"
+)
+
+func TestASN1GeneralizedTime_StringSemanticEquals(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		currentASN1GeneralizedTime timetypes.ASN1GeneralizedTime
+		givenASN1GeneralizedTime   basetypes.StringValuable
+		expectedMatch              bool
+		expectedDiags              diag.Diagnostics
+	}{
+		"not equal - different time": {
+			currentASN1GeneralizedTime: timetypes.NewASN1GeneralizedTimeValueMust("20261016120000Z"),
+			givenASN1GeneralizedTime:   timetypes.NewASN1GeneralizedTimeValueMust("20261016120000.5Z"),
+			expectedMatch:              false,
+		},
+		"not equal - UTCTime in a different century": {
+			currentASN1GeneralizedTime: timetypes.NewASN1GeneralizedTimeValueMust("20961016120000Z"),
+			givenASN1GeneralizedTime:   timetypes.NewASN1UTCTimeValueMust("961016120000Z"),
+			expectedMatch:              false,
+		},
+		"not equal - RFC3339 with offset": {
+			currentASN1GeneralizedTime: timetypes.NewASN1GeneralizedTimeValueMust("20261016120000Z"),
+			givenASN1GeneralizedTime:   timetypes.NewRFC3339ValueMust("2026-10-16T14:00:00+02:00"),
+			expectedMatch:              false,
+		},
+		"semantically equal - byte for byte match": {
+			currentASN1GeneralizedTime: timetypes.NewASN1GeneralizedTimeValueMust("20261016120000Z"),
+			givenASN1GeneralizedTime:   timetypes.NewASN1GeneralizedTimeValueMust("20261016120000Z"),
+			expectedMatch:              true,
+		},
+		"semantically equal - UTCTime": {
+			currentASN1GeneralizedTime: timetypes.NewASN1GeneralizedTimeValueMust("19961016120000Z"),
+			givenASN1GeneralizedTime:   timetypes.NewASN1UTCTimeValueMust("961016120000Z"),
+			expectedMatch:              true,
+		},
+		"semantically equal - RFC3339": {
+			currentASN1GeneralizedTime: timetypes.NewASN1GeneralizedTimeValueMust("20261016120000Z"),
+			givenASN1GeneralizedTime:   timetypes.NewRFC3339ValueMust("2026-10-16T12:00:00Z"),
+			expectedMatch:              true,
+		},
+		"semantically equal - RFC3339 with fractional seconds": {
+			currentASN1GeneralizedTime: timetypes.NewASN1GeneralizedTimeValueMust("20261016120000.5Z"),
+			givenASN1GeneralizedTime:   timetypes.NewRFC3339ValueMust("2026-10-16T12:00:00.500Z"),
+			expectedMatch:              true,
+		},
+		"error - not given ASN1GeneralizedTime, ASN1UTCTime or RFC3339 value": {
+			currentASN1GeneralizedTime: timetypes.NewASN1GeneralizedTimeValueMust("20261016120000Z"),
+			givenASN1GeneralizedTime:   basetypes.NewStringValue("20261016120000Z"),
+			expectedMatch:              false,
+			expectedDiags: diag.Diagnostics{
+				diag.NewErrorDiagnostic(
+					"Semantic Equality Check Error",
+					"An unexpected value type was received while performing semantic equality checks. "+
+						"Please report this to the provider developers.\n\n"+
+						"Expected Value Type: timetypes.ASN1GeneralizedTime\n"+
+						"Got Value Type: basetypes.StringValue",
+				),
+			},
+		},
+	}
+	for name, testCase := range testCases {
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			match, diags := testCase.currentASN1GeneralizedTime.StringSemanticEquals(context.Background(), testCase.givenASN1GeneralizedTime)
+
+			if testCase.expectedMatch != match {
+				t.Errorf("Expected StringSemanticEquals to return: %t, but got: %t", testCase.expectedMatch, match)
+			}
+
+			if diff := cmp.Diff(diags, testCase.expectedDiags); diff != "" {
+				t.Errorf("Unexpected diagnostics (-got, +expected): %s", diff)
+			}
+		})
+	}
+}
+
+func TestASN1GeneralizedTimeValidateAttribute(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		asn1GeneralizedTime timetypes.ASN1GeneralizedTime
+		expectedDiags       diag.Diagnostics
+	}{
+		"empty-struct": {
+			asn1GeneralizedTime: timetypes.ASN1GeneralizedTime{},
+		},
+		"null": {
+			asn1GeneralizedTime: timetypes.NewASN1GeneralizedTimeNull(),
+		},
+		"unknown": {
+			asn1GeneralizedTime: timetypes.NewASN1GeneralizedTimeUnknown(),
+		},
+		"valid": {
+			asn1GeneralizedTime: timetypes.NewASN1GeneralizedTimeValueMust("20261016120000Z"),
+		},
+		"valid - fractional seconds": {
+			asn1GeneralizedTime: timetypes.NewASN1GeneralizedTimeValueMust("20261016120000.123456789Z"),
+		},
+		"valid - X.509 no well-defined expiration date": {
+			asn1GeneralizedTime: timetypes.NewASN1GeneralizedTimeValueMust("99991231235959Z"),
+		},
+		"invalid - trailing zero in fractional seconds": {
+			asn1GeneralizedTime: timetypes.ASN1GeneralizedTime{
+				StringValue: basetypes.NewStringValue("20261016120000.50Z"),
+			},
+			expectedDiags: diag.Diagnostics{
+				diag.NewAttributeErrorDiagnostic(
+					path.Root("test"),
+					"Invalid ASN.1 GeneralizedTime String Value",
+					"A string value was provided that is not valid DER encoded ASN.1 GeneralizedTime string format, such as \"20261016120000Z\".\n\n"+
+						"Given Value: 20261016120000.50Z\n"+
+						"Error: fractional seconds must not have trailing zeros, got \"50\"",
+				),
+			},
+		},
+		"invalid - comma decimal separator": {
+			asn1GeneralizedTime: timetypes.ASN1GeneralizedTime{
+				StringValue: basetypes.NewStringValue("20261016120000,5Z"),
+			},
+			expectedDiags: diag.Diagnostics{
+				diag.NewAttributeErrorDiagnostic(
+					path.Root("test"),
+					"Invalid ASN.1 GeneralizedTime String Value",
+					"A string value was provided that is not valid DER encoded ASN.1 GeneralizedTime string format, such as \"20261016120000Z\".\n\n"+
+						"Given Value: 20261016120000,5Z\n"+
+						"Error: expected \"Z\" after seconds, got \",5Z\"",
+				),
+			},
+		},
+		"invalid - decimal separator without digits": {
+			asn1GeneralizedTime: timetypes.ASN1GeneralizedTime{
+				StringValue: basetypes.NewStringValue("20261016120000.Z"),
+			},
+			expectedDiags: diag.Diagnostics{
+				diag.NewAttributeErrorDiagnostic(
+					path.Root("test"),
+					"Invalid ASN.1 GeneralizedTime String Value",
+					"A string value was provided that is not valid DER encoded ASN.1 GeneralizedTime string format, such as \"20261016120000Z\".\n\n"+
+						"Given Value: 20261016120000.Z\n"+
+						"Error: expected digits after decimal separator in fractional seconds, got \"Z\"",
+				),
+			},
+		},
+		"invalid - local time": {
+			asn1GeneralizedTime: timetypes.ASN1GeneralizedTime{
+				StringValue: basetypes.NewStringValue("20261016120000"),
+			},
+			expectedDiags: diag.Diagnostics{
+				diag.NewAttributeErrorDiagnostic(
+					path.Root("test"),
+					"Invalid ASN.1 GeneralizedTime String Value",
+					"A string value was provided that is not valid DER encoded ASN.1 GeneralizedTime string format, such as \"20261016120000Z\".\n\n"+
+						"Given Value: 20261016120000\n"+
+						"Error: expected \"Z\" after seconds, got \"\"",
+				),
+			},
+		},
+		"invalid - UTCTime": {
+			asn1GeneralizedTime: timetypes.ASN1GeneralizedTime{
+				StringValue: basetypes.NewStringValue("261016120000Z"),
+			},
+			expectedDiags: diag.Diagnostics{
+				diag.NewAttributeErrorDiagnostic(
+					path.Root("test"),
+					"Invalid ASN.1 GeneralizedTime String Value",
+					"A string value was provided that is not valid DER encoded ASN.1 GeneralizedTime string format, such as \"20261016120000Z\".\n\n"+
+						"Given Value: 261016120000Z\n"+
+						"Error: month \"16\" is out of range [1, 12]",
+				),
+			},
+		},
+	}
+	for name, testCase := range testCases {
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			resp := xattr.ValidateAttributeResponse{}
+
+			testCase.asn1GeneralizedTime.ValidateAttribute(
+				context.Background(),
+				xattr.ValidateAttributeRequest{
+					Path: path.Root("test"),
+				},
+				&resp,
+			)
+
+			if diff := cmp.Diff(resp.Diagnostics, testCase.expectedDiags); diff != "" {
+				t.Errorf("Unexpected diagnostics (-got, +expected): %s", diff)
+			}
+		})
+	}
+}
+
+func TestASN1GeneralizedTimeValidateParameter(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		asn1GeneralizedTime timetypes.ASN1GeneralizedTime
+		expectedFuncErr     *function.FuncError
+	}{
+		"empty-struct": {
+			asn1GeneralizedTime: timetypes.ASN1GeneralizedTime{},
+		},
+		"null": {
+			asn1GeneralizedTime: timetypes.NewASN1GeneralizedTimeNull(),
+		},
+		"unknown": {
+			asn1GeneralizedTime: timetypes.NewASN1GeneralizedTimeUnknown(),
+		},
+		"valid": {
+			asn1GeneralizedTime: timetypes.NewASN1GeneralizedTimeValueMust("20261016120000Z"),
+		},
+		"invalid": {
+			asn1GeneralizedTime: timetypes.ASN1GeneralizedTime{
+				StringValue: basetypes.NewStringValue("20261016120000+0200"),
+			},
+			expectedFuncErr: function.NewArgumentFuncError(
+				0,
+				"Invalid ASN.1 GeneralizedTime String Value: "+
+					"A string value was provided that is not valid DER encoded ASN.1 GeneralizedTime string format, such as \"20261016120000Z\".\n\n"+
+					"Given Value: 20261016120000+0200\n"+
+					"Error: expected \"Z\" after seconds, got \"+0200\"",
+			),
+		},
+	}
+	for name, testCase := range testCases {
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			resp := function.ValidateParameterResponse{}
+
+			testCase.asn1GeneralizedTime.ValidateParameter(
+				context.Background(),
+				function.ValidateParameterRequest{
+					Position: int64(0),
+				},
+				&resp,
+			)
+
+			if diff := cmp.Diff(resp.Error, testCase.expectedFuncErr); diff != "" {
+				t.Errorf("Unexpected diagnostics (-got, +expected): %s", diff)
+			}
+		})
+	}
+}
+
+func TestASN1GeneralizedTime_ValueRFC3339Time(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		asn1GeneralizedTime timetypes.ASN1GeneralizedTime
+		expectedTime        time.Time
+		expectedDiags       diag.Diagnostics
+	}{
+		"ASN.1 GeneralizedTime string value is null": {
+			asn1GeneralizedTime: timetypes.NewASN1GeneralizedTimeNull(),
+			expectedDiags: diag.Diagnostics{
+				diag.NewErrorDiagnostic("ASN1GeneralizedTime ValueRFC3339Time Error", "ASN.1 GeneralizedTime string value is null"),
+			},
+		},
+		"ASN.1 GeneralizedTime string value is unknown": {
+			asn1GeneralizedTime: timetypes.NewASN1GeneralizedTimeUnknown(),
+			expectedDiags: diag.Diagnostics{
+				diag.NewErrorDiagnostic("ASN1GeneralizedTime ValueRFC3339Time Error", "ASN.1 GeneralizedTime string value is unknown"),
+			},
+		},
+		"valid": {
+			asn1GeneralizedTime: timetypes.NewASN1GeneralizedTimeValueMust("20261016120000Z"),
+			expectedTime:        time.Date(2026, time.October, 16, 12, 0, 0, 0, time.UTC),
+		},
+		"fractional seconds": {
+			asn1GeneralizedTime: timetypes.NewASN1GeneralizedTimeValueMust("20261016120000.25Z"),
+			expectedTime:        time.Date(2026, time.October, 16, 12, 0, 0, 250000000, time.UTC),
+		},
+	}
+	for name, testCase := range testCases {
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got, diags := testCase.asn1GeneralizedTime.ValueRFC3339Time()
+
+			if !got.Equal(testCase.expectedTime) {
+				t.Errorf("Unexpected difference in time.Time, got: %s, expected: %s", got, testCase.expectedTime)
+			}
+
+			if diff := cmp.Diff(diags, testCase.expectedDiags); diff != "" {
+				t.Errorf("Unexpected diagnostics (-got, +expected): %s", diff)
+			}
+		})
+	}
+}
+
+func TestASN1GeneralizedTime_ToRFC3339Value(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		asn1GeneralizedTime timetypes.ASN1GeneralizedTime
+		expected            timetypes.RFC3339
+		expectedDiags       diag.Diagnostics
+	}{
+		"null": {
+			asn1GeneralizedTime: timetypes.NewASN1GeneralizedTimeNull(),
+			expected:            timetypes.NewRFC3339Null(),
+		},
+		"unknown": {
+			asn1GeneralizedTime: timetypes.NewASN1GeneralizedTimeUnknown(),
+			expected:            timetypes.NewRFC3339Unknown(),
+		},
+		"valid": {
+			asn1GeneralizedTime: timetypes.NewASN1GeneralizedTimeValueMust("20261016120000Z"),
+			expected:            timetypes.NewRFC3339ValueMust("2026-10-16T12:00:00Z"),
+		},
+		"fractional seconds": {
+			asn1GeneralizedTime: timetypes.NewASN1GeneralizedTimeValueMust("20261016120000.25Z"),
+			expected:            timetypes.NewRFC3339ValueMust("2026-10-16T12:00:00.25Z"),
+		},
+	}
+	for name, testCase := range testCases {
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got, diags := testCase.asn1GeneralizedTime.ToRFC3339Value()
+
+			if !got.Equal(testCase.expected) {
+				t.Errorf("Expected %s, got %s", testCase.expected, got)
+			}
+
+			if diff := cmp.Diff(diags, testCase.expectedDiags); diff != "" {
+				t.Errorf("Unexpected diagnostics (-got, +expected): %s", diff)
+			}
+		})
+	}
+}
+
+func TestASN1GeneralizedTime_ToASN1UTCTimeValue(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		asn1GeneralizedTime timetypes.ASN1GeneralizedTime
+		expected            timetypes.ASN1UTCTime
+		expectedDiags       diag.Diagnostics
+	}{
+		"null": {
+			asn1GeneralizedTime: timetypes.NewASN1GeneralizedTimeNull(),
+			expected:            timetypes.NewASN1UTCTimeNull(),
+		},
+		"unknown": {
+			asn1GeneralizedTime: timetypes.NewASN1GeneralizedTimeUnknown(),
+			expected:            timetypes.NewASN1UTCTimeUnknown(),
+		},
+		"valid": {
+			asn1GeneralizedTime: timetypes.NewASN1GeneralizedTimeValueMust("19961016120000Z"),
+			expected:            timetypes.NewASN1UTCTimeValueMust("961016120000Z"),
+		},
+		"fractional seconds": {
+			asn1GeneralizedTime: timetypes.NewASN1GeneralizedTimeValueMust("20261016120000.5Z"),
+			expected:            timetypes.NewASN1UTCTimeValueMust("261016120000Z"),
+		},
+		"out of range": {
+			asn1GeneralizedTime: timetypes.NewASN1GeneralizedTimeValueMust("20500101000000Z"),
+			expected:            timetypes.NewASN1UTCTimeUnknown(),
+			expectedDiags: diag.Diagnostics{
+				diag.NewErrorDiagnostic(
+					"ASN1GeneralizedTime ToASN1UTCTimeValue Error",
+					"time 2050-01-01T00:00:00Z is out of range for UTCTime, which represents the years 1950 through 2049",
+				),
+			},
+		},
+	}
+	for name, testCase := range testCases {
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got, diags := testCase.asn1GeneralizedTime.ToASN1UTCTimeValue()
+
+			if !got.Equal(testCase.expected) {
+				t.Errorf("Expected %s, got %s", testCase.expected, got)
+			}
+
+			if diff := cmp.Diff(diags, testCase.expectedDiags); diff != "" {
+				t.Errorf("Unexpected diagnostics (-got, +expected): %s", diff)
+			}
+		})
+	}
+}
+
+func TestNewASN1GeneralizedTimeTimeValue(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		time     time.Time
+		expected timetypes.ASN1GeneralizedTime
+	}{
+		"UTC": {
+			time:     time.Date(2026, time.October, 16, 12, 0, 0, 0, time.UTC),
+			expected: timetypes.NewASN1GeneralizedTimeValueMust("20261016120000Z"),
+		},
+		"fixed zone": {
+			time:     time.Date(2026, time.October, 16, 14, 0, 0, 0, time.FixedZone("", 2*60*60)),
+			expected: timetypes.NewASN1GeneralizedTimeValueMust("20261016120000Z"),
+		},
+		"fractional seconds": {
+			time:     time.Date(2026, time.October, 16, 12, 0, 0, 120000000, time.UTC),
+			expected: timetypes.NewASN1GeneralizedTimeValueMust("20261016120000.12Z"),
+		},
+	}
+	for name, testCase := range testCases {
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got := timetypes.NewASN1GeneralizedTimeTimeValue(testCase.time)
+
+			if !got.Equal(testCase.expected) {
+				t.Errorf("Expected %s, got %s", testCase.expected, got)
+			}
+		})
+	}
+}
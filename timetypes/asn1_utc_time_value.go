@@ -0,0 +1,326 @@
+// Copyright IBM Corp. 2023, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package timetypes
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/attr/xattr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+)
+
+var (
+	_ basetypes.StringValuableWithSemanticEquals = (*ASN1UTCTime)(nil)
+	_ xattr.ValidateableAttribute                = (*ASN1UTCTime)(nil)
+	_ function.ValidateableParameter             = (*ASN1UTCTime)(nil)
+)
+
+// asn1UTCTimeLayout is the time.Time layout of a DER encoded UTCTime.
+const asn1UTCTimeLayout = "060102150405Z"
+
+// ASN1UTCTime represents a valid ASN.1 UTCTime string in the DER encoding used by X.509 certificates (RFC 5280), such
+// as `261016120000Z`. The format is `YYMMDDHHMMSSZ`, which requires seconds and the `Z` suffix. Per RFC 5280, a
+// two digit year of 50 or greater is in the 1900s and a two digit year of less than 50 is in the 2000s, so values
+// represent times in the years 1950 through 2049. Semantic equality logic is defined for ASN1UTCTime such that a value
+// is equal to an ASN1GeneralizedTime or RFC3339 value representing the same instant.
+type ASN1UTCTime struct {
+	basetypes.StringValue
+}
+
+// Type returns an ASN1UTCTimeType.
+func (v ASN1UTCTime) Type(_ context.Context) attr.Type {
+	return ASN1UTCTimeType{}
+}
+
+// Equal returns true if the given value is equivalent.
+func (v ASN1UTCTime) Equal(o attr.Value) bool {
+	other, ok := o.(ASN1UTCTime)
+
+	if !ok {
+		return false
+	}
+
+	return v.StringValue.Equal(other.StringValue)
+}
+
+// StringSemanticEquals returns true if the given ASN1UTCTime, ASN1GeneralizedTime or RFC3339 string value is
+// semantically equal to the current ASN1UTCTime string value. An RFC3339 value must have a `Z` or `00:00` UTC offset
+// to be considered equal, as UTCTime values are always UTC.
+//
+// Examples:
+//   - `261016120000Z` is semantically equal to `20261016120000Z`
+//   - `491231235959Z` is semantically equal to `2049-12-31T23:59:59Z`
+//   - `500101000000Z` is semantically equal to `1950-01-01T00:00:00Z`
+//
+// Counterexamples:
+//   - `261016120000Z` expresses the same time as `2026-10-16T14:00:00+02:00` but is NOT considered to be
+//     semantically equal.
+func (v ASN1UTCTime) StringSemanticEquals(_ context.Context, newValuable basetypes.StringValuable) (bool, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	var newTime time.Time
+
+	// ASN.1 and RFC3339 strings are already validated at this point, ignoring errors
+	switch newValue := newValuable.(type) {
+	case ASN1UTCTime:
+		newTime, _ = parseASN1UTCTime(newValue.ValueString())
+	case ASN1GeneralizedTime:
+		newTime, _ = parseASN1GeneralizedTime(newValue.ValueString())
+	case RFC3339:
+		newTime, _ = time.Parse(time.RFC3339, newValue.ValueString())
+	default:
+		diags.AddError(
+			"Semantic Equality Check Error",
+			"An unexpected value type was received while performing semantic equality checks. "+
+				"Please report this to the provider developers.\n\n"+
+				"Expected Value Type: "+fmt.Sprintf("%T", v)+"\n"+
+				"Got Value Type: "+fmt.Sprintf("%T", newValuable),
+		)
+
+		return false, diags
+	}
+
+	currentTime, _ := parseASN1UTCTime(v.ValueString())
+
+	_, newOffset := newTime.Zone()
+
+	return currentTime.Equal(newTime) && newOffset == 0, diags
+}
+
+// ValidateAttribute implements attribute value validation. This type requires the value to be a String value that
+// is valid DER encoded ASN.1 UTCTime format.
+func (v ASN1UTCTime) ValidateAttribute(ctx context.Context, req xattr.ValidateAttributeRequest, resp *xattr.ValidateAttributeResponse) {
+	if v.IsUnknown() || v.IsNull() {
+		return
+	}
+
+	if _, err := parseASN1UTCTime(v.ValueString()); err != nil {
+		resp.Diagnostics.Append(diag.WithPath(req.Path, asn1UTCTimeInvalidStringDiagnostic(v.ValueString(), err)))
+
+		return
+	}
+}
+
+// ValidateParameter implements provider-defined function parameter value validation. This type requires the value to
+// be a String value that is valid DER encoded ASN.1 UTCTime format.
+func (v ASN1UTCTime) ValidateParameter(ctx context.Context, req function.ValidateParameterRequest, resp *function.ValidateParameterResponse) {
+	if v.IsUnknown() || v.IsNull() {
+		return
+	}
+
+	if _, err := parseASN1UTCTime(v.ValueString()); err != nil {
+		resp.Error = function.NewArgumentFuncError(
+			req.Position,
+			"Invalid ASN.1 UTCTime String Value: "+
+				"A string value was provided that is not valid DER encoded ASN.1 UTCTime string format, such as \"261016120000Z\".\n\n"+
+				"Given Value: "+v.ValueString()+"\n"+
+				"Error: "+err.Error(),
+		)
+
+		return
+	}
+}
+
+// ValueRFC3339Time creates a new time.Time instance in UTC with the ASN1UTCTime StringValue. A null or unknown value
+// will produce an error diagnostic.
+func (v ASN1UTCTime) ValueRFC3339Time() (time.Time, diag.Diagnostics) {
+	return v.valueASN1UTCTime("ValueRFC3339Time")
+}
+
+// ToRFC3339Value converts the ASN1UTCTime to an RFC3339 value, such as `2026-10-16T12:00:00Z` for `261016120000Z`.
+// Null and unknown values are converted to null and unknown RFC3339 values respectively.
+func (v ASN1UTCTime) ToRFC3339Value() (RFC3339, diag.Diagnostics) {
+	if v.IsNull() {
+		return NewRFC3339Null(), nil
+	}
+
+	if v.IsUnknown() {
+		return NewRFC3339Unknown(), nil
+	}
+
+	t, diags := v.valueASN1UTCTime("ToRFC3339Value")
+	if diags.HasError() {
+		return NewRFC3339Unknown(), diags
+	}
+
+	return newRFC3339TimeNanoValue(t), nil
+}
+
+// ToASN1GeneralizedTimeValue converts the ASN1UTCTime to an ASN1GeneralizedTime value, such as `20261016120000Z` for
+// `261016120000Z`. Null and unknown values are converted to null and unknown ASN1GeneralizedTime values respectively.
+func (v ASN1UTCTime) ToASN1GeneralizedTimeValue() (ASN1GeneralizedTime, diag.Diagnostics) {
+	if v.IsNull() {
+		return NewASN1GeneralizedTimeNull(), nil
+	}
+
+	if v.IsUnknown() {
+		return NewASN1GeneralizedTimeUnknown(), nil
+	}
+
+	t, diags := v.valueASN1UTCTime("ToASN1GeneralizedTimeValue")
+	if diags.HasError() {
+		return NewASN1GeneralizedTimeUnknown(), diags
+	}
+
+	return NewASN1GeneralizedTimeTimeValue(t), nil
+}
+
+// valueASN1UTCTime returns the parsed ASN1UTCTime, with error diagnostics summarized by the given accessor method
+// name.
+func (v ASN1UTCTime) valueASN1UTCTime(accessor string) (time.Time, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	summary := "ASN1UTCTime " + accessor + " Error"
+
+	if v.IsNull() {
+		diags.Append(diag.NewErrorDiagnostic(summary, "ASN.1 UTCTime string value is null"))
+		return time.Time{}, diags
+	}
+
+	if v.IsUnknown() {
+		diags.Append(diag.NewErrorDiagnostic(summary, "ASN.1 UTCTime string value is unknown"))
+		return time.Time{}, diags
+	}
+
+	t, err := parseASN1UTCTime(v.ValueString())
+	if err != nil {
+		diags.Append(diag.NewErrorDiagnostic(summary, err.Error()))
+		return time.Time{}, diags
+	}
+
+	return t, nil
+}
+
+// NewASN1UTCTimeNull creates an ASN1UTCTime with a null value. Determine whether the value is null via IsNull method.
+func NewASN1UTCTimeNull() ASN1UTCTime {
+	return ASN1UTCTime{
+		StringValue: basetypes.NewStringNull(),
+	}
+}
+
+// NewASN1UTCTimeUnknown creates an ASN1UTCTime with an unknown value. Determine whether the value is unknown via IsUnknown method.
+func NewASN1UTCTimeUnknown() ASN1UTCTime {
+	return ASN1UTCTime{
+		StringValue: basetypes.NewStringUnknown(),
+	}
+}
+
+// NewASN1UTCTimeTimeValue creates an ASN1UTCTime with a known value of the time.Time in UTC or raises an error
+// diagnostic if the time.Time is outside of the years 1950 through 2049. Any fractional seconds are truncated.
+func NewASN1UTCTimeTimeValue(value time.Time) (ASN1UTCTime, diag.Diagnostics) {
+	utcTime, err := formatASN1UTCTime(value)
+
+	if err != nil {
+		// Returning an unknown value will guarantee that, as a last resort,
+		// Terraform will return an error if attempting to store into state.
+		return NewASN1UTCTimeUnknown(), diag.Diagnostics{asn1UTCTimeInvalidTimeDiagnostic(value, err)}
+	}
+
+	return ASN1UTCTime{
+		StringValue: basetypes.NewStringValue(utcTime),
+	}, nil
+}
+
+// NewASN1UTCTimeTimePointerValue creates an ASN1UTCTime with a null value if nil, a known value of the time.Time in
+// UTC, or raises an error diagnostic if the time.Time is outside of the years 1950 through 2049.
+func NewASN1UTCTimeTimePointerValue(value *time.Time) (ASN1UTCTime, diag.Diagnostics) {
+	if value == nil {
+		return NewASN1UTCTimeNull(), nil
+	}
+
+	return NewASN1UTCTimeTimeValue(*value)
+}
+
+// NewASN1UTCTimeValue creates an ASN1UTCTime with a known value or raises an error
+// diagnostic if the string is not DER encoded ASN.1 UTCTime format.
+func NewASN1UTCTimeValue(value string) (ASN1UTCTime, diag.Diagnostics) {
+	_, err := parseASN1UTCTime(value)
+
+	if err != nil {
+		// Returning an unknown value will guarantee that, as a last resort,
+		// Terraform will return an error if attempting to store into state.
+		return NewASN1UTCTimeUnknown(), diag.Diagnostics{asn1UTCTimeInvalidStringDiagnostic(value, err)}
+	}
+
+	return ASN1UTCTime{
+		StringValue: basetypes.NewStringValue(value),
+	}, nil
+}
+
+// NewASN1UTCTimeValueMust creates an ASN1UTCTime with a known value or raises a panic
+// if the string is not DER encoded ASN.1 UTCTime format.
+//
+// This creation function is only recommended to create ASN1UTCTime values which
+// either will not potentially affect practitioners, such as testing, or within
+// exhaustively tested provider logic.
+func NewASN1UTCTimeValueMust(value string) ASN1UTCTime {
+	_, err := parseASN1UTCTime(value)
+
+	if err != nil {
+		panic(fmt.Sprintf("Invalid ASN.1 UTCTime String Value (%s): %s", value, err))
+	}
+
+	return ASN1UTCTime{
+		StringValue: basetypes.NewStringValue(value),
+	}
+}
+
+// NewASN1UTCTimePointerValue creates an ASN1UTCTime with a null value if nil, a known
+// value, or raises an error diagnostic if the string is not DER encoded ASN.1 UTCTime
+// format.
+func NewASN1UTCTimePointerValue(value *string) (ASN1UTCTime, diag.Diagnostics) {
+	if value == nil {
+		return NewASN1UTCTimeNull(), nil
+	}
+
+	return NewASN1UTCTimeValue(*value)
+}
+
+// NewASN1UTCTimePointerValueMust creates an ASN1UTCTime with a null value if nil, a
+// known value, or raises a panic if the string is not DER encoded ASN.1 UTCTime format.
+//
+// This creation function is only recommended to create ASN1UTCTime values which
+// either will not potentially affect practitioners, such as testing, or within
+// exhaustively tested provider logic.
+func NewASN1UTCTimePointerValueMust(value *string) ASN1UTCTime {
+	if value == nil {
+		return NewASN1UTCTimeNull()
+	}
+
+	return NewASN1UTCTimeValueMust(*value)
+}
+
+// parseASN1UTCTime parses a DER encoded UTCTime string of the format `YYMMDDHHMMSSZ`, returning the time in UTC. The
+// two digit year is interpreted with the RFC 5280 pivot, as a year in the range 1950 through 2049.
+func parseASN1UTCTime(value string) (time.Time, error) {
+	year, rest, err := parseFixedDigits(value, 2, "year", 0, 99)
+	if err != nil {
+		return time.Time{}, err
+	}
+
+	if year >= 50 {
+		year += 1900
+	} else {
+		year += 2000
+	}
+
+	return parseASN1Time(year, rest, false)
+}
+
+// formatASN1UTCTime returns the DER encoded UTCTime string of the given time.Time in UTC, truncating any fractional
+// seconds, or an error if the time.Time is outside of the years 1950 through 2049.
+func formatASN1UTCTime(value time.Time) (string, error) {
+	value = value.UTC()
+
+	if year := value.Year(); year < 1950 || year > 2049 {
+		return "", fmt.Errorf("time %s is out of range for UTCTime, which represents the years 1950 through 2049", value.Format(time.RFC3339Nano))
+	}
+
+	return value.Format(asn1UTCTimeLayout), nil
+}
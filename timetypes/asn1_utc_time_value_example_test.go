@@ -0,0 +1,33 @@
+// Copyright IBM Corp. 2023, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package timetypes_test
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework-timetypes/timetypes"
+)
+
+type CertificateResourceModel struct {
+	NotBefore timetypes.ASN1UTCTime `tfsdk:"not_before"`
+}
+
+func ExampleASN1UTCTime_ToRFC3339Value() {
+	// For example purposes, typically the data model would be populated automatically by Plugin Framework via Config, Plan or State.
+	// https://developer.hashicorp.com/terraform/plugin/framework/handling-data/accessing-values
+	data := CertificateResourceModel{
+		NotBefore: timetypes.NewASN1UTCTimeValueMust("991016120000Z"),
+	}
+
+	// Check that the ASN.1 UTCTime data is known and able to be converted to RFC3339
+	if !data.NotBefore.IsNull() && !data.NotBefore.IsUnknown() {
+		rfc3339, diags := data.NotBefore.ToRFC3339Value()
+		if diags.HasError() {
+			return
+		}
+
+		// Output: 1999-10-16T12:00:00Z
+		fmt.Println(rfc3339.ValueString())
+	}
+}
@@ -0,0 +1,453 @@
+// Copyright IBM Corp. 2023, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package timetypes_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-framework/attr/xattr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+
+	"github.com/hashicorp/terraform-plugin-framework-timetypes/timetypes"
+)
+
+func TestASN1UTCTime_StringSemanticEquals(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		currentASN1UTCTime timetypes.ASN1UTCTime
+		givenASN1UTCTime   basetypes.StringValuable
+		expectedMatch      bool
+		expectedDiags      diag.Diagnostics
+	}{
+		"not equal - different time": {
+			currentASN1UTCTime: timetypes.NewASN1UTCTimeValueMust("261016120000Z"),
+			givenASN1UTCTime:   timetypes.NewASN1UTCTimeValueMust("261016120001Z"),
+			expectedMatch:      false,
+		},
+		"not equal - GeneralizedTime in a different century": {
+			currentASN1UTCTime: timetypes.NewASN1UTCTimeValueMust("261016120000Z"),
+			givenASN1UTCTime:   timetypes.NewASN1GeneralizedTimeValueMust("19261016120000Z"),
+			expectedMatch:      false,
+		},
+		"not equal - GeneralizedTime with fractional seconds": {
+			currentASN1UTCTime: timetypes.NewASN1UTCTimeValueMust("261016120000Z"),
+			givenASN1UTCTime:   timetypes.NewASN1GeneralizedTimeValueMust("20261016120000.5Z"),
+			expectedMatch:      false,
+		},
+		"not equal - RFC3339 with offset": {
+			currentASN1UTCTime: timetypes.NewASN1UTCTimeValueMust("261016120000Z"),
+			givenASN1UTCTime:   timetypes.NewRFC3339ValueMust("2026-10-16T14:00:00+02:00"),
+			expectedMatch:      false,
+		},
+		"semantically equal - byte for byte match": {
+			currentASN1UTCTime: timetypes.NewASN1UTCTimeValueMust("261016120000Z"),
+			givenASN1UTCTime:   timetypes.NewASN1UTCTimeValueMust("261016120000Z"),
+			expectedMatch:      true,
+		},
+		"semantically equal - GeneralizedTime": {
+			currentASN1UTCTime: timetypes.NewASN1UTCTimeValueMust("261016120000Z"),
+			givenASN1UTCTime:   timetypes.NewASN1GeneralizedTimeValueMust("20261016120000Z"),
+			expectedMatch:      true,
+		},
+		"semantically equal - GeneralizedTime at the start of the pivot": {
+			currentASN1UTCTime: timetypes.NewASN1UTCTimeValueMust("500101000000Z"),
+			givenASN1UTCTime:   timetypes.NewASN1GeneralizedTimeValueMust("19500101000000Z"),
+			expectedMatch:      true,
+		},
+		"semantically equal - RFC3339 at the end of the pivot": {
+			currentASN1UTCTime: timetypes.NewASN1UTCTimeValueMust("491231235959Z"),
+			givenASN1UTCTime:   timetypes.NewRFC3339ValueMust("2049-12-31T23:59:59Z"),
+			expectedMatch:      true,
+		},
+		"semantically equal - RFC3339 with +00:00 offset": {
+			currentASN1UTCTime: timetypes.NewASN1UTCTimeValueMust("261016120000Z"),
+			givenASN1UTCTime:   timetypes.NewRFC3339ValueMust("2026-10-16T12:00:00+00:00"),
+			expectedMatch:      true,
+		},
+		"error - not given ASN1UTCTime, ASN1GeneralizedTime or RFC3339 value": {
+			currentASN1UTCTime: timetypes.NewASN1UTCTimeValueMust("261016120000Z"),
+			givenASN1UTCTime:   basetypes.NewStringValue("261016120000Z"),
+			expectedMatch:      false,
+			expectedDiags: diag.Diagnostics{
+				diag.NewErrorDiagnostic(
+					"Semantic Equality Check Error",
+					"An unexpected value type was received while performing semantic equality checks. "+
+						"Please report this to the provider developers.\n\n"+
+						"Expected Value Type: timetypes.ASN1UTCTime\n"+
+						"Got Value Type: basetypes.StringValue",
+				),
+			},
+		},
+	}
+	for name, testCase := range testCases {
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			match, diags := testCase.currentASN1UTCTime.StringSemanticEquals(context.Background(), testCase.givenASN1UTCTime)
+
+			if testCase.expectedMatch != match {
+				t.Errorf("Expected StringSemanticEquals to return: %t, but got: %t", testCase.expectedMatch, match)
+			}
+
+			if diff := cmp.Diff(diags, testCase.expectedDiags); diff != "" {
+				t.Errorf("Unexpected diagnostics (-got, +expected): %s", diff)
+			}
+		})
+	}
+}
+
+func TestASN1UTCTimeValidateAttribute(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		asn1UTCTime   timetypes.ASN1UTCTime
+		expectedDiags diag.Diagnostics
+	}{
+		"empty-struct": {
+			asn1UTCTime: timetypes.ASN1UTCTime{},
+		},
+		"null": {
+			asn1UTCTime: timetypes.NewASN1UTCTimeNull(),
+		},
+		"unknown": {
+			asn1UTCTime: timetypes.NewASN1UTCTimeUnknown(),
+		},
+		"valid": {
+			asn1UTCTime: timetypes.NewASN1UTCTimeValueMust("261016120000Z"),
+		},
+		"valid - leap day in the 1900s": {
+			asn1UTCTime: timetypes.NewASN1UTCTimeValueMust("960229000000Z"),
+		},
+		"invalid - missing seconds": {
+			asn1UTCTime: timetypes.ASN1UTCTime{
+				StringValue: basetypes.NewStringValue("2610161200Z"),
+			},
+			expectedDiags: diag.Diagnostics{
+				diag.NewAttributeErrorDiagnostic(
+					path.Root("test"),
+					"Invalid ASN.1 UTCTime String Value",
+					"A string value was provided that is not valid DER encoded ASN.1 UTCTime string format, such as \"261016120000Z\".\n\n"+
+						"Given Value: 2610161200Z\n"+
+						"Error: expected 2 digit second, got \"Z\"",
+				),
+			},
+		},
+		"invalid - UTC offset": {
+			asn1UTCTime: timetypes.ASN1UTCTime{
+				StringValue: basetypes.NewStringValue("261016140000+0200"),
+			},
+			expectedDiags: diag.Diagnostics{
+				diag.NewAttributeErrorDiagnostic(
+					path.Root("test"),
+					"Invalid ASN.1 UTCTime String Value",
+					"A string value was provided that is not valid DER encoded ASN.1 UTCTime string format, such as \"261016120000Z\".\n\n"+
+						"Given Value: 261016140000+0200\n"+
+						"Error: expected \"Z\" after seconds, got \"+0200\"",
+				),
+			},
+		},
+		"invalid - fractional seconds": {
+			asn1UTCTime: timetypes.ASN1UTCTime{
+				StringValue: basetypes.NewStringValue("261016120000.5Z"),
+			},
+			expectedDiags: diag.Diagnostics{
+				diag.NewAttributeErrorDiagnostic(
+					path.Root("test"),
+					"Invalid ASN.1 UTCTime String Value",
+					"A string value was provided that is not valid DER encoded ASN.1 UTCTime string format, such as \"261016120000Z\".\n\n"+
+						"Given Value: 261016120000.5Z\n"+
+						"Error: expected \"Z\" after seconds, got \".5Z\"",
+				),
+			},
+		},
+		"invalid - GeneralizedTime": {
+			asn1UTCTime: timetypes.ASN1UTCTime{
+				StringValue: basetypes.NewStringValue("20261016120000Z"),
+			},
+			expectedDiags: diag.Diagnostics{
+				diag.NewAttributeErrorDiagnostic(
+					path.Root("test"),
+					"Invalid ASN.1 UTCTime String Value",
+					"A string value was provided that is not valid DER encoded ASN.1 UTCTime string format, such as \"261016120000Z\".\n\n"+
+						"Given Value: 20261016120000Z\n"+
+						"Error: month \"26\" is out of range [1, 12]",
+				),
+			},
+		},
+		"invalid - day out of range": {
+			asn1UTCTime: timetypes.ASN1UTCTime{
+				StringValue: basetypes.NewStringValue("500229000000Z"),
+			},
+			expectedDiags: diag.Diagnostics{
+				diag.NewAttributeErrorDiagnostic(
+					path.Root("test"),
+					"Invalid ASN.1 UTCTime String Value",
+					"A string value was provided that is not valid DER encoded ASN.1 UTCTime string format, such as \"261016120000Z\".\n\n"+
+						"Given Value: 500229000000Z\n"+
+						"Error: day 29 is out of range for 1950-02, which has 28 days",
+				),
+			},
+		},
+	}
+	for name, testCase := range testCases {
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			resp := xattr.ValidateAttributeResponse{}
+
+			testCase.asn1UTCTime.ValidateAttribute(
+				context.Background(),
+				xattr.ValidateAttributeRequest{
+					Path: path.Root("test"),
+				},
+				&resp,
+			)
+
+			if diff := cmp.Diff(resp.Diagnostics, testCase.expectedDiags); diff != "" {
+				t.Errorf("Unexpected diagnostics (-got, +expected): %s", diff)
+			}
+		})
+	}
+}
+
+func TestASN1UTCTimeValidateParameter(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		asn1UTCTime     timetypes.ASN1UTCTime
+		expectedFuncErr *function.FuncError
+	}{
+		"empty-struct": {
+			asn1UTCTime: timetypes.ASN1UTCTime{},
+		},
+		"null": {
+			asn1UTCTime: timetypes.NewASN1UTCTimeNull(),
+		},
+		"unknown": {
+			asn1UTCTime: timetypes.NewASN1UTCTimeUnknown(),
+		},
+		"valid": {
+			asn1UTCTime: timetypes.NewASN1UTCTimeValueMust("261016120000Z"),
+		},
+		"invalid": {
+			asn1UTCTime: timetypes.ASN1UTCTime{
+				StringValue: basetypes.NewStringValue("261016120000"),
+			},
+			expectedFuncErr: function.NewArgumentFuncError(
+				0,
+				"Invalid ASN.1 UTCTime String Value: "+
+					"A string value was provided that is not valid DER encoded ASN.1 UTCTime string format, such as \"261016120000Z\".\n\n"+
+					"Given Value: 261016120000\n"+
+					"Error: expected \"Z\" after seconds, got \"\"",
+			),
+		},
+	}
+	for name, testCase := range testCases {
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			resp := function.ValidateParameterResponse{}
+
+			testCase.asn1UTCTime.ValidateParameter(
+				context.Background(),
+				function.ValidateParameterRequest{
+					Position: int64(0),
+				},
+				&resp,
+			)
+
+			if diff := cmp.Diff(resp.Error, testCase.expectedFuncErr); diff != "" {
+				t.Errorf("Unexpected diagnostics (-got, +expected): %s", diff)
+			}
+		})
+	}
+}
+
+func TestASN1UTCTime_ValueRFC3339Time(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		asn1UTCTime   timetypes.ASN1UTCTime
+		expectedTime  time.Time
+		expectedDiags diag.Diagnostics
+	}{
+		"ASN.1 UTCTime string value is null": {
+			asn1UTCTime: timetypes.NewASN1UTCTimeNull(),
+			expectedDiags: diag.Diagnostics{
+				diag.NewErrorDiagnostic("ASN1UTCTime ValueRFC3339Time Error", "ASN.1 UTCTime string value is null"),
+			},
+		},
+		"ASN.1 UTCTime string value is unknown": {
+			asn1UTCTime: timetypes.NewASN1UTCTimeUnknown(),
+			expectedDiags: diag.Diagnostics{
+				diag.NewErrorDiagnostic("ASN1UTCTime ValueRFC3339Time Error", "ASN.1 UTCTime string value is unknown"),
+			},
+		},
+		"2000s": {
+			asn1UTCTime:  timetypes.NewASN1UTCTimeValueMust("491231235959Z"),
+			expectedTime: time.Date(2049, time.December, 31, 23, 59, 59, 0, time.UTC),
+		},
+		"1900s": {
+			asn1UTCTime:  timetypes.NewASN1UTCTimeValueMust("500101000000Z"),
+			expectedTime: time.Date(1950, time.January, 1, 0, 0, 0, 0, time.UTC),
+		},
+	}
+	for name, testCase := range testCases {
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got, diags := testCase.asn1UTCTime.ValueRFC3339Time()
+
+			if !got.Equal(testCase.expectedTime) {
+				t.Errorf("Unexpected difference in time.Time, got: %s, expected: %s", got, testCase.expectedTime)
+			}
+
+			if diff := cmp.Diff(diags, testCase.expectedDiags); diff != "" {
+				t.Errorf("Unexpected diagnostics (-got, +expected): %s", diff)
+			}
+		})
+	}
+}
+
+func TestASN1UTCTime_ToRFC3339Value(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		asn1UTCTime   timetypes.ASN1UTCTime
+		expected      timetypes.RFC3339
+		expectedDiags diag.Diagnostics
+	}{
+		"null": {
+			asn1UTCTime: timetypes.NewASN1UTCTimeNull(),
+			expected:    timetypes.NewRFC3339Null(),
+		},
+		"unknown": {
+			asn1UTCTime: timetypes.NewASN1UTCTimeUnknown(),
+			expected:    timetypes.NewRFC3339Unknown(),
+		},
+		"valid": {
+			asn1UTCTime: timetypes.NewASN1UTCTimeValueMust("961016120000Z"),
+			expected:    timetypes.NewRFC3339ValueMust("1996-10-16T12:00:00Z"),
+		},
+	}
+	for name, testCase := range testCases {
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got, diags := testCase.asn1UTCTime.ToRFC3339Value()
+
+			if !got.Equal(testCase.expected) {
+				t.Errorf("Expected %s, got %s", testCase.expected, got)
+			}
+
+			if diff := cmp.Diff(diags, testCase.expectedDiags); diff != "" {
+				t.Errorf("Unexpected diagnostics (-got, +expected): %s", diff)
+			}
+		})
+	}
+}
+
+func TestASN1UTCTime_ToASN1GeneralizedTimeValue(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		asn1UTCTime   timetypes.ASN1UTCTime
+		expected      timetypes.ASN1GeneralizedTime
+		expectedDiags diag.Diagnostics
+	}{
+		"null": {
+			asn1UTCTime: timetypes.NewASN1UTCTimeNull(),
+			expected:    timetypes.NewASN1GeneralizedTimeNull(),
+		},
+		"unknown": {
+			asn1UTCTime: timetypes.NewASN1UTCTimeUnknown(),
+			expected:    timetypes.NewASN1GeneralizedTimeUnknown(),
+		},
+		"2000s": {
+			asn1UTCTime: timetypes.NewASN1UTCTimeValueMust("261016120000Z"),
+			expected:    timetypes.NewASN1GeneralizedTimeValueMust("20261016120000Z"),
+		},
+		"1900s": {
+			asn1UTCTime: timetypes.NewASN1UTCTimeValueMust("961016120000Z"),
+			expected:    timetypes.NewASN1GeneralizedTimeValueMust("19961016120000Z"),
+		},
+	}
+	for name, testCase := range testCases {
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got, diags := testCase.asn1UTCTime.ToASN1GeneralizedTimeValue()
+
+			if !got.Equal(testCase.expected) {
+				t.Errorf("Expected %s, got %s", testCase.expected, got)
+			}
+
+			if diff := cmp.Diff(diags, testCase.expectedDiags); diff != "" {
+				t.Errorf("Unexpected diagnostics (-got, +expected): %s", diff)
+			}
+		})
+	}
+}
+
+func TestNewASN1UTCTimeTimeValue(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		time          time.Time
+		expected      timetypes.ASN1UTCTime
+		expectedDiags diag.Diagnostics
+	}{
+		"UTC": {
+			time:     time.Date(2026, time.October, 16, 12, 0, 0, 0, time.UTC),
+			expected: timetypes.NewASN1UTCTimeValueMust("261016120000Z"),
+		},
+		"fixed zone with fractional seconds": {
+			time:     time.Date(2026, time.October, 16, 14, 0, 0, 999999999, time.FixedZone("", 2*60*60)),
+			expected: timetypes.NewASN1UTCTimeValueMust("261016120000Z"),
+		},
+		"out of range": {
+			time:     time.Date(2050, time.January, 1, 0, 0, 0, 0, time.UTC),
+			expected: timetypes.NewASN1UTCTimeUnknown(),
+			expectedDiags: diag.Diagnostics{
+				diag.NewErrorDiagnostic(
+					"Invalid ASN.1 UTCTime Time Value",
+					"A time value was provided that cannot be represented as an ASN.1 UTCTime. "+
+						"UTCTime values must represent a time between 1950-01-01T00:00:00Z and 2049-12-31T23:59:59Z. "+
+						"X.509 certificates represent times outside of this range as GeneralizedTime instead.\n\n"+
+						"Given Value: 2050-01-01T00:00:00Z\n"+
+						"Error: time 2050-01-01T00:00:00Z is out of range for UTCTime, which represents the years 1950 through 2049",
+				),
+			},
+		},
+	}
+	for name, testCase := range testCases {
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got, diags := timetypes.NewASN1UTCTimeTimeValue(testCase.time)
+
+			if !got.Equal(testCase.expected) {
+				t.Errorf("Expected %s, got %s", testCase.expected, got)
+			}
+
+			if diff := cmp.Diff(diags, testCase.expectedDiags); diff != "" {
+				t.Errorf("Unexpected diagnostics (-got, +expected): %s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,77 @@
+// Copyright IBM Corp. 2023, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package timetypes
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+var (
+	_ basetypes.StringTypable = (*CronExpressionType)(nil)
+)
+
+// CronExpressionType is an attribute type that represents a valid cron expression string, such as `0 0 * * *` or
+// `@daily`. Semantic equality logic is defined for CronExpressionType such that expressions describing the same
+// schedule are considered equal.
+type CronExpressionType struct {
+	basetypes.StringType
+}
+
+// String returns a human-readable string of the type name.
+func (t CronExpressionType) String() string {
+	return "timetypes.CronExpressionType"
+}
+
+// ValueType returns the Value type.
+func (t CronExpressionType) ValueType(ctx context.Context) attr.Value {
+	return CronExpression{}
+}
+
+// Equal returns true if the given type is equivalent.
+func (t CronExpressionType) Equal(o attr.Type) bool {
+	other, ok := o.(CronExpressionType)
+
+	if !ok {
+		return false
+	}
+
+	return t.StringType.Equal(other.StringType)
+}
+
+// ValueFromString returns a StringValuable type given a StringValue.
+func (t CronExpressionType) ValueFromString(ctx context.Context, in basetypes.StringValue) (basetypes.StringValuable, diag.Diagnostics) {
+	return CronExpression{
+		StringValue: in,
+	}, nil
+}
+
+// ValueFromTerraform returns a Value given a tftypes.Value.  This is meant to convert the tftypes.Value into a more convenient Go type
+// for the provider to consume the data with.
+func (t CronExpressionType) ValueFromTerraform(ctx context.Context, in tftypes.Value) (attr.Value, error) {
+	attrValue, err := t.StringType.ValueFromTerraform(ctx, in)
+
+	if err != nil {
+		return nil, err
+	}
+
+	stringValue, ok := attrValue.(basetypes.StringValue)
+
+	if !ok {
+		return nil, fmt.Errorf("unexpected value type of %T", attrValue)
+	}
+
+	stringValuable, diags := t.ValueFromString(ctx, stringValue)
+
+	if diags.HasError() {
+		return nil, fmt.Errorf("unexpected error converting StringValue to StringValuable: %v", diags)
+	}
+
+	return stringValuable, nil
+}
@@ -0,0 +1,548 @@
+// Copyright IBM Corp. 2023, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package timetypes
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/attr/xattr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+)
+
+var (
+	_ basetypes.StringValuableWithSemanticEquals = (*CronExpression)(nil)
+	_ xattr.ValidateableAttribute                = (*CronExpression)(nil)
+	_ function.ValidateableParameter             = (*CronExpression)(nil)
+)
+
+// cronExpressionSearchYears is the number of years after a given time that CronExpression.Next will search for a
+// matching time, such that schedules which can never match, such as `0 0 30 2 *`, do not search forever.
+const cronExpressionSearchYears = 10
+
+const (
+	// cronExpressionHours is the bit set of an hour field matching every hour.
+	cronExpressionHours uint64 = 1<<24 - 1
+
+	// cronExpressionDaysOfMonth is the bit set of a day of month field matching every day.
+	cronExpressionDaysOfMonth uint64 = 1<<32 - 1<<1
+
+	// cronExpressionDaysOfWeek is the bit set of a day of week field matching every day.
+	cronExpressionDaysOfWeek uint64 = 1<<7 - 1
+)
+
+// cronExpressionMacros are the supported cron expression macros and their equivalent 5-field cron expressions.
+var cronExpressionMacros = map[string]string{
+	"@yearly":   "0 0 1 1 *",
+	"@annually": "0 0 1 1 *",
+	"@monthly":  "0 0 1 * *",
+	"@weekly":   "0 0 * * 0",
+	"@daily":    "0 0 * * *",
+	"@midnight": "0 0 * * *",
+	"@hourly":   "0 * * * *",
+}
+
+// cronExpressionField describes a single field of a cron expression.
+type cronExpressionField struct {
+	name     string
+	min, max int
+
+	// names are the optional case-insensitive names of the field values, indexed from min.
+	names []string
+}
+
+// cronExpressionFields are the fields of a 6-field cron expression. A 5-field cron expression omits the seconds field.
+var cronExpressionFields = []cronExpressionField{
+	{name: "second", min: 0, max: 59},
+	{name: "minute", min: 0, max: 59},
+	{name: "hour", min: 0, max: 23},
+	{name: "day of month", min: 1, max: 31},
+	{name: "month", min: 1, max: 12, names: []string{"JAN", "FEB", "MAR", "APR", "MAY", "JUN", "JUL", "AUG", "SEP", "OCT", "NOV", "DEC"}},
+	{name: "day of week", min: 0, max: 7, names: []string{"SUN", "MON", "TUE", "WED", "THU", "FRI", "SAT"}},
+}
+
+// cronSchedule is a parsed cron expression, where each field is a bit set of the matching values.
+type cronSchedule struct {
+	second, minute, hour, dayOfMonth, month, dayOfWeek uint64
+
+	// eitherDay is true if a day matches when either day field matches, rather than both of them. This is only the
+	// case when neither day field starts with `*` or `?` and neither matches every day, as otherwise both behaviours
+	// match the same days, so that schedules matching the same times compare equal.
+	eitherDay bool
+}
+
+// CronExpression represents a valid cron expression string, such as `0 0 * * *` or `@daily`.
+//
+// Both the standard 5-field syntax of `minute hour day-of-month month day-of-week` and the 6-field syntax with a leading
+// seconds field are accepted. Each field may be `*`, a value, a range such as `1-5` or a comma-separated list of these,
+// optionally followed by a step such as `*/15`. The month and day of week fields also accept case-insensitive names,
+// such as `JAN` or `MON`, the day of week field accepts 7 as Sunday and the day fields accept `?` as an alias of `*`.
+// The macros `@yearly`, `@annually`, `@monthly`, `@weekly`, `@daily`, `@midnight` and `@hourly` are also accepted.
+//
+// As with traditional cron implementations, if both the day of month and day of week fields are restricted, that is
+// they do not start with `*` or `?`, a day matches if either field matches.
+type CronExpression struct {
+	basetypes.StringValue
+}
+
+// Type returns a CronExpressionType.
+func (v CronExpression) Type(_ context.Context) attr.Type {
+	return CronExpressionType{}
+}
+
+// Equal returns true if the given value is equivalent.
+func (v CronExpression) Equal(o attr.Value) bool {
+	other, ok := o.(CronExpression)
+
+	if !ok {
+		return false
+	}
+
+	return v.StringValue.Equal(other.StringValue)
+}
+
+// StringSemanticEquals returns true if the given cron expression string value is semantically equal to the current
+// cron expression string value. Both values are parsed into schedules and their matching values compared, so
+// expressions that match exactly the same times are considered equal. How the day fields are written only matters
+// where it changes whether a day must match either or both of them.
+//
+// Examples:
+//   - `0 0 * * *` is semantically equal to `@daily`
+//   - `0 0 * * *` is semantically equal to `0 0 0 * * *`
+//   - `0 0 * * 0` is semantically equal to `0 0 * * SUN` and `0 0 * * 7`
+//   - `*/15 * * * *` is semantically equal to `0,15,30,45 * * * *`
+//   - `0 0 * * *` is semantically equal to `0 0 * * 0-6` and `0 0 1-31 * *`
+//   - `0 0 1 * *` is semantically equal to `0 0 1 * ?`
+//
+// Counterexamples:
+//   - `0 0 * * *` is NOT semantically equal to `0 0 * * 1-5`
+//   - `0 0 1 * 1` is NOT semantically equal to `0 0 1 * */1`, as a day must match either day field in the former
+func (v CronExpression) StringSemanticEquals(_ context.Context, newValuable basetypes.StringValuable) (bool, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	newValue, ok := newValuable.(CronExpression)
+	if !ok {
+		diags.AddError(
+			"Semantic Equality Check Error",
+			"An unexpected value type was received while performing semantic equality checks. "+
+				"Please report this to the provider developers.\n\n"+
+				"Expected Value Type: "+fmt.Sprintf("%T", v)+"\n"+
+				"Got Value Type: "+fmt.Sprintf("%T", newValuable),
+		)
+
+		return false, diags
+	}
+
+	// Cron expression strings are already validated at this point, ignoring errors
+	currentSchedule, _ := parseCronExpression(v.ValueString())
+	newSchedule, _ := parseCronExpression(newValue.ValueString())
+
+	return currentSchedule == newSchedule, diags
+}
+
+// ValidateAttribute implements attribute value validation. This type requires the value to be a String value that
+// is a valid cron expression.
+func (v CronExpression) ValidateAttribute(ctx context.Context, req xattr.ValidateAttributeRequest, resp *xattr.ValidateAttributeResponse) {
+	if v.IsUnknown() || v.IsNull() {
+		return
+	}
+
+	if _, err := parseCronExpression(v.ValueString()); err != nil {
+		resp.Diagnostics.Append(diag.WithPath(req.Path, cronExpressionInvalidStringDiagnostic(v.ValueString(), err)))
+
+		return
+	}
+}
+
+// ValidateParameter implements provider-defined function parameter value validation. This type requires the value to
+// be a String value that is a valid cron expression.
+func (v CronExpression) ValidateParameter(ctx context.Context, req function.ValidateParameterRequest, resp *function.ValidateParameterResponse) {
+	if v.IsUnknown() || v.IsNull() {
+		return
+	}
+
+	if _, err := parseCronExpression(v.ValueString()); err != nil {
+		resp.Error = function.NewArgumentFuncError(
+			req.Position,
+			"Invalid Cron Expression String Value: "+
+				"A string value was provided that is not a valid cron expression, such as \"0 0 * * *\" or \"@daily\".\n\n"+
+				"Given Value: "+v.ValueString()+"\n"+
+				"Error: "+err.Error(),
+		)
+
+		return
+	}
+}
+
+// Next returns the first time strictly after the given time that matches the CronExpression, evaluated in the given
+// location, or UTC if nil. A null or unknown value, or a schedule that does not match any time within 10 years of the
+// given time, will produce an error diagnostic.
+//
+// Similar to Vixie cron, daylight saving time transitions are handled depending on the hour field:
+//   - If the hour field matches every hour, such as `30 * * * *`, the schedule follows elapsed time. Wall clock times
+//     skipped by a transition do not match and wall clock times repeated by a transition match twice.
+//   - Otherwise, such as `30 2 * * *`, the schedule matches each wall clock time once. Wall clock times skipped by a
+//     transition match after it, moved later by the length of the transition, and wall clock times repeated by a
+//     transition only match the earlier time.
+func (v CronExpression) Next(after time.Time, loc *time.Location) (time.Time, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	if v.IsNull() {
+		diags.Append(diag.NewErrorDiagnostic("CronExpression Next Error", "Cron expression string value is null"))
+		return time.Time{}, diags
+	}
+
+	if v.IsUnknown() {
+		diags.Append(diag.NewErrorDiagnostic("CronExpression Next Error", "Cron expression string value is unknown"))
+		return time.Time{}, diags
+	}
+
+	schedule, err := parseCronExpression(v.ValueString())
+	if err != nil {
+		diags.Append(diag.NewErrorDiagnostic("CronExpression Next Error", err.Error()))
+		return time.Time{}, diags
+	}
+
+	if loc == nil {
+		loc = time.UTC
+	}
+
+	next, ok := schedule.next(after, loc)
+	if !ok {
+		diags.Append(diag.NewErrorDiagnostic(
+			"CronExpression Next Error",
+			fmt.Sprintf("no time matching the cron expression was found within %d years after %s", cronExpressionSearchYears, after.Format(time.RFC3339)),
+		))
+		return time.Time{}, diags
+	}
+
+	return next, nil
+}
+
+// NewCronExpressionNull creates a CronExpression with a null value. Determine whether the value is null via IsNull method.
+func NewCronExpressionNull() CronExpression {
+	return CronExpression{
+		StringValue: basetypes.NewStringNull(),
+	}
+}
+
+// NewCronExpressionUnknown creates a CronExpression with an unknown value. Determine whether the value is unknown via IsUnknown method.
+func NewCronExpressionUnknown() CronExpression {
+	return CronExpression{
+		StringValue: basetypes.NewStringUnknown(),
+	}
+}
+
+// NewCronExpressionValue creates a CronExpression with a known value or raises
+// an error diagnostic if the string is not a valid cron expression.
+func NewCronExpressionValue(value string) (CronExpression, diag.Diagnostics) {
+	_, err := parseCronExpression(value)
+
+	if err != nil {
+		// Returning an unknown value will guarantee that, as a last resort,
+		// Terraform will return an error if attempting to store into state.
+		return NewCronExpressionUnknown(), diag.Diagnostics{cronExpressionInvalidStringDiagnostic(value, err)}
+	}
+
+	return CronExpression{
+		StringValue: basetypes.NewStringValue(value),
+	}, nil
+}
+
+// NewCronExpressionValueMust creates a CronExpression with a known value or
+// raises a panic if the string is not a valid cron expression.
+//
+// This creation function is only recommended to create CronExpression values which
+// either will not potentially affect practitioners, such as testing, or within
+// exhaustively tested provider logic.
+func NewCronExpressionValueMust(value string) CronExpression {
+	_, err := parseCronExpression(value)
+
+	if err != nil {
+		panic(fmt.Sprintf("Invalid Cron Expression String Value (%s): %s", value, err))
+	}
+
+	return CronExpression{
+		StringValue: basetypes.NewStringValue(value),
+	}
+}
+
+// NewCronExpressionPointerValue creates a CronExpression with a null value if
+// nil, a known value, or raises an error diagnostic if the string is not a
+// valid cron expression.
+func NewCronExpressionPointerValue(value *string) (CronExpression, diag.Diagnostics) {
+	if value == nil {
+		return NewCronExpressionNull(), nil
+	}
+
+	return NewCronExpressionValue(*value)
+}
+
+// NewCronExpressionPointerValueMust creates a CronExpression with a null value
+// if nil, a known value, or raises a panic if the string is not a valid cron
+// expression.
+//
+// This creation function is only recommended to create CronExpression values which
+// either will not potentially affect practitioners, such as testing, or within
+// exhaustively tested provider logic.
+func NewCronExpressionPointerValueMust(value *string) CronExpression {
+	if value == nil {
+		return NewCronExpressionNull()
+	}
+
+	return NewCronExpressionValueMust(*value)
+}
+
+// parseCronExpression parses a 5-field or 6-field cron expression or macro into a cronSchedule.
+func parseCronExpression(value string) (cronSchedule, error) {
+	fields := strings.Fields(value)
+
+	if len(fields) == 1 && strings.HasPrefix(fields[0], "@") {
+		expression, ok := cronExpressionMacros[strings.ToLower(fields[0])]
+		if !ok {
+			return cronSchedule{}, fmt.Errorf("unknown macro %q, expected one of \"@yearly\", \"@annually\", \"@monthly\", \"@weekly\", \"@daily\", \"@midnight\" or \"@hourly\"", fields[0])
+		}
+
+		fields = strings.Fields(expression)
+	}
+
+	switch len(fields) {
+	case 5:
+		fields = append([]string{"0"}, fields...)
+	case 6:
+	default:
+		return cronSchedule{}, fmt.Errorf("expected 5 or 6 fields or a macro such as \"@daily\", got %d fields", len(fields))
+	}
+
+	var bits [6]uint64
+
+	for i, field := range fields {
+		fieldBits, err := parseCronExpressionField(field, cronExpressionFields[i])
+		if err != nil {
+			return cronSchedule{}, fmt.Errorf("invalid %s field %q: %w", cronExpressionFields[i].name, field, err)
+		}
+
+		bits[i] = fieldBits
+	}
+
+	// Sunday may be either 0 or 7
+	if bits[5]&(1<<7) != 0 {
+		bits[5] = bits[5]&^(1<<7) | 1
+	}
+
+	schedule := cronSchedule{
+		second:     bits[0],
+		minute:     bits[1],
+		hour:       bits[2],
+		dayOfMonth: bits[3],
+		month:      bits[4],
+		dayOfWeek:  bits[5],
+		eitherDay:  !isCronExpressionStar(fields[3]) && !isCronExpressionStar(fields[5]),
+	}
+
+	// A day field matching every day makes every day match either day field
+	if schedule.eitherDay && (schedule.dayOfMonth == cronExpressionDaysOfMonth || schedule.dayOfWeek == cronExpressionDaysOfWeek) {
+		schedule.dayOfMonth = cronExpressionDaysOfMonth
+		schedule.dayOfWeek = cronExpressionDaysOfWeek
+		schedule.eitherDay = false
+	}
+
+	return schedule, nil
+}
+
+// isCronExpressionStar returns true if the cron expression field starts with `*` or `?`.
+func isCronExpressionStar(field string) bool {
+	return strings.HasPrefix(field, "*") || strings.HasPrefix(field, "?")
+}
+
+// parseCronExpressionField parses a comma-separated list of cron expression field items, such as `1-5,*/15`, into a
+// bit set of the matching values.
+func parseCronExpressionField(value string, field cronExpressionField) (uint64, error) {
+	var bits uint64
+
+	for _, item := range strings.Split(value, ",") {
+		rangeValue, stepValue, hasStep := strings.Cut(item, "/")
+
+		var lo, hi int
+
+		switch rangeValue {
+		case "?":
+			if field.name != "day of month" && field.name != "day of week" {
+				return 0, fmt.Errorf("%q is only allowed in the day of month and day of week fields", rangeValue)
+			}
+
+			fallthrough
+		case "*":
+			lo, hi = field.min, field.max
+		default:
+			loValue, hiValue, isRange := strings.Cut(rangeValue, "-")
+
+			var err error
+
+			if lo, err = parseCronExpressionValue(loValue, field); err != nil {
+				return 0, err
+			}
+
+			hi = lo
+
+			if isRange {
+				if hi, err = parseCronExpressionValue(hiValue, field); err != nil {
+					return 0, err
+				}
+
+				if lo > hi {
+					return 0, fmt.Errorf("range start %d is greater than range end %d", lo, hi)
+				}
+			} else if hasStep {
+				hi = field.max
+			}
+		}
+
+		step := 1
+
+		if hasStep {
+			var err error
+
+			if step, err = strconv.Atoi(stepValue); !isDigits(stepValue) || err != nil || step < 1 {
+				return 0, fmt.Errorf("expected a positive step, got %q", stepValue)
+			}
+		}
+
+		for i := lo; i <= hi; i += step {
+			bits |= 1 << i
+		}
+	}
+
+	return bits, nil
+}
+
+// parseCronExpressionValue parses a single cron expression field value, which is either a number or one of the
+// names of the field.
+func parseCronExpressionValue(value string, field cronExpressionField) (int, error) {
+	for i, name := range field.names {
+		if strings.EqualFold(value, name) {
+			return field.min + i, nil
+		}
+	}
+
+	if !isDigits(value) {
+		if field.names != nil {
+			return 0, fmt.Errorf("expected a number or name, got %q", value)
+		}
+
+		return 0, fmt.Errorf("expected a number, got %q", value)
+	}
+
+	number, err := strconv.Atoi(value)
+	if err != nil || number < field.min || number > field.max {
+		return 0, fmt.Errorf("value %s is out of range [%d, %d]", value, field.min, field.max)
+	}
+
+	return number, nil
+}
+
+// isDigits returns true if the given string is non-empty and only contains ASCII digits.
+func isDigits(value string) bool {
+	return value != "" && leadingDigits(value) == len(value)
+}
+
+// next returns the first time strictly after the given time that matches the schedule in the given location, or
+// false if there is no such time within cronExpressionSearchYears.
+//
+// If the hour field matches every hour, the schedule matches elapsed time, so wall clock times skipped by a daylight
+// saving time transition never match and repeated wall clock times match twice. Otherwise, similar to Vixie cron, the
+// schedule matches each wall clock time once, where skipped wall clock times match after the transition and repeated
+// wall clock times only match the earlier instant.
+func (s cronSchedule) next(after time.Time, loc *time.Location) (time.Time, bool) {
+	if s.hour == cronExpressionHours {
+		return s.nextElapsed(after.In(loc))
+	}
+
+	// Matching wall clock times are searched in UTC, which has no transitions. As skipped wall clock times move later
+	// when resolved, the search starts a day early and continues until the wall clock of the earliest instant found.
+	wallClock := cronWallClock(after.In(loc)).Add(-24 * time.Hour)
+
+	var next, nextWallClock time.Time
+
+	for {
+		var ok bool
+
+		wallClock, ok = s.nextElapsed(wallClock)
+		if !ok || (!next.IsZero() && !wallClock.Before(nextWallClock)) {
+			break
+		}
+
+		if t := resolveWallClock(wallClock, loc); t.After(after) && (next.IsZero() || t.Before(next)) {
+			next = t
+			nextWallClock = cronWallClock(t)
+		}
+	}
+
+	return next, !next.IsZero()
+}
+
+// cronWallClock returns the wall clock of the given time as a time in UTC.
+func cronWallClock(t time.Time) time.Time {
+	return time.Date(t.Year(), t.Month(), t.Day(), t.Hour(), t.Minute(), t.Second(), t.Nanosecond(), time.UTC)
+}
+
+// nextElapsed returns the first time strictly after the given time that matches the schedule in the location of the
+// given time, or false if there is no such time within cronExpressionSearchYears.
+func (s cronSchedule) nextElapsed(after time.Time) (time.Time, bool) {
+	loc := after.Location()
+	t := after.Truncate(time.Second).Add(time.Second)
+	yearLimit := t.Year() + cronExpressionSearchYears
+
+	for t.Year() <= yearLimit {
+		if s.month&(1<<t.Month()) == 0 {
+			t = time.Date(t.Year(), t.Month()+1, 1, 0, 0, 0, 0, loc)
+			continue
+		}
+
+		if !s.matchesDay(t) {
+			t = time.Date(t.Year(), t.Month(), t.Day()+1, 0, 0, 0, 0, loc)
+			continue
+		}
+
+		// Hours, minutes and seconds are advanced by duration, rather than by time.Date, so that wall clock times
+		// which are skipped or repeated by daylight saving time transitions are matched by elapsed time.
+		if s.hour&(1<<t.Hour()) == 0 {
+			t = t.Add(time.Hour - time.Duration(t.Minute())*time.Minute - time.Duration(t.Second())*time.Second)
+			continue
+		}
+
+		if s.minute&(1<<t.Minute()) == 0 {
+			t = t.Add(time.Minute - time.Duration(t.Second())*time.Second)
+			continue
+		}
+
+		if s.second&(1<<t.Second()) == 0 {
+			t = t.Add(time.Second)
+			continue
+		}
+
+		return t, true
+	}
+
+	return time.Time{}, false
+}
+
+// matchesDay returns true if the day of the given time matches the day of month and day of week fields of the
+// schedule.
+func (s cronSchedule) matchesDay(t time.Time) bool {
+	dayOfMonth := s.dayOfMonth&(1<<t.Day()) != 0
+	dayOfWeek := s.dayOfWeek&(1<<t.Weekday()) != 0
+
+	if s.eitherDay {
+		return dayOfMonth || dayOfWeek
+	}
+
+	return dayOfMonth && dayOfWeek
+}
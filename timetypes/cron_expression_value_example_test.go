@@ -0,0 +1,34 @@
+// Copyright IBM Corp. 2023, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package timetypes_test
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework-timetypes/timetypes"
+)
+
+type BackupPolicyResourceModel struct {
+	Schedule timetypes.CronExpression `tfsdk:"schedule"`
+}
+
+func ExampleCronExpression_Next() {
+	// For example purposes, typically the data model would be populated automatically by Plugin Framework via Config, Plan or State.
+	// https://developer.hashicorp.com/terraform/plugin/framework/handling-data/accessing-values
+	data := BackupPolicyResourceModel{
+		Schedule: timetypes.NewCronExpressionValueMust("30 2 * * MON-FRI"),
+	}
+
+	// Check that the cron expression data is known and able to compute the next scheduled time
+	if !data.Schedule.IsNull() && !data.Schedule.IsUnknown() {
+		next, diags := data.Schedule.Next(time.Date(2026, time.October, 16, 12, 0, 0, 0, time.UTC), time.UTC)
+		if diags.HasError() {
+			return
+		}
+
+		// Output: 2026-10-19T02:30:00Z
+		fmt.Println(next.Format(time.RFC3339))
+	}
+}
@@ -0,0 +1,469 @@
+// Copyright IBM Corp. 2023, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package timetypes_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-framework/attr/xattr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+
+	"github.com/hashicorp/terraform-plugin-framework-timetypes/timetypes"
+)
+
+func TestCronExpression_StringSemanticEquals(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		currentCronExpression timetypes.CronExpression
+		givenCronExpression   basetypes.StringValuable
+		expectedMatch         bool
+		expectedDiags         diag.Diagnostics
+	}{
+		"not equal - different schedules": {
+			currentCronExpression: timetypes.NewCronExpressionValueMust("0 0 * * *"),
+			givenCronExpression:   timetypes.NewCronExpressionValueMust("0 0 * * 1-5"),
+			expectedMatch:         false,
+		},
+		"not equal - restricted day of week matches either day field": {
+			currentCronExpression: timetypes.NewCronExpressionValueMust("0 0 1 * *"),
+			givenCronExpression:   timetypes.NewCronExpressionValueMust("0 0 1 * 0-6"),
+			expectedMatch:         false,
+		},
+		"not equal - restricted day fields match either day field": {
+			currentCronExpression: timetypes.NewCronExpressionValueMust("0 0 1 * 1"),
+			givenCronExpression:   timetypes.NewCronExpressionValueMust("0 0 1 * */1"),
+			expectedMatch:         false,
+		},
+		"not equal - stepped star and list of the same days": {
+			currentCronExpression: timetypes.NewCronExpressionValueMust("0 0 */10 * 1"),
+			givenCronExpression:   timetypes.NewCronExpressionValueMust("0 0 1,11,21,31 * MON"),
+			expectedMatch:         false,
+		},
+		"semantically equal - byte for byte match": {
+			currentCronExpression: timetypes.NewCronExpressionValueMust("0 0 * * *"),
+			givenCronExpression:   timetypes.NewCronExpressionValueMust("0 0 * * *"),
+			expectedMatch:         true,
+		},
+		"semantically equal - macro": {
+			currentCronExpression: timetypes.NewCronExpressionValueMust("0 0 * * *"),
+			givenCronExpression:   timetypes.NewCronExpressionValueMust("@daily"),
+			expectedMatch:         true,
+		},
+		"semantically equal - macro aliases": {
+			currentCronExpression: timetypes.NewCronExpressionValueMust("@YEARLY"),
+			givenCronExpression:   timetypes.NewCronExpressionValueMust("@annually"),
+			expectedMatch:         true,
+		},
+		"semantically equal - seconds field": {
+			currentCronExpression: timetypes.NewCronExpressionValueMust("0 0 * * *"),
+			givenCronExpression:   timetypes.NewCronExpressionValueMust("0 0 0 * * ?"),
+			expectedMatch:         true,
+		},
+		"semantically equal - names and Sunday as 7": {
+			currentCronExpression: timetypes.NewCronExpressionValueMust("0 0 * jan sun"),
+			givenCronExpression:   timetypes.NewCronExpressionValueMust("0 0 * 1 7"),
+			expectedMatch:         true,
+		},
+		"semantically equal - step and list": {
+			currentCronExpression: timetypes.NewCronExpressionValueMust("*/15 * * * *"),
+			givenCronExpression:   timetypes.NewCronExpressionValueMust("0,15,30,45  *  *  *  *"),
+			expectedMatch:         true,
+		},
+		"semantically equal - full day of week range and star": {
+			currentCronExpression: timetypes.NewCronExpressionValueMust("0 0 * * 0-6"),
+			givenCronExpression:   timetypes.NewCronExpressionValueMust("0 0 * * *"),
+			expectedMatch:         true,
+		},
+		"semantically equal - full day of month range and star": {
+			currentCronExpression: timetypes.NewCronExpressionValueMust("0 0 1-31 * *"),
+			givenCronExpression:   timetypes.NewCronExpressionValueMust("@daily"),
+			expectedMatch:         true,
+		},
+		"semantically equal - full day of month range and restricted day of week": {
+			currentCronExpression: timetypes.NewCronExpressionValueMust("0 0 1-31 * MON"),
+			givenCronExpression:   timetypes.NewCronExpressionValueMust("0 0 * * 0-7"),
+			expectedMatch:         true,
+		},
+		"semantically equal - star and question mark": {
+			currentCronExpression: timetypes.NewCronExpressionValueMust("0 0 1 * *"),
+			givenCronExpression:   timetypes.NewCronExpressionValueMust("0 0 1 * ?"),
+			expectedMatch:         true,
+		},
+		"error - not given CronExpression value": {
+			currentCronExpression: timetypes.NewCronExpressionValueMust("0 0 * * *"),
+			givenCronExpression:   basetypes.NewStringValue("0 0 * * *"),
+			expectedMatch:         false,
+			expectedDiags: diag.Diagnostics{
+				diag.NewErrorDiagnostic(
+					"Semantic Equality Check Error",
+					"An unexpected value type was received while performing semantic equality checks. "+
+						"Please report this to the provider developers.\n\n"+
+						"Expected Value Type: timetypes.CronExpression\n"+
+						"Got Value Type: basetypes.StringValue",
+				),
+			},
+		},
+	}
+	for name, testCase := range testCases {
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			match, diags := testCase.currentCronExpression.StringSemanticEquals(context.Background(), testCase.givenCronExpression)
+
+			if testCase.expectedMatch != match {
+				t.Errorf("Expected StringSemanticEquals to return: %t, but got: %t", testCase.expectedMatch, match)
+			}
+
+			if diff := cmp.Diff(diags, testCase.expectedDiags); diff != "" {
+				t.Errorf("Unexpected diagnostics (-got, +expected): %s", diff)
+			}
+		})
+	}
+}
+
+func TestCronExpressionValidateAttribute(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		cronExpression timetypes.CronExpression
+		expectedDiags  diag.Diagnostics
+	}{
+		"empty-struct": {
+			cronExpression: timetypes.CronExpression{},
+		},
+		"null": {
+			cronExpression: timetypes.NewCronExpressionNull(),
+		},
+		"unknown": {
+			cronExpression: timetypes.NewCronExpressionUnknown(),
+		},
+		"valid cron expression - 5 fields": {
+			cronExpression: timetypes.NewCronExpressionValueMust("*/5 9-17 * * MON-FRI"),
+		},
+		"valid cron expression - 6 fields": {
+			cronExpression: timetypes.NewCronExpressionValueMust("30 0 12 1,15 * ?"),
+		},
+		"valid cron expression - macro": {
+			cronExpression: timetypes.NewCronExpressionValueMust("@hourly"),
+		},
+		"invalid cron expression - field count": {
+			cronExpression: timetypes.CronExpression{
+				StringValue: basetypes.NewStringValue("0 0 * *"),
+			},
+			expectedDiags: diag.Diagnostics{
+				diag.NewAttributeErrorDiagnostic(
+					path.Root("test"),
+					"Invalid Cron Expression String Value",
+					"A string value was provided that is not a valid cron expression, such as \"0 0 * * *\" or \"@daily\".\n\n"+
+						"Given Value: 0 0 * *\n"+
+						"Error: expected 5 or 6 fields or a macro such as \"@daily\", got 4 fields",
+				),
+			},
+		},
+		"invalid cron expression - unknown macro": {
+			cronExpression: timetypes.CronExpression{
+				StringValue: basetypes.NewStringValue("@reboot"),
+			},
+			expectedDiags: diag.Diagnostics{
+				diag.NewAttributeErrorDiagnostic(
+					path.Root("test"),
+					"Invalid Cron Expression String Value",
+					"A string value was provided that is not a valid cron expression, such as \"0 0 * * *\" or \"@daily\".\n\n"+
+						"Given Value: @reboot\n"+
+						"Error: unknown macro \"@reboot\", expected one of \"@yearly\", \"@annually\", \"@monthly\", \"@weekly\", \"@daily\", \"@midnight\" or \"@hourly\"",
+				),
+			},
+		},
+		"invalid cron expression - minute out of range": {
+			cronExpression: timetypes.CronExpression{
+				StringValue: basetypes.NewStringValue("60 0 * * *"),
+			},
+			expectedDiags: diag.Diagnostics{
+				diag.NewAttributeErrorDiagnostic(
+					path.Root("test"),
+					"Invalid Cron Expression String Value",
+					"A string value was provided that is not a valid cron expression, such as \"0 0 * * *\" or \"@daily\".\n\n"+
+						"Given Value: 60 0 * * *\n"+
+						"Error: invalid minute field \"60\": value 60 is out of range [0, 59]",
+				),
+			},
+		},
+		"invalid cron expression - unknown day of week name": {
+			cronExpression: timetypes.CronExpression{
+				StringValue: basetypes.NewStringValue("0 0 * * MON-FRY"),
+			},
+			expectedDiags: diag.Diagnostics{
+				diag.NewAttributeErrorDiagnostic(
+					path.Root("test"),
+					"Invalid Cron Expression String Value",
+					"A string value was provided that is not a valid cron expression, such as \"0 0 * * *\" or \"@daily\".\n\n"+
+						"Given Value: 0 0 * * MON-FRY\n"+
+						"Error: invalid day of week field \"MON-FRY\": expected a number or name, got \"FRY\"",
+				),
+			},
+		},
+		"invalid cron expression - descending range": {
+			cronExpression: timetypes.CronExpression{
+				StringValue: basetypes.NewStringValue("0 0 17-9 * * *"),
+			},
+			expectedDiags: diag.Diagnostics{
+				diag.NewAttributeErrorDiagnostic(
+					path.Root("test"),
+					"Invalid Cron Expression String Value",
+					"A string value was provided that is not a valid cron expression, such as \"0 0 * * *\" or \"@daily\".\n\n"+
+						"Given Value: 0 0 17-9 * * *\n"+
+						"Error: invalid hour field \"17-9\": range start 17 is greater than range end 9",
+				),
+			},
+		},
+		"invalid cron expression - zero step": {
+			cronExpression: timetypes.CronExpression{
+				StringValue: basetypes.NewStringValue("*/0 * * * *"),
+			},
+			expectedDiags: diag.Diagnostics{
+				diag.NewAttributeErrorDiagnostic(
+					path.Root("test"),
+					"Invalid Cron Expression String Value",
+					"A string value was provided that is not a valid cron expression, such as \"0 0 * * *\" or \"@daily\".\n\n"+
+						"Given Value: */0 * * * *\n"+
+						"Error: invalid minute field \"*/0\": expected a positive step, got \"0\"",
+				),
+			},
+		},
+		"invalid cron expression - question mark outside day fields": {
+			cronExpression: timetypes.CronExpression{
+				StringValue: basetypes.NewStringValue("0 ? * * *"),
+			},
+			expectedDiags: diag.Diagnostics{
+				diag.NewAttributeErrorDiagnostic(
+					path.Root("test"),
+					"Invalid Cron Expression String Value",
+					"A string value was provided that is not a valid cron expression, such as \"0 0 * * *\" or \"@daily\".\n\n"+
+						"Given Value: 0 ? * * *\n"+
+						"Error: invalid hour field \"?\": \"?\" is only allowed in the day of month and day of week fields",
+				),
+			},
+		},
+	}
+	for name, testCase := range testCases {
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			resp := xattr.ValidateAttributeResponse{}
+
+			testCase.cronExpression.ValidateAttribute(
+				context.Background(),
+				xattr.ValidateAttributeRequest{
+					Path: path.Root("test"),
+				},
+				&resp,
+			)
+
+			if diff := cmp.Diff(resp.Diagnostics, testCase.expectedDiags); diff != "" {
+				t.Errorf("Unexpected diagnostics (-got, +expected): %s", diff)
+			}
+		})
+	}
+}
+
+func TestCronExpressionValidateParameter(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		cronExpression  timetypes.CronExpression
+		expectedFuncErr *function.FuncError
+	}{
+		"empty-struct": {
+			cronExpression: timetypes.CronExpression{},
+		},
+		"null": {
+			cronExpression: timetypes.NewCronExpressionNull(),
+		},
+		"unknown": {
+			cronExpression: timetypes.NewCronExpressionUnknown(),
+		},
+		"valid cron expression": {
+			cronExpression: timetypes.NewCronExpressionValueMust("0 0 * * *"),
+		},
+		"invalid cron expression": {
+			cronExpression: timetypes.CronExpression{
+				StringValue: basetypes.NewStringValue("0 0 32 * *"),
+			},
+			expectedFuncErr: function.NewArgumentFuncError(
+				0,
+				"Invalid Cron Expression String Value: "+
+					"A string value was provided that is not a valid cron expression, such as \"0 0 * * *\" or \"@daily\".\n\n"+
+					"Given Value: 0 0 32 * *\n"+
+					"Error: invalid day of month field \"32\": value 32 is out of range [1, 31]",
+			),
+		},
+	}
+	for name, testCase := range testCases {
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			resp := function.ValidateParameterResponse{}
+
+			testCase.cronExpression.ValidateParameter(
+				context.Background(),
+				function.ValidateParameterRequest{
+					Position: int64(0),
+				},
+				&resp,
+			)
+
+			if diff := cmp.Diff(resp.Error, testCase.expectedFuncErr); diff != "" {
+				t.Errorf("Unexpected diagnostics (-got, +expected): %s", diff)
+			}
+		})
+	}
+}
+
+func TestCronExpression_Next(t *testing.T) {
+	t.Parallel()
+
+	newYork, err := time.LoadLocation("America/New_York")
+	if err != nil {
+		t.Fatalf("Unexpected error loading location: %s", err)
+	}
+
+	after := time.Date(2026, time.October, 16, 12, 7, 30, 500, time.UTC)
+
+	testCases := map[string]struct {
+		cronExpression timetypes.CronExpression
+		after          time.Time
+		loc            *time.Location
+		expectedTime   time.Time
+		expectedDiags  diag.Diagnostics
+	}{
+		"cron expression string value is null": {
+			cronExpression: timetypes.NewCronExpressionNull(),
+			after:          after,
+			expectedDiags: diag.Diagnostics{
+				diag.NewErrorDiagnostic(
+					"CronExpression Next Error",
+					"Cron expression string value is null",
+				),
+			},
+		},
+		"cron expression string value is unknown": {
+			cronExpression: timetypes.NewCronExpressionUnknown(),
+			after:          after,
+			expectedDiags: diag.Diagnostics{
+				diag.NewErrorDiagnostic(
+					"CronExpression Next Error",
+					"Cron expression string value is unknown",
+				),
+			},
+		},
+		"daily": {
+			cronExpression: timetypes.NewCronExpressionValueMust("@daily"),
+			after:          after,
+			expectedTime:   time.Date(2026, time.October, 17, 0, 0, 0, 0, time.UTC),
+		},
+		"step": {
+			cronExpression: timetypes.NewCronExpressionValueMust("*/15 * * * *"),
+			after:          after,
+			expectedTime:   time.Date(2026, time.October, 16, 12, 15, 0, 0, time.UTC),
+		},
+		"seconds field": {
+			cronExpression: timetypes.NewCronExpressionValueMust("30 * * * * *"),
+			after:          time.Date(2026, time.October, 16, 12, 7, 30, 0, time.UTC),
+			expectedTime:   time.Date(2026, time.October, 16, 12, 8, 30, 0, time.UTC),
+		},
+		"weekdays": {
+			cronExpression: timetypes.NewCronExpressionValueMust("0 9 * * MON-FRI"),
+			after:          after,
+			expectedTime:   time.Date(2026, time.October, 19, 9, 0, 0, 0, time.UTC),
+		},
+		"day of month or day of week": {
+			cronExpression: timetypes.NewCronExpressionValueMust("0 0 1 * MON"),
+			after:          after,
+			expectedTime:   time.Date(2026, time.October, 19, 0, 0, 0, 0, time.UTC),
+		},
+		"leap day": {
+			cronExpression: timetypes.NewCronExpressionValueMust("0 0 29 FEB *"),
+			after:          after,
+			expectedTime:   time.Date(2028, time.February, 29, 0, 0, 0, 0, time.UTC),
+		},
+		"location": {
+			cronExpression: timetypes.NewCronExpressionValueMust("0 9 * * *"),
+			after:          after,
+			loc:            newYork,
+			expectedTime:   time.Date(2026, time.October, 16, 13, 0, 0, 0, time.UTC),
+		},
+		"location - skipped by daylight saving time": {
+			cronExpression: timetypes.NewCronExpressionValueMust("30 2 * * *"),
+			after:          time.Date(2026, time.March, 7, 12, 0, 0, 0, newYork),
+			loc:            newYork,
+			expectedTime:   time.Date(2026, time.March, 8, 7, 30, 0, 0, time.UTC),
+		},
+		"location - skipped by daylight saving time after the transition": {
+			cronExpression: timetypes.NewCronExpressionValueMust("0,45 2,3 * * *"),
+			after:          time.Date(2026, time.March, 8, 7, 0, 0, 0, time.UTC),
+			loc:            newYork,
+			expectedTime:   time.Date(2026, time.March, 8, 7, 45, 0, 0, time.UTC),
+		},
+		"location - repeated by daylight saving time": {
+			cronExpression: timetypes.NewCronExpressionValueMust("30 1 * * *"),
+			after:          time.Date(2026, time.November, 1, 5, 30, 0, 0, time.UTC),
+			loc:            newYork,
+			expectedTime:   time.Date(2026, time.November, 2, 6, 30, 0, 0, time.UTC),
+		},
+		"location - repeated by daylight saving time before the transition": {
+			cronExpression: timetypes.NewCronExpressionValueMust("30 1 * * *"),
+			after:          time.Date(2026, time.October, 31, 12, 0, 0, 0, newYork),
+			loc:            newYork,
+			expectedTime:   time.Date(2026, time.November, 1, 5, 30, 0, 0, time.UTC),
+		},
+		"location - every hour skipped by daylight saving time": {
+			cronExpression: timetypes.NewCronExpressionValueMust("30 * * * *"),
+			after:          time.Date(2026, time.March, 8, 6, 30, 0, 0, time.UTC),
+			loc:            newYork,
+			expectedTime:   time.Date(2026, time.March, 8, 7, 30, 0, 0, time.UTC),
+		},
+		"location - every hour repeated by daylight saving time": {
+			cronExpression: timetypes.NewCronExpressionValueMust("30 * * * *"),
+			after:          time.Date(2026, time.November, 1, 5, 30, 0, 0, time.UTC),
+			loc:            newYork,
+			expectedTime:   time.Date(2026, time.November, 1, 6, 30, 0, 0, time.UTC),
+		},
+		"no matching time": {
+			cronExpression: timetypes.NewCronExpressionValueMust("0 0 30 2 *"),
+			after:          after,
+			expectedDiags: diag.Diagnostics{
+				diag.NewErrorDiagnostic(
+					"CronExpression Next Error",
+					"no time matching the cron expression was found within 10 years after 2026-10-16T12:07:30Z",
+				),
+			},
+		},
+	}
+	for name, testCase := range testCases {
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			next, diags := testCase.cronExpression.Next(testCase.after, testCase.loc)
+
+			if !next.Equal(testCase.expectedTime) {
+				t.Errorf("Unexpected time, got: %s, expected: %s", next, testCase.expectedTime)
+			}
+
+			if diff := cmp.Diff(diags, testCase.expectedDiags); diff != "" {
+				t.Errorf("Unexpected diagnostics (-got, +expected): %s", diff)
+			}
+		})
+	}
+}
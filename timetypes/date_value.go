@@ -0,0 +1,200 @@
+// Copyright IBM Corp. 2023, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package timetypes
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/attr/xattr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+)
+
+var (
+	_ basetypes.StringValuable       = (*Date)(nil)
+	_ xattr.ValidateableAttribute    = (*Date)(nil)
+	_ function.ValidateableParameter = (*Date)(nil)
+)
+
+// Date represents a valid RFC 3339 full-date string, such as `2023-07-25`, which does not contain a time or offset.
+//
+// See RFC 3339 for more details on the string format: https://www.rfc-editor.org/rfc/rfc3339.html#section-5.6.
+type Date struct {
+	basetypes.StringValue
+}
+
+// Type returns a DateType.
+func (v Date) Type(_ context.Context) attr.Type {
+	return DateType{}
+}
+
+// Equal returns true if the given value is equivalent.
+func (v Date) Equal(o attr.Value) bool {
+	other, ok := o.(Date)
+
+	if !ok {
+		return false
+	}
+
+	return v.StringValue.Equal(other.StringValue)
+}
+
+// ValidateAttribute implements attribute value validation. This type requires the value to be a String value that
+// is a valid RFC 3339 full-date, including a valid day for the given month and year.
+func (v Date) ValidateAttribute(ctx context.Context, req xattr.ValidateAttributeRequest, resp *xattr.ValidateAttributeResponse) {
+	if v.IsUnknown() || v.IsNull() {
+		return
+	}
+
+	if _, err := time.Parse(time.DateOnly, v.ValueString()); err != nil {
+		resp.Diagnostics.Append(diag.WithPath(req.Path, dateInvalidStringDiagnostic(v.ValueString(), err)))
+
+		return
+	}
+}
+
+// ValidateParameter implements provider-defined function parameter value validation. This type requires the value to
+// be a String value that is a valid RFC 3339 full-date, including a valid day for the given month and year.
+func (v Date) ValidateParameter(ctx context.Context, req function.ValidateParameterRequest, resp *function.ValidateParameterResponse) {
+	if v.IsUnknown() || v.IsNull() {
+		return
+	}
+
+	if _, err := time.Parse(time.DateOnly, v.ValueString()); err != nil {
+		resp.Error = function.NewArgumentFuncError(
+			req.Position,
+			"Invalid Date String Value: "+
+				"A string value was provided that is not valid RFC 3339 full-date string format, such as \"2006-01-02\".\n\n"+
+				"Given Value: "+v.ValueString()+"\n"+
+				"Error: "+err.Error(),
+		)
+
+		return
+	}
+}
+
+// ValueDate creates a new time.Time instance with the Date StringValue at midnight in the given location. A nil location
+// is treated as UTC. A null or unknown value will produce an error diagnostic.
+func (v Date) ValueDate(loc *time.Location) (time.Time, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	if v.IsNull() {
+		diags.Append(diag.NewErrorDiagnostic("Date ValueDate Error", "Date string value is null"))
+		return time.Time{}, diags
+	}
+
+	if v.IsUnknown() {
+		diags.Append(diag.NewErrorDiagnostic("Date ValueDate Error", "Date string value is unknown"))
+		return time.Time{}, diags
+	}
+
+	if loc == nil {
+		loc = time.UTC
+	}
+
+	date, err := time.ParseInLocation(time.DateOnly, v.ValueString(), loc)
+	if err != nil {
+		diags.Append(diag.NewErrorDiagnostic("Date ValueDate Error", err.Error()))
+		return time.Time{}, diags
+	}
+
+	return date, nil
+}
+
+// NewDateNull creates a Date with a null value. Determine whether the value is null via IsNull method.
+func NewDateNull() Date {
+	return Date{
+		StringValue: basetypes.NewStringNull(),
+	}
+}
+
+// NewDateUnknown creates a Date with an unknown value. Determine whether the value is unknown via IsUnknown method.
+func NewDateUnknown() Date {
+	return Date{
+		StringValue: basetypes.NewStringUnknown(),
+	}
+}
+
+// NewDateTimeValue creates a Date with a known value. The date is taken from the time.Time in its own location and
+// any time of day is discarded.
+func NewDateTimeValue(value time.Time) Date {
+	return Date{
+		StringValue: basetypes.NewStringValue(value.Format(time.DateOnly)),
+	}
+}
+
+// NewDateTimePointerValue creates a Date with a null value if nil or
+// a known value. The date is taken from the time.Time in its own location and
+// any time of day is discarded.
+func NewDateTimePointerValue(value *time.Time) Date {
+	if value == nil {
+		return NewDateNull()
+	}
+
+	return Date{
+		StringValue: basetypes.NewStringValue(value.Format(time.DateOnly)),
+	}
+}
+
+// NewDateValue creates a Date with a known value or raises an error
+// diagnostic if the string is not RFC 3339 full-date format.
+func NewDateValue(value string) (Date, diag.Diagnostics) {
+	_, err := time.Parse(time.DateOnly, value)
+
+	if err != nil {
+		// Returning an unknown value will guarantee that, as a last resort,
+		// Terraform will return an error if attempting to store into state.
+		return NewDateUnknown(), diag.Diagnostics{dateInvalidStringDiagnostic(value, err)}
+	}
+
+	return Date{
+		StringValue: basetypes.NewStringValue(value),
+	}, nil
+}
+
+// NewDateValueMust creates a Date with a known value or raises a panic
+// if the string is not RFC 3339 full-date format.
+//
+// This creation function is only recommended to create Date values which
+// either will not potentially affect practitioners, such as testing, or within
+// exhaustively tested provider logic.
+func NewDateValueMust(value string) Date {
+	_, err := time.Parse(time.DateOnly, value)
+
+	if err != nil {
+		panic(fmt.Sprintf("Invalid Date String Value (%s): %s", value, err))
+	}
+
+	return Date{
+		StringValue: basetypes.NewStringValue(value),
+	}
+}
+
+// NewDatePointerValue creates a Date with a null value if nil, a known
+// value, or raises an error diagnostic if the string is not RFC 3339 full-date format.
+func NewDatePointerValue(value *string) (Date, diag.Diagnostics) {
+	if value == nil {
+		return NewDateNull(), nil
+	}
+
+	return NewDateValue(*value)
+}
+
+// NewDatePointerValueMust creates a Date with a null value if nil, a
+// known value, or raises a panic if the string is not RFC 3339 full-date format.
+//
+// This creation function is only recommended to create Date values which
+// either will not potentially affect practitioners, such as testing, or within
+// exhaustively tested provider logic.
+func NewDatePointerValueMust(value *string) Date {
+	if value == nil {
+		return NewDateNull()
+	}
+
+	return NewDateValueMust(*value)
+}
@@ -0,0 +1,34 @@
+// Copyright IBM Corp. 2023, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package timetypes_test
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework-timetypes/timetypes"
+)
+
+type DateResourceModel struct {
+	ExpiryDate timetypes.Date `tfsdk:"expiry_date"`
+}
+
+func ExampleDate_ValueDate() {
+	// For example purposes, typically the data model would be populated automatically by Plugin Framework via Config, Plan or State.
+	// https://developer.hashicorp.com/terraform/plugin/framework/handling-data/accessing-values
+	data := DateResourceModel{
+		ExpiryDate: timetypes.NewDateValueMust("2023-07-25"),
+	}
+
+	// Check that the date data is known and able to be converted to time.Time
+	if !data.ExpiryDate.IsNull() && !data.ExpiryDate.IsUnknown() {
+		t, diags := data.ExpiryDate.ValueDate(time.UTC)
+		if diags.HasError() {
+			return
+		}
+
+		// Output: 2023-07-25T00:00:00Z
+		fmt.Println(t.Format(time.RFC3339))
+	}
+}
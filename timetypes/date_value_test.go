@@ -0,0 +1,231 @@
+// Copyright IBM Corp. 2023, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package timetypes_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-framework/attr/xattr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+
+	"github.com/hashicorp/terraform-plugin-framework-timetypes/timetypes"
+)
+
+func TestDateValidateAttribute(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		Date          timetypes.Date
+		expectedDiags diag.Diagnostics
+	}{
+		"empty-struct": {
+			Date: timetypes.Date{},
+		},
+		"null": {
+			Date: timetypes.NewDateNull(),
+		},
+		"unknown": {
+			Date: timetypes.NewDateUnknown(),
+		},
+		"valid date": {
+			Date: timetypes.NewDateValueMust("2023-07-25"),
+		},
+		"valid date - leap day": {
+			Date: timetypes.NewDateValueMust("2024-02-29"),
+		},
+		"invalid date - not a leap year": {
+			Date: timetypes.Date{
+				StringValue: basetypes.NewStringValue("2023-02-29"),
+			},
+			expectedDiags: diag.Diagnostics{
+				diag.NewAttributeErrorDiagnostic(
+					path.Root("test"),
+					"Invalid Date String Value",
+					"A string value was provided that is not valid RFC 3339 full-date string format, such as \"2006-01-02\".\n\n"+
+						"Given Value: 2023-02-29\n"+
+						"Error: parsing time \"2023-02-29\": day out of range",
+				),
+			},
+		},
+		"invalid date - date-time": {
+			Date: timetypes.Date{
+				StringValue: basetypes.NewStringValue("2023-07-25T20:43:16Z"),
+			},
+			expectedDiags: diag.Diagnostics{
+				diag.NewAttributeErrorDiagnostic(
+					path.Root("test"),
+					"Invalid Date String Value",
+					"A string value was provided that is not valid RFC 3339 full-date string format, such as \"2006-01-02\".\n\n"+
+						"Given Value: 2023-07-25T20:43:16Z\n"+
+						"Error: parsing time \"2023-07-25T20:43:16Z\": extra text: \"T20:43:16Z\"",
+				),
+			},
+		},
+		"invalid date - single digit month": {
+			Date: timetypes.Date{
+				StringValue: basetypes.NewStringValue("2023-7-25"),
+			},
+			expectedDiags: diag.Diagnostics{
+				diag.NewAttributeErrorDiagnostic(
+					path.Root("test"),
+					"Invalid Date String Value",
+					"A string value was provided that is not valid RFC 3339 full-date string format, such as \"2006-01-02\".\n\n"+
+						"Given Value: 2023-7-25\n"+
+						"Error: parsing time \"2023-7-25\" as \"2006-01-02\": cannot parse \"7-25\" as \"01\"",
+				),
+			},
+		},
+	}
+	for name, testCase := range testCases {
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			resp := xattr.ValidateAttributeResponse{}
+
+			testCase.Date.ValidateAttribute(
+				context.Background(),
+				xattr.ValidateAttributeRequest{
+					Path: path.Root("test"),
+				},
+				&resp,
+			)
+
+			if diff := cmp.Diff(resp.Diagnostics, testCase.expectedDiags); diff != "" {
+				t.Errorf("Unexpected diagnostics (-got, +expected): %s", diff)
+			}
+		})
+	}
+}
+
+func TestDateValidateParameter(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		Date            timetypes.Date
+		expectedFuncErr *function.FuncError
+	}{
+		"empty-struct": {
+			Date: timetypes.Date{},
+		},
+		"null": {
+			Date: timetypes.NewDateNull(),
+		},
+		"unknown": {
+			Date: timetypes.NewDateUnknown(),
+		},
+		"valid date": {
+			Date: timetypes.NewDateValueMust("2023-07-25"),
+		},
+		"invalid date": {
+			Date: timetypes.Date{
+				StringValue: basetypes.NewStringValue("2023-13-01"),
+			},
+			expectedFuncErr: function.NewArgumentFuncError(
+				0,
+				"Invalid Date String Value: "+
+					"A string value was provided that is not valid RFC 3339 full-date string format, such as \"2006-01-02\".\n\n"+
+					"Given Value: 2023-13-01\n"+
+					"Error: parsing time \"2023-13-01\": month out of range",
+			),
+		},
+	}
+	for name, testCase := range testCases {
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			resp := function.ValidateParameterResponse{}
+
+			testCase.Date.ValidateParameter(
+				context.Background(),
+				function.ValidateParameterRequest{
+					Position: int64(0),
+				},
+				&resp,
+			)
+
+			if diff := cmp.Diff(resp.Error, testCase.expectedFuncErr); diff != "" {
+				t.Errorf("Unexpected diagnostics (-got, +expected): %s", diff)
+			}
+		})
+	}
+}
+
+func TestDate_ValueDate(t *testing.T) {
+	t.Parallel()
+
+	berlin, err := time.LoadLocation("Europe/Berlin")
+	if err != nil {
+		t.Fatalf("Unexpected error loading location: %s", err)
+	}
+
+	testCases := map[string]struct {
+		Date          timetypes.Date
+		location      *time.Location
+		expectedTime  time.Time
+		expectedDiags diag.Diagnostics
+	}{
+		"Date string value is null ": {
+			Date: timetypes.NewDateNull(),
+			expectedDiags: diag.Diagnostics{
+				diag.NewErrorDiagnostic(
+					"Date ValueDate Error",
+					"Date string value is null",
+				),
+			},
+		},
+		"Date string value is unknown ": {
+			Date: timetypes.NewDateUnknown(),
+			expectedDiags: diag.Diagnostics{
+				diag.NewErrorDiagnostic(
+					"Date ValueDate Error",
+					"Date string value is unknown",
+				),
+			},
+		},
+		"valid date - nil location": {
+			Date:         timetypes.NewDateValueMust("2023-07-25"),
+			expectedTime: time.Date(2023, time.July, 25, 0, 0, 0, 0, time.UTC),
+		},
+		"valid date - location": {
+			Date:         timetypes.NewDateValueMust("2023-07-25"),
+			location:     berlin,
+			expectedTime: time.Date(2023, time.July, 25, 0, 0, 0, 0, berlin),
+		},
+	}
+	for name, testCase := range testCases {
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got, diags := testCase.Date.ValueDate(testCase.location)
+
+			if !got.Equal(testCase.expectedTime) || got.Location() != testCase.expectedTime.Location() {
+				t.Errorf("Unexpected difference in time.Time, got: %s, expected: %s", got, testCase.expectedTime)
+			}
+
+			if diff := cmp.Diff(diags, testCase.expectedDiags); diff != "" {
+				t.Errorf("Unexpected diagnostics (-got, +expected): %s", diff)
+			}
+		})
+	}
+}
+
+func TestNewDateTimeValue(t *testing.T) {
+	t.Parallel()
+
+	got := timetypes.NewDateTimeValue(time.Date(2023, time.July, 25, 23, 43, 16, 0, time.FixedZone("", -4*60*60)))
+	expected := timetypes.NewDateValueMust("2023-07-25")
+
+	if !got.Equal(expected) {
+		t.Errorf("Expected %s, got %s", expected, got)
+	}
+}
@@ -3,7 +3,14 @@
 
 package timetypes
 
-import "github.com/hashicorp/terraform-plugin-framework/diag"
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+)
 
 // rfc3339InvalidStringDiagnostic returns an error diagnostic intended to report
 // when a string is not RFC3339 format.
@@ -28,3 +35,372 @@ func goDurationInvalidStringDiagnostic(value string, err error) diag.Diagnostic
 			"Error: "+err.Error(),
 	)
 }
+
+// iso8601DurationInvalidStringDiagnostic returns an error diagnostic intended to report
+// when a string is not an ISO 8601 duration.
+func iso8601DurationInvalidStringDiagnostic(value string, err error) diag.Diagnostic {
+	return diag.NewErrorDiagnostic(
+		"Invalid ISO 8601 Duration String Value",
+		"A string value was provided that is not valid ISO 8601 duration string format. "+
+			`An ISO 8601 duration has the format "PnYnMnWnDTnHnMnS", such as "P1DT2H30M" or "PT0.5S".`+"\n\n"+
+			"Given Value: "+value+"\n"+
+			"Error: "+err.Error(),
+	)
+}
+
+// iso8601DurationInvalidComponentsDiagnostic returns an error diagnostic intended to report
+// when ISO 8601 duration components cannot be expressed as an ISO 8601 duration string.
+func iso8601DurationInvalidComponentsDiagnostic(value ISO8601DurationComponents, err error) diag.Diagnostic {
+	return diag.NewErrorDiagnostic(
+		"Invalid ISO 8601 Duration Components Value",
+		"ISO 8601 duration components were provided that cannot be expressed as an ISO 8601 duration string. "+
+			"ISO 8601 durations cannot be negative.\n\n"+
+			"Given Value: "+fmt.Sprintf("Years: %d, Months: %d, Days: %d, Duration: %s", value.Years, value.Months, value.Days, value.Duration)+"\n"+
+			"Error: "+err.Error(),
+	)
+}
+
+// dateInvalidStringDiagnostic returns an error diagnostic intended to report
+// when a string is not an RFC 3339 full-date.
+func dateInvalidStringDiagnostic(value string, err error) diag.Diagnostic {
+	return diag.NewErrorDiagnostic(
+		"Invalid Date String Value",
+		"A string value was provided that is not valid RFC 3339 full-date string format, such as \"2006-01-02\".\n\n"+
+			"Given Value: "+value+"\n"+
+			"Error: "+err.Error(),
+	)
+}
+
+// timeOfDayInvalidStringDiagnostic returns an error diagnostic intended to report
+// when a string is not a time of day.
+func timeOfDayInvalidStringDiagnostic(value string, err error) diag.Diagnostic {
+	return diag.NewErrorDiagnostic(
+		"Invalid Time of Day String Value",
+		"A string value was provided that is not valid time of day string format. "+
+			`A time of day has the format "15:04", "15:04:05" or "15:04:05.123", optionally followed by a "Z" or "+07:00" UTC offset.`+"\n\n"+
+			"Given Value: "+value+"\n"+
+			"Error: "+err.Error(),
+	)
+}
+
+// timeZoneInvalidStringDiagnostic returns an error diagnostic intended to report
+// when a string is not an IANA time zone database name.
+func timeZoneInvalidStringDiagnostic(value string, err error) diag.Diagnostic {
+	return diag.NewErrorDiagnostic(
+		"Invalid Time Zone String Value",
+		"A string value was provided that is not a valid IANA time zone database name, such as \"Europe/Berlin\" or \"America/New_York\".\n\n"+
+			"Given Value: "+value+"\n"+
+			"Error: "+err.Error(),
+	)
+}
+
+// timeZoneDeprecatedAliasDiagnostic returns a warning diagnostic intended to report
+// when a string is a deprecated alias of an IANA time zone database name.
+func timeZoneDeprecatedAliasDiagnostic(value string, canonical string) diag.Diagnostic {
+	return diag.NewWarningDiagnostic(
+		"Deprecated Time Zone Name",
+		"A string value was provided that is a deprecated alias in the IANA time zone database. "+
+			"Remote systems may report the canonical time zone name instead.\n\n"+
+			"Given Value: "+value+"\n"+
+			"Canonical Value: "+canonical,
+	)
+}
+
+// utcOffsetInvalidStringDiagnostic returns an error diagnostic intended to report
+// when a string is not a UTC offset.
+func utcOffsetInvalidStringDiagnostic(value string, err error) diag.Diagnostic {
+	return diag.NewErrorDiagnostic(
+		"Invalid UTC Offset String Value",
+		"A string value was provided that is not valid UTC offset string format. "+
+			`A UTC offset has the format "Z", "+05:30" or "-08:00".`+"\n\n"+
+			"Given Value: "+value+"\n"+
+			"Error: "+err.Error(),
+	)
+}
+
+// utcOffsetInvalidSecondsDiagnostic returns an error diagnostic intended to report
+// when an integer number of seconds is not a valid UTC offset.
+func utcOffsetInvalidSecondsDiagnostic(value int, err error) diag.Diagnostic {
+	return diag.NewErrorDiagnostic(
+		"Invalid UTC Offset Seconds Value",
+		"An integer value was provided that is not a valid UTC offset in seconds. "+
+			"UTC offsets must be a whole number of minutes.\n\n"+
+			"Given Value: "+strconv.Itoa(value)+"\n"+
+			"Error: "+err.Error(),
+	)
+}
+
+// unixTimestampInvalidValueDiagnostic returns an error diagnostic intended to report
+// when an integer is not a Unix timestamp within the supported range.
+func unixTimestampInvalidValueDiagnostic(value int64, err error) diag.Diagnostic {
+	return diag.NewErrorDiagnostic(
+		"Invalid Unix Timestamp Value",
+		"An integer value was provided that is not a valid Unix timestamp. "+
+			"Unix timestamps must represent a time between 0000-01-01T00:00:00Z and 9999-12-31T23:59:59Z.\n\n"+
+			"Given Value: "+strconv.FormatInt(value, 10)+"\n"+
+			"Error: "+err.Error(),
+	)
+}
+
+// unixTimestampInvalidTimeDiagnostic returns an error diagnostic intended to report
+// when a time cannot be represented as a Unix timestamp.
+func unixTimestampInvalidTimeDiagnostic(value time.Time, err error) diag.Diagnostic {
+	return diag.NewErrorDiagnostic(
+		"Invalid Unix Timestamp Time Value",
+		"A time value was provided that cannot be represented as a Unix timestamp. "+
+			"Unix timestamps must represent a time between 0000-01-01T00:00:00Z and 9999-12-31T23:59:59Z.\n\n"+
+			"Given Value: "+value.Format(time.RFC3339Nano)+"\n"+
+			"Error: "+err.Error(),
+	)
+}
+
+// secondsDurationInvalidValueDiagnostic returns an error diagnostic intended to report
+// when an integer is not a duration within the supported range.
+func secondsDurationInvalidValueDiagnostic(value int64, err error) diag.Diagnostic {
+	return diag.NewErrorDiagnostic(
+		"Invalid Seconds Duration Value",
+		"An integer value was provided that is not a valid duration. "+
+			"Durations must be within the range of a Go time duration, which is approximately 292 years.\n\n"+
+			"Given Value: "+strconv.FormatInt(value, 10)+"\n"+
+			"Error: "+err.Error(),
+	)
+}
+
+// secondsDurationInvalidDurationDiagnostic returns an error diagnostic intended to report
+// when a duration cannot be represented as a whole number of a unit.
+func secondsDurationInvalidDurationDiagnostic(value time.Duration, err error) diag.Diagnostic {
+	return diag.NewErrorDiagnostic(
+		"Invalid Seconds Duration Conversion",
+		"A duration value was provided that cannot be represented as a whole number of the duration unit.\n\n"+
+			"Given Value: "+value.String()+"\n"+
+			"Error: "+err.Error(),
+	)
+}
+
+// httpDateInvalidStringDiagnostic returns an error diagnostic intended to report
+// when a string is not HTTP date format.
+func httpDateInvalidStringDiagnostic(value string, err error) diag.Diagnostic {
+	return diag.NewErrorDiagnostic(
+		"Invalid HTTP Date String Value",
+		"A string value was provided that is not valid HTTP date string format, such as \"Sun, 06 Nov 1994 08:49:37 GMT\".\n\n"+
+			"Given Value: "+value+"\n"+
+			"Error: "+err.Error(),
+	)
+}
+
+// rfc5322DateInvalidStringDiagnostic returns an error diagnostic intended to report
+// when a string is not RFC 5322 date-time format.
+func rfc5322DateInvalidStringDiagnostic(value string, err error) diag.Diagnostic {
+	return diag.NewErrorDiagnostic(
+		"Invalid RFC 5322 Date String Value",
+		"A string value was provided that is not valid RFC 5322 date-time string format, such as \"Fri, 16 Oct 2026 12:00:00 +0200\".\n\n"+
+			"Given Value: "+value+"\n"+
+			"Error: "+err.Error(),
+	)
+}
+
+// cronExpressionInvalidStringDiagnostic returns an error diagnostic intended to report
+// when a string is not a valid cron expression.
+func cronExpressionInvalidStringDiagnostic(value string, err error) diag.Diagnostic {
+	return diag.NewErrorDiagnostic(
+		"Invalid Cron Expression String Value",
+		"A string value was provided that is not a valid cron expression, such as \"0 0 * * *\" or \"@daily\".\n\n"+
+			"Given Value: "+value+"\n"+
+			"Error: "+err.Error(),
+	)
+}
+
+// iso8601IntervalInvalidStringDiagnostic returns an error diagnostic intended to report
+// when a string is not an ISO 8601 time interval.
+func iso8601IntervalInvalidStringDiagnostic(value string, err error) diag.Diagnostic {
+	return diag.NewErrorDiagnostic(
+		"Invalid ISO 8601 Interval String Value",
+		"A string value was provided that is not valid ISO 8601 interval string format. "+
+			`An ISO 8601 interval has the format "start/end", "start/duration" or "duration/end", such as "2026-10-16T00:00:00Z/P1D".`+"\n\n"+
+			"Given Value: "+value+"\n"+
+			"Error: "+err.Error(),
+	)
+}
+
+// iso8601RepeatingIntervalInvalidStringDiagnostic returns an error diagnostic intended to report
+// when a string is not an ISO 8601 repeating interval.
+func iso8601RepeatingIntervalInvalidStringDiagnostic(value string, err error) diag.Diagnostic {
+	return diag.NewErrorDiagnostic(
+		"Invalid ISO 8601 Repeating Interval String Value",
+		"A string value was provided that is not valid ISO 8601 repeating interval string format. "+
+			`An ISO 8601 repeating interval has the format "Rn/start/duration" or "Rn/start/end", where n is optional, such as "R5/2026-10-16T00:00:00Z/PT1H".`+"\n\n"+
+			"Given Value: "+value+"\n"+
+			"Error: "+err.Error(),
+	)
+}
+
+// recurrenceRuleInvalidStringDiagnostic returns an error diagnostic intended to report
+// when a string is not an RFC 5545 recurrence rule.
+func recurrenceRuleInvalidStringDiagnostic(value string, err error) diag.Diagnostic {
+	return diag.NewErrorDiagnostic(
+		"Invalid Recurrence Rule String Value",
+		"A string value was provided that is not a valid RFC 5545 recurrence rule, such as \"FREQ=WEEKLY;BYDAY=MO,WE;BYHOUR=3\".\n\n"+
+			"Given Value: "+value+"\n"+
+			"Error: "+err.Error(),
+	)
+}
+
+// extendedGoDurationInvalidStringDiagnostic returns an error diagnostic intended to report
+// when a string is not an extended Go time duration.
+func extendedGoDurationInvalidStringDiagnostic(value string, err error) diag.Diagnostic {
+	return diag.NewErrorDiagnostic(
+		"Invalid Extended Go Time Duration String Value",
+		"A string value was provided that is not a valid extended Go Time Duration string format. "+
+			`A duration string is a sequence of numbers, each with optional fraction and a unit suffix, such as "7d", "-1.5h" or "2w3d12h". `+
+			`Valid time units are "ns", "us" (or "µs"), "ms", "s", "m", "h", "d" (24 hours), "w" (168 hours).`+"\n\n"+
+			"Given Value: "+value+"\n"+
+			"Error: "+err.Error(),
+	)
+}
+
+// weekDateInvalidStringDiagnostic returns an error diagnostic intended to report
+// when a string is not an ISO 8601 week date.
+func weekDateInvalidStringDiagnostic(value string, err error) diag.Diagnostic {
+	return diag.NewErrorDiagnostic(
+		"Invalid Week Date String Value",
+		"A string value was provided that is not valid ISO 8601 week date string format, such as \"2026-W42\" or \"2026-W42-5\".\n\n"+
+			"Given Value: "+value+"\n"+
+			"Error: "+err.Error(),
+	)
+}
+
+// ordinalDateInvalidStringDiagnostic returns an error diagnostic intended to report
+// when a string is not an ISO 8601 ordinal date.
+func ordinalDateInvalidStringDiagnostic(value string, err error) diag.Diagnostic {
+	return diag.NewErrorDiagnostic(
+		"Invalid Ordinal Date String Value",
+		"A string value was provided that is not valid ISO 8601 ordinal date string format, such as \"2026-289\".\n\n"+
+			"Given Value: "+value+"\n"+
+			"Error: "+err.Error(),
+	)
+}
+
+// yearMonthInvalidStringDiagnostic returns an error diagnostic intended to report
+// when a string is not an ISO 8601 year and month.
+func yearMonthInvalidStringDiagnostic(value string, err error) diag.Diagnostic {
+	return diag.NewErrorDiagnostic(
+		"Invalid Year Month String Value",
+		"A string value was provided that is not valid ISO 8601 year and month string format, such as \"2026-10\".\n\n"+
+			"Given Value: "+value+"\n"+
+			"Error: "+err.Error(),
+	)
+}
+
+// yearQuarterInvalidStringDiagnostic returns an error diagnostic intended to report
+// when a string is not a year and quarter.
+func yearQuarterInvalidStringDiagnostic(value string, err error) diag.Diagnostic {
+	return diag.NewErrorDiagnostic(
+		"Invalid Year Quarter String Value",
+		"A string value was provided that is not valid year and quarter string format, such as \"2026-Q4\".\n\n"+
+			"Given Value: "+value+"\n"+
+			"Error: "+err.Error(),
+	)
+}
+
+// strictRFC3339InvalidStringDiagnostic returns an error diagnostic intended to report
+// when a string does not conform to the RFC 3339 date-time grammar.
+func strictRFC3339InvalidStringDiagnostic(value string, err error) diag.Diagnostic {
+	return diag.NewErrorDiagnostic(
+		"Invalid Strict RFC3339 String Value",
+		"A string value was provided that is not valid RFC 3339 date-time string format, such as \"2026-10-16T20:43:16Z\".\n\n"+
+			"Given Value: "+value+"\n"+
+			"Error: "+err.Error(),
+	)
+}
+
+// localDateTimeInvalidStringDiagnostic returns an error diagnostic intended to report
+// when a string is not an ISO 8601 date and time without a UTC offset.
+func localDateTimeInvalidStringDiagnostic(value string, err error) diag.Diagnostic {
+	return diag.NewErrorDiagnostic(
+		"Invalid Local Date Time String Value",
+		"A string value was provided that is not valid ISO 8601 local date and time string format, such as \"2026-10-16T09:00:00\".\n\n"+
+			"Given Value: "+value+"\n"+
+			"Error: "+err.Error(),
+	)
+}
+
+// rfc9557InvalidStringDiagnostic returns an error diagnostic intended to report
+// when a string is not an RFC 9557 date-time with a bracketed time zone.
+func rfc9557InvalidStringDiagnostic(value string, err error) diag.Diagnostic {
+	return diag.NewErrorDiagnostic(
+		"Invalid RFC9557 String Value",
+		"A string value was provided that is not valid RFC 9557 date-time string format with a bracketed time zone, such as \"2026-10-16T12:00:00+02:00[Europe/Paris]\".\n\n"+
+			"Given Value: "+value+"\n"+
+			"Error: "+err.Error(),
+	)
+}
+
+// lenientRFC3339InvalidStringDiagnostic returns an error diagnostic intended to report
+// when a string is not RFC 3339 or an accepted ISO 8601 spelling.
+func lenientRFC3339InvalidStringDiagnostic(value string, err error) diag.Diagnostic {
+	return diag.NewErrorDiagnostic(
+		"Invalid Lenient RFC3339 String Value",
+		"A string value was provided that is not valid RFC 3339 string format or an accepted ISO 8601 spelling, such as \"2026-10-16T12:00:00Z\" or \"2026-10-16 12:00Z\".\n\n"+
+			"Given Value: "+value+"\n"+
+			"Error: "+err.Error(),
+	)
+}
+
+// lenientRFC3339NormalizedDiagnostic returns a warning diagnostic intended to report
+// when a string is an accepted ISO 8601 spelling that is not canonical RFC 3339.
+func lenientRFC3339NormalizedDiagnostic(value string, canonical string, normalizations []string) diag.Diagnostic {
+	return diag.NewWarningDiagnostic(
+		"Non-Canonical RFC3339 String Value",
+		"A string value was provided that is not in canonical RFC 3339 format and was normalized. "+
+			"Remote systems may report the canonical value instead.\n\n"+
+			"Given Value: "+value+"\n"+
+			"Canonical Value: "+canonical+"\n"+
+			"Normalization: "+strings.Join(normalizations, ", "),
+	)
+}
+
+// iso8601BasicDateTimeInvalidStringDiagnostic returns an error diagnostic intended to report
+// when a string is not ISO 8601 basic date and time format with a UTC offset.
+func iso8601BasicDateTimeInvalidStringDiagnostic(value string, err error) diag.Diagnostic {
+	return diag.NewErrorDiagnostic(
+		"Invalid ISO 8601 Basic Date Time String Value",
+		"A string value was provided that is not valid ISO 8601 basic date and time string format with a UTC offset, such as \"20261016T120000Z\" or \"20261016T120000+0200\".\n\n"+
+			"Given Value: "+value+"\n"+
+			"Error: "+err.Error(),
+	)
+}
+
+// asn1UTCTimeInvalidStringDiagnostic returns an error diagnostic intended to report
+// when a string is not DER encoded ASN.1 UTCTime format.
+func asn1UTCTimeInvalidStringDiagnostic(value string, err error) diag.Diagnostic {
+	return diag.NewErrorDiagnostic(
+		"Invalid ASN.1 UTCTime String Value",
+		"A string value was provided that is not valid DER encoded ASN.1 UTCTime string format, such as \"261016120000Z\".\n\n"+
+			"Given Value: "+value+"\n"+
+			"Error: "+err.Error(),
+	)
+}
+
+// asn1UTCTimeInvalidTimeDiagnostic returns an error diagnostic intended to report
+// when a time cannot be represented as an ASN.1 UTCTime.
+func asn1UTCTimeInvalidTimeDiagnostic(value time.Time, err error) diag.Diagnostic {
+	return diag.NewErrorDiagnostic(
+		"Invalid ASN.1 UTCTime Time Value",
+		"A time value was provided that cannot be represented as an ASN.1 UTCTime. "+
+			"UTCTime values must represent a time between 1950-01-01T00:00:00Z and 2049-12-31T23:59:59Z. "+
+			"X.509 certificates represent times outside of this range as GeneralizedTime instead.\n\n"+
+			"Given Value: "+value.Format(time.RFC3339Nano)+"\n"+
+			"Error: "+err.Error(),
+	)
+}
+
+// asn1GeneralizedTimeInvalidStringDiagnostic returns an error diagnostic intended to report
+// when a string is not DER encoded ASN.1 GeneralizedTime format.
+func asn1GeneralizedTimeInvalidStringDiagnostic(value string, err error) diag.Diagnostic {
+	return diag.NewErrorDiagnostic(
+		"Invalid ASN.1 GeneralizedTime String Value",
+		"A string value was provided that is not valid DER encoded ASN.1 GeneralizedTime string format, such as \"20261016120000Z\".\n\n"+
+			"Given Value: "+value+"\n"+
+			"Error: "+err.Error(),
+	)
+}
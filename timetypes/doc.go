@@ -1,5 +1,6 @@
 // Copyright IBM Corp. 2023, 2026
 // SPDX-License-Identifier: MPL-2.0
 
-// Package timetypes contains Terraform Plugin Framework Custom Type implementations for Time strings, such as RFC 3339.
+// Package timetypes contains Terraform Plugin Framework Custom Type implementations for time values, such as RFC 3339
+// strings and Unix timestamps.
 package timetypes
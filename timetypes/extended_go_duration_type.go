@@ -0,0 +1,77 @@
+// Copyright IBM Corp. 2023, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package timetypes
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+var (
+	_ basetypes.StringTypable = (*ExtendedGoDurationType)(nil)
+)
+
+// ExtendedGoDurationType is an attribute type that represents a valid Go time duration string, which additionally
+// accepts the "d" (24 hours) and "w" (168 hours) units, such as `7d` or `2w3d12h`.
+// See https://pkg.go.dev/time#ParseDuration for more details
+type ExtendedGoDurationType struct {
+	basetypes.StringType
+}
+
+// String returns a human-readable string of the type name.
+func (t ExtendedGoDurationType) String() string {
+	return "timetypes.ExtendedGoDurationType"
+}
+
+// ValueType returns the Value type.
+func (t ExtendedGoDurationType) ValueType(ctx context.Context) attr.Value {
+	return ExtendedGoDuration{}
+}
+
+// Equal returns true if the given type is equivalent.
+func (t ExtendedGoDurationType) Equal(o attr.Type) bool {
+	other, ok := o.(ExtendedGoDurationType)
+
+	if !ok {
+		return false
+	}
+
+	return t.StringType.Equal(other.StringType)
+}
+
+// ValueFromString returns a StringValuable type given a StringValue.
+func (t ExtendedGoDurationType) ValueFromString(ctx context.Context, in basetypes.StringValue) (basetypes.StringValuable, diag.Diagnostics) {
+	return ExtendedGoDuration{
+		StringValue: in,
+	}, nil
+}
+
+// ValueFromTerraform returns a Value given a tftypes.Value.  This is meant to convert the tftypes.Value into a more convenient Go type
+// for the provider to consume the data with.
+func (t ExtendedGoDurationType) ValueFromTerraform(ctx context.Context, in tftypes.Value) (attr.Value, error) {
+	attrValue, err := t.StringType.ValueFromTerraform(ctx, in)
+
+	if err != nil {
+		return nil, err
+	}
+
+	stringValue, ok := attrValue.(basetypes.StringValue)
+
+	if !ok {
+		return nil, fmt.Errorf("unexpected value type of %T", attrValue)
+	}
+
+	stringValuable, diags := t.ValueFromString(ctx, stringValue)
+
+	if diags.HasError() {
+		return nil, fmt.Errorf("unexpected error converting StringValue to StringValuable: %v", diags)
+	}
+
+	return stringValuable, nil
+}
@@ -0,0 +1,320 @@
+// Copyright IBM Corp. 2023, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package timetypes
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/attr/xattr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+)
+
+var (
+	_ basetypes.StringValuableWithSemanticEquals = (*ExtendedGoDuration)(nil)
+	_ xattr.ValidateableAttribute                = (*ExtendedGoDuration)(nil)
+	_ function.ValidateableParameter             = (*ExtendedGoDuration)(nil)
+)
+
+// extendedGoDurationUnits are the units accepted by ExtendedGoDuration which are not accepted by time.ParseDuration,
+// mapped to their fixed length.
+var extendedGoDurationUnits = map[string]time.Duration{
+	"d": 24 * time.Hour,
+	"w": 7 * 24 * time.Hour,
+}
+
+// ExtendedGoDuration represents a valid Go time duration string, which additionally accepts the "d" and "w" units,
+// such as `7d` or `2w3d12h`. Days are always 24 hours and weeks are always 168 hours, regardless of daylight saving
+// time, and all units accepted by time.ParseDuration are also accepted.
+// See https://pkg.go.dev/time#ParseDuration for more details
+type ExtendedGoDuration struct {
+	basetypes.StringValue
+}
+
+// Type returns an ExtendedGoDurationType.
+func (d ExtendedGoDuration) Type(_ context.Context) attr.Type {
+	return ExtendedGoDurationType{}
+}
+
+// Equal returns true if the given value is equivalent.
+func (d ExtendedGoDuration) Equal(o attr.Value) bool {
+	other, ok := o.(ExtendedGoDuration)
+
+	if !ok {
+		return false
+	}
+
+	return d.StringValue.Equal(other.StringValue)
+}
+
+// ValidateAttribute implements attribute value validation. This type requires the value to be a String value that
+// is a valid extended Go time duration.
+func (d ExtendedGoDuration) ValidateAttribute(ctx context.Context, req xattr.ValidateAttributeRequest, resp *xattr.ValidateAttributeResponse) {
+	if d.IsUnknown() || d.IsNull() {
+		return
+	}
+
+	if _, err := parseExtendedGoDuration(d.ValueString()); err != nil {
+		resp.Diagnostics.Append(diag.WithPath(req.Path, extendedGoDurationInvalidStringDiagnostic(d.ValueString(), err)))
+
+		return
+	}
+}
+
+// ValidateParameter implements provider-defined function parameter value validation. This type requires the value to
+// be a String value that is a valid extended Go time duration.
+func (d ExtendedGoDuration) ValidateParameter(ctx context.Context, req function.ValidateParameterRequest, resp *function.ValidateParameterResponse) {
+	if d.IsUnknown() || d.IsNull() {
+		return
+	}
+
+	if _, err := parseExtendedGoDuration(d.ValueString()); err != nil {
+		resp.Error = function.NewArgumentFuncError(
+			req.Position,
+			"Invalid Extended Go Time Duration String Value: "+
+				"A string value was provided that is not a valid extended Go Time Duration string format. "+
+				`A duration string is a sequence of numbers, each with optional fraction and a unit suffix, such as "7d", "-1.5h" or "2w3d12h". `+
+				`Valid time units are "ns", "us" (or "µs"), "ms", "s", "m", "h", "d" (24 hours), "w" (168 hours).`+"\n\n"+
+				"Given Value: "+d.ValueString()+"\n"+
+				"Error: "+err.Error(),
+		)
+
+		return
+	}
+}
+
+// ValueGoDuration creates a new time.Duration instance with the extended time duration StringValue. A null or unknown
+// value will produce an error diagnostic.
+func (d ExtendedGoDuration) ValueGoDuration() (time.Duration, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	if d.IsNull() {
+		diags.Append(diag.NewErrorDiagnostic("Extended Go Duration ValueGoDuration Error", "Duration string value is null"))
+		return time.Duration(0), diags
+	}
+
+	if d.IsUnknown() {
+		diags.Append(diag.NewErrorDiagnostic("Extended Go Duration ValueGoDuration Error", "Duration string value is unknown"))
+		return time.Duration(0), diags
+	}
+
+	duration, err := parseExtendedGoDuration(d.ValueString())
+	if err != nil {
+		diags.Append(diag.NewErrorDiagnostic("Extended Go Duration ValueGoDuration Error", err.Error()))
+		return time.Duration(0), diags
+	}
+
+	return duration, nil
+}
+
+// ToGoDurationValue converts the ExtendedGoDuration to a GoDuration value, such as `168h0m0s` for `1w`. Null and
+// unknown values are converted to null and unknown GoDuration values respectively.
+func (d ExtendedGoDuration) ToGoDurationValue() (GoDuration, diag.Diagnostics) {
+	if d.IsNull() {
+		return NewGoDurationNull(), nil
+	}
+
+	if d.IsUnknown() {
+		return NewGoDurationUnknown(), nil
+	}
+
+	duration, diags := d.ValueGoDuration()
+	if diags.HasError() {
+		return NewGoDurationUnknown(), diags
+	}
+
+	return NewGoDurationValue(duration), nil
+}
+
+// StringSemanticEquals returns true if the given ExtendedGoDuration or GoDuration string value is semantically equal
+// to the current ExtendedGoDuration string value. It ensures that two duration values are semantically equal even if
+// their string representations are different, such as `1w`, `7d` and `168h`.
+func (d ExtendedGoDuration) StringSemanticEquals(ctx context.Context, newValuable basetypes.StringValuable) (bool, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	var newDuration time.Duration
+
+	switch newValue := newValuable.(type) {
+	case ExtendedGoDuration:
+		newDuration, _ = newValue.ValueGoDuration()
+	case GoDuration:
+		newDuration, _ = newValue.ValueGoDuration()
+	default:
+		diags.AddError(
+			"Semantic Equality Check Error",
+			"An unexpected value type was received while performing semantic equality checks. "+
+				"Please report this to the provider developers.\n\n"+
+				"Expected Value Type: "+fmt.Sprintf("%T", d)+"\n"+
+				"Got Value Type: "+fmt.Sprintf("%T", newValuable),
+		)
+
+		return false, diags
+	}
+
+	priorDuration, _ := d.ValueGoDuration()
+
+	return priorDuration == newDuration, diags
+}
+
+// NewExtendedGoDurationNull creates an ExtendedGoDuration with a null value. Determine whether the value is null via IsNull method.
+func NewExtendedGoDurationNull() ExtendedGoDuration {
+	return ExtendedGoDuration{
+		StringValue: basetypes.NewStringNull(),
+	}
+}
+
+// NewExtendedGoDurationUnknown creates an ExtendedGoDuration with an unknown value. Determine whether the value is unknown via IsUnknown method.
+func NewExtendedGoDurationUnknown() ExtendedGoDuration {
+	return ExtendedGoDuration{
+		StringValue: basetypes.NewStringUnknown(),
+	}
+}
+
+// NewExtendedGoDurationValue creates an ExtendedGoDuration with a known value, which is formatted without the "d" and
+// "w" units, such as `168h0m0s`.
+func NewExtendedGoDurationValue(value time.Duration) ExtendedGoDuration {
+	return ExtendedGoDuration{
+		StringValue: basetypes.NewStringValue(value.String()),
+	}
+}
+
+// NewExtendedGoDurationPointerValue creates an ExtendedGoDuration with a null value if nil or
+// a known value.
+func NewExtendedGoDurationPointerValue(value *time.Duration) ExtendedGoDuration {
+	if value == nil {
+		return NewExtendedGoDurationNull()
+	}
+
+	return NewExtendedGoDurationValue(*value)
+}
+
+// NewExtendedGoDurationValueFromString creates an ExtendedGoDuration with a known value or raises an error
+// diagnostic if the string is not extended Go duration format.
+func NewExtendedGoDurationValueFromString(value string) (ExtendedGoDuration, diag.Diagnostics) {
+	_, err := parseExtendedGoDuration(value)
+
+	if err != nil {
+		// Returning an unknown value will guarantee that, as a last resort,
+		// Terraform will return an error if attempting to store into state.
+		return NewExtendedGoDurationUnknown(), diag.Diagnostics{extendedGoDurationInvalidStringDiagnostic(value, err)}
+	}
+
+	return ExtendedGoDuration{
+		StringValue: basetypes.NewStringValue(value),
+	}, nil
+}
+
+// NewExtendedGoDurationValueFromStringMust creates an ExtendedGoDuration with a known value or raises a panic
+// if the string is not extended Go duration format.
+//
+// This creation function is only recommended to create ExtendedGoDuration values which
+// either will not potentially affect practitioners, such as testing, or within
+// exhaustively tested provider logic.
+func NewExtendedGoDurationValueFromStringMust(value string) ExtendedGoDuration {
+	_, err := parseExtendedGoDuration(value)
+
+	if err != nil {
+		panic(fmt.Sprintf("Invalid Extended Go Duration String Value (%s): %s", value, err))
+	}
+
+	return ExtendedGoDuration{
+		StringValue: basetypes.NewStringValue(value),
+	}
+}
+
+// NewExtendedGoDurationValueFromPointerString creates an ExtendedGoDuration with a null value if nil, a known
+// value, or raises an error diagnostic if the string is not extended Go duration format.
+func NewExtendedGoDurationValueFromPointerString(value *string) (ExtendedGoDuration, diag.Diagnostics) {
+	if value == nil {
+		return NewExtendedGoDurationNull(), nil
+	}
+
+	return NewExtendedGoDurationValueFromString(*value)
+}
+
+// NewExtendedGoDurationValueFromPointerStringMust creates an ExtendedGoDuration with a null value if nil, a
+// known value, or raises a panic if the string is not extended Go duration format.
+//
+// This creation function is only recommended to create ExtendedGoDuration values which
+// either will not potentially affect practitioners, such as testing, or within
+// exhaustively tested provider logic.
+func NewExtendedGoDurationValueFromPointerStringMust(value *string) ExtendedGoDuration {
+	if value == nil {
+		return NewExtendedGoDurationNull()
+	}
+
+	return NewExtendedGoDurationValueFromStringMust(*value)
+}
+
+// parseExtendedGoDuration parses a Go time duration string which may include the "d" and "w" units. Values without
+// these units are parsed by time.ParseDuration, otherwise each number and unit is parsed by time.ParseDuration in turn,
+// with days and weeks parsed as hours and then scaled.
+func parseExtendedGoDuration(value string) (time.Duration, error) {
+	if !strings.ContainsAny(value, "dw") {
+		return time.ParseDuration(value)
+	}
+
+	rest := value
+	negative := false
+
+	if rest != "" && (rest[0] == '-' || rest[0] == '+') {
+		negative = rest[0] == '-'
+		rest = rest[1:]
+	}
+
+	var total time.Duration
+
+	for rest != "" {
+		numberLength := strings.IndexFunc(rest, func(r rune) bool {
+			return (r < '0' || r > '9') && r != '.'
+		})
+
+		if numberLength == -1 {
+			return 0, fmt.Errorf("missing unit in duration %q", value)
+		}
+
+		unitLength := strings.IndexAny(rest[numberLength:], "0123456789.")
+		if unitLength == -1 {
+			unitLength = len(rest) - numberLength
+		}
+
+		number, unit := rest[:numberLength], rest[numberLength:numberLength+unitLength]
+		rest = rest[numberLength+unitLength:]
+
+		if number == "" {
+			return 0, fmt.Errorf("expected a number before unit %q in duration %q", unit, value)
+		}
+
+		scale, ok := extendedGoDurationUnits[unit]
+		if !ok {
+			scale = 1
+		} else {
+			unit = "h"
+			scale /= time.Hour
+		}
+
+		component, err := time.ParseDuration(number + unit)
+		if err != nil {
+			return 0, fmt.Errorf("invalid duration %q: %w", value, err)
+		}
+
+		if component > math.MaxInt64/scale || total > math.MaxInt64-component*scale {
+			return 0, fmt.Errorf("duration %q is out of range", value)
+		}
+
+		total += component * scale
+	}
+
+	if negative {
+		total = -total
+	}
+
+	return total, nil
+}
@@ -0,0 +1,33 @@
+// Copyright IBM Corp. 2023, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package timetypes_test
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework-timetypes/timetypes"
+)
+
+type RetentionResourceModel struct {
+	Retention timetypes.ExtendedGoDuration `tfsdk:"retention"`
+}
+
+func ExampleExtendedGoDuration_ValueGoDuration() {
+	// For example purposes, typically the data model would be populated automatically by Plugin Framework via Config, Plan or State.
+	// https://developer.hashicorp.com/terraform/plugin/framework/handling-data/accessing-values
+	data := RetentionResourceModel{
+		Retention: timetypes.NewExtendedGoDurationValueFromStringMust("2w3d"),
+	}
+
+	// Check that the duration data is known and able to be converted to time.Duration
+	if !data.Retention.IsNull() && !data.Retention.IsUnknown() {
+		t, diags := data.Retention.ValueGoDuration()
+		if diags.HasError() {
+			return
+		}
+
+		// Output: 408h0m0s
+		fmt.Println(t.String())
+	}
+}
@@ -0,0 +1,350 @@
+// Copyright IBM Corp. 2023, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package timetypes_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-framework/attr/xattr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+
+	"github.com/hashicorp/terraform-plugin-framework-timetypes/timetypes"
+)
+
+func TestExtendedGoDuration_StringSemanticEquals(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		currentDuration timetypes.ExtendedGoDuration
+		givenDuration   basetypes.StringValuable
+		expectedMatch   bool
+		expectedDiags   diag.Diagnostics
+	}{
+		"not equal - different durations": {
+			currentDuration: timetypes.NewExtendedGoDurationValueFromStringMust("7d"),
+			givenDuration:   timetypes.NewExtendedGoDurationValueFromStringMust("7h"),
+			expectedMatch:   false,
+		},
+		"not equal - different GoDuration": {
+			currentDuration: timetypes.NewExtendedGoDurationValueFromStringMust("1d"),
+			givenDuration:   timetypes.NewGoDurationValueFromStringMust("1h"),
+			expectedMatch:   false,
+		},
+		"semantically equal - exactly the same string": {
+			currentDuration: timetypes.NewExtendedGoDurationValueFromStringMust("2w3d12h"),
+			givenDuration:   timetypes.NewExtendedGoDurationValueFromStringMust("2w3d12h"),
+			expectedMatch:   true,
+		},
+		"semantically equal - weeks and days": {
+			currentDuration: timetypes.NewExtendedGoDurationValueFromStringMust("1w"),
+			givenDuration:   timetypes.NewExtendedGoDurationValueFromStringMust("7d"),
+			expectedMatch:   true,
+		},
+		"semantically equal - days and hours": {
+			currentDuration: timetypes.NewExtendedGoDurationValueFromStringMust("1.5d"),
+			givenDuration:   timetypes.NewExtendedGoDurationValueFromStringMust("36h"),
+			expectedMatch:   true,
+		},
+		"semantically equal - GoDuration": {
+			currentDuration: timetypes.NewExtendedGoDurationValueFromStringMust("7d"),
+			givenDuration:   timetypes.NewGoDurationValueFromStringMust("168h0m0s"),
+			expectedMatch:   true,
+		},
+		"error - not given ExtendedGoDuration or GoDuration value": {
+			currentDuration: timetypes.NewExtendedGoDurationValueFromStringMust("7d"),
+			givenDuration:   basetypes.NewStringValue("7d"),
+			expectedMatch:   false,
+			expectedDiags: diag.Diagnostics{
+				diag.NewErrorDiagnostic(
+					"Semantic Equality Check Error",
+					"An unexpected value type was received while performing semantic equality checks. "+
+						"Please report this to the provider developers.\n\n"+
+						"Expected Value Type: timetypes.ExtendedGoDuration\n"+
+						"Got Value Type: basetypes.StringValue",
+				),
+			},
+		},
+	}
+	for name, testCase := range testCases {
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			match, diags := testCase.currentDuration.StringSemanticEquals(context.Background(), testCase.givenDuration)
+
+			if testCase.expectedMatch != match {
+				t.Errorf("Expected StringSemanticEquals to return: %t, but got: %t", testCase.expectedMatch, match)
+			}
+
+			if diff := cmp.Diff(diags, testCase.expectedDiags); diff != "" {
+				t.Errorf("Unexpected diagnostics (-got, +expected): %s", diff)
+			}
+		})
+	}
+}
+
+func TestExtendedGoDurationValidateAttribute(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		duration      timetypes.ExtendedGoDuration
+		expectedDiags diag.Diagnostics
+	}{
+		"empty-struct": {
+			duration: timetypes.ExtendedGoDuration{},
+		},
+		"null": {
+			duration: timetypes.NewExtendedGoDurationNull(),
+		},
+		"unknown": {
+			duration: timetypes.NewExtendedGoDurationUnknown(),
+		},
+		"valid extended Go duration - Go duration": {
+			duration: timetypes.NewExtendedGoDurationValueFromStringMust("-1.5h30us"),
+		},
+		"valid extended Go duration - days and weeks": {
+			duration: timetypes.NewExtendedGoDurationValueFromStringMust("2w3d12h"),
+		},
+		"invalid extended Go duration - unknown unit": {
+			duration: timetypes.ExtendedGoDuration{
+				StringValue: basetypes.NewStringValue("1y"),
+			},
+			expectedDiags: diag.Diagnostics{
+				diag.NewAttributeErrorDiagnostic(
+					path.Root("test"),
+					"Invalid Extended Go Time Duration String Value",
+					"A string value was provided that is not a valid extended Go Time Duration string format. "+
+						`A duration string is a sequence of numbers, each with optional fraction and a unit suffix, such as "7d", "-1.5h" or "2w3d12h". `+
+						`Valid time units are "ns", "us" (or "µs"), "ms", "s", "m", "h", "d" (24 hours), "w" (168 hours).`+"\n\n"+
+						"Given Value: 1y\n"+
+						"Error: time: unknown unit \"y\" in duration \"1y\"",
+				),
+			},
+		},
+		"invalid extended Go duration - missing unit": {
+			duration: timetypes.ExtendedGoDuration{
+				StringValue: basetypes.NewStringValue("1d12"),
+			},
+			expectedDiags: diag.Diagnostics{
+				diag.NewAttributeErrorDiagnostic(
+					path.Root("test"),
+					"Invalid Extended Go Time Duration String Value",
+					"A string value was provided that is not a valid extended Go Time Duration string format. "+
+						`A duration string is a sequence of numbers, each with optional fraction and a unit suffix, such as "7d", "-1.5h" or "2w3d12h". `+
+						`Valid time units are "ns", "us" (or "µs"), "ms", "s", "m", "h", "d" (24 hours), "w" (168 hours).`+"\n\n"+
+						"Given Value: 1d12\n"+
+						"Error: missing unit in duration \"1d12\"",
+				),
+			},
+		},
+		"invalid extended Go duration - missing number": {
+			duration: timetypes.ExtendedGoDuration{
+				StringValue: basetypes.NewStringValue("1wd"),
+			},
+			expectedDiags: diag.Diagnostics{
+				diag.NewAttributeErrorDiagnostic(
+					path.Root("test"),
+					"Invalid Extended Go Time Duration String Value",
+					"A string value was provided that is not a valid extended Go Time Duration string format. "+
+						`A duration string is a sequence of numbers, each with optional fraction and a unit suffix, such as "7d", "-1.5h" or "2w3d12h". `+
+						`Valid time units are "ns", "us" (or "µs"), "ms", "s", "m", "h", "d" (24 hours), "w" (168 hours).`+"\n\n"+
+						"Given Value: 1wd\n"+
+						"Error: invalid duration \"1wd\": time: unknown unit \"wd\" in duration \"1wd\"",
+				),
+			},
+		},
+		"invalid extended Go duration - out of range": {
+			duration: timetypes.ExtendedGoDuration{
+				StringValue: basetypes.NewStringValue("20000w"),
+			},
+			expectedDiags: diag.Diagnostics{
+				diag.NewAttributeErrorDiagnostic(
+					path.Root("test"),
+					"Invalid Extended Go Time Duration String Value",
+					"A string value was provided that is not a valid extended Go Time Duration string format. "+
+						`A duration string is a sequence of numbers, each with optional fraction and a unit suffix, such as "7d", "-1.5h" or "2w3d12h". `+
+						`Valid time units are "ns", "us" (or "µs"), "ms", "s", "m", "h", "d" (24 hours), "w" (168 hours).`+"\n\n"+
+						"Given Value: 20000w\n"+
+						"Error: duration \"20000w\" is out of range",
+				),
+			},
+		},
+	}
+	for name, testCase := range testCases {
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			resp := xattr.ValidateAttributeResponse{}
+
+			testCase.duration.ValidateAttribute(
+				context.Background(),
+				xattr.ValidateAttributeRequest{
+					Path: path.Root("test"),
+				},
+				&resp,
+			)
+
+			if diff := cmp.Diff(resp.Diagnostics, testCase.expectedDiags); diff != "" {
+				t.Errorf("Unexpected diagnostics (-got, +expected): %s", diff)
+			}
+		})
+	}
+}
+
+func TestExtendedGoDurationValidateParameter(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		duration        timetypes.ExtendedGoDuration
+		expectedFuncErr *function.FuncError
+	}{
+		"empty-struct": {
+			duration: timetypes.ExtendedGoDuration{},
+		},
+		"null": {
+			duration: timetypes.NewExtendedGoDurationNull(),
+		},
+		"unknown": {
+			duration: timetypes.NewExtendedGoDurationUnknown(),
+		},
+		"valid extended Go duration": {
+			duration: timetypes.NewExtendedGoDurationValueFromStringMust("7d"),
+		},
+		"invalid extended Go duration": {
+			duration: timetypes.ExtendedGoDuration{
+				StringValue: basetypes.NewStringValue("d"),
+			},
+			expectedFuncErr: function.NewArgumentFuncError(
+				0,
+				"Invalid Extended Go Time Duration String Value: "+
+					"A string value was provided that is not a valid extended Go Time Duration string format. "+
+					`A duration string is a sequence of numbers, each with optional fraction and a unit suffix, such as "7d", "-1.5h" or "2w3d12h". `+
+					`Valid time units are "ns", "us" (or "µs"), "ms", "s", "m", "h", "d" (24 hours), "w" (168 hours).`+"\n\n"+
+					"Given Value: d\n"+
+					"Error: expected a number before unit \"d\" in duration \"d\"",
+			),
+		},
+	}
+	for name, testCase := range testCases {
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			resp := function.ValidateParameterResponse{}
+
+			testCase.duration.ValidateParameter(
+				context.Background(),
+				function.ValidateParameterRequest{
+					Position: int64(0),
+				},
+				&resp,
+			)
+
+			if diff := cmp.Diff(resp.Error, testCase.expectedFuncErr); diff != "" {
+				t.Errorf("Unexpected diagnostics (-got, +expected): %s", diff)
+			}
+		})
+	}
+}
+
+func TestExtendedGoDuration_ValueGoDuration(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		duration         timetypes.ExtendedGoDuration
+		expectedDuration time.Duration
+		expectedDiags    diag.Diagnostics
+	}{
+		"duration string value is null": {
+			duration: timetypes.NewExtendedGoDurationNull(),
+			expectedDiags: diag.Diagnostics{
+				diag.NewErrorDiagnostic("Extended Go Duration ValueGoDuration Error", "Duration string value is null"),
+			},
+		},
+		"duration string value is unknown": {
+			duration: timetypes.NewExtendedGoDurationUnknown(),
+			expectedDiags: diag.Diagnostics{
+				diag.NewErrorDiagnostic("Extended Go Duration ValueGoDuration Error", "Duration string value is unknown"),
+			},
+		},
+		"Go duration": {
+			duration:         timetypes.NewExtendedGoDurationValueFromStringMust("1h1m1s"),
+			expectedDuration: time.Hour + time.Minute + time.Second,
+		},
+		"days and weeks": {
+			duration:         timetypes.NewExtendedGoDurationValueFromStringMust("2w3d12h30m"),
+			expectedDuration: 17*24*time.Hour + 12*time.Hour + 30*time.Minute,
+		},
+		"fractional days": {
+			duration:         timetypes.NewExtendedGoDurationValueFromStringMust("0.5d"),
+			expectedDuration: 12 * time.Hour,
+		},
+		"negative": {
+			duration:         timetypes.NewExtendedGoDurationValueFromStringMust("-1w1d"),
+			expectedDuration: -8 * 24 * time.Hour,
+		},
+	}
+	for name, testCase := range testCases {
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			duration, diags := testCase.duration.ValueGoDuration()
+
+			if duration != testCase.expectedDuration {
+				t.Errorf("Unexpected difference in time.Duration, got: %s, expected: %s", duration, testCase.expectedDuration)
+			}
+
+			if diff := cmp.Diff(diags, testCase.expectedDiags); diff != "" {
+				t.Errorf("Unexpected diagnostics (-got, +expected): %s", diff)
+			}
+		})
+	}
+}
+
+func TestExtendedGoDuration_ToGoDurationValue(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		duration      timetypes.ExtendedGoDuration
+		expected      timetypes.GoDuration
+		expectedDiags diag.Diagnostics
+	}{
+		"null": {
+			duration: timetypes.NewExtendedGoDurationNull(),
+			expected: timetypes.NewGoDurationNull(),
+		},
+		"unknown": {
+			duration: timetypes.NewExtendedGoDurationUnknown(),
+			expected: timetypes.NewGoDurationUnknown(),
+		},
+		"days": {
+			duration: timetypes.NewExtendedGoDurationValueFromStringMust("7d"),
+			expected: timetypes.NewGoDurationValueFromStringMust("168h0m0s"),
+		},
+	}
+	for name, testCase := range testCases {
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got, diags := testCase.duration.ToGoDurationValue()
+
+			if !got.Equal(testCase.expected) {
+				t.Errorf("Unexpected GoDuration, got: %s, expected: %s", got, testCase.expected)
+			}
+
+			if diff := cmp.Diff(diags, testCase.expectedDiags); diff != "" {
+				t.Errorf("Unexpected diagnostics (-got, +expected): %s", diff)
+			}
+		})
+	}
+}
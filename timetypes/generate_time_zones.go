@@ -0,0 +1,275 @@
+// Copyright IBM Corp. 2023, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+//go:build ignore
+
+// This program generates time_zone_data.go, which contains the names of all time zones, deprecated time zone aliases
+// and equivalent time zone names in a pinned release of the IANA time zone database. It is invoked via go generate.
+//
+// The release is downloaded from IANA unless the -tzdata flag names a local copy of the tzdata tarball. When
+// updating tzdataVersion, keep it in sync with the DATA version in $(go env GOROOT)/lib/time/update.bash, so the
+// generated names match those embedded by time/tzdata.
+//
+// Zone names are read from the Zone and Link lines of the release's data files. A link is considered a deprecated
+// alias if its name is not listed in zone.tab, which lists the preferred name for every country and region, with the
+// exception of the "UTC" and "GMT" links, which are not deprecated but are equivalent to their targets. Since the
+// links in the data files are merged into zones that may be in a different country, an alias maps to the "#=" target
+// given in the "backward" file when present, resolved through links until a name in zone.tab or a zone is reached.
+package main
+
+import (
+	"archive/tar"
+	"bufio"
+	"bytes"
+	"compress/gzip"
+	"flag"
+	"fmt"
+	"go/format"
+	"io"
+	"log"
+	"net/http"
+	"os"
+	"slices"
+	"strings"
+)
+
+// tzdataVersion is the pinned IANA time zone database release.
+const tzdataVersion = "2026c"
+
+// tzdataFiles are the data files in the release containing Zone and Link lines, excluding backzone.
+var tzdataFiles = []string{
+	"africa",
+	"antarctica",
+	"asia",
+	"australasia",
+	"backward",
+	"etcetera",
+	"europe",
+	"factory",
+	"northamerica",
+	"southamerica",
+}
+
+func main() {
+	tzdataPath := flag.String("tzdata", "", "path to tzdata"+tzdataVersion+".tar.gz, downloaded from IANA if empty")
+
+	flag.Parse()
+
+	files := readRelease(*tzdataPath)
+
+	if version := strings.TrimSpace(string(files["version"])); version != tzdataVersion {
+		log.Fatalf("expected tzdata version %s, got %q", tzdataVersion, version)
+	}
+
+	preferred := make(map[string]bool)
+
+	for _, fields := range readTable(files["zone.tab"]) {
+		if len(fields) >= 3 {
+			preferred[fields[2]] = true
+		}
+	}
+
+	var names []string
+
+	links := make(map[string]string)
+	intended := make(map[string]string)
+
+	for _, file := range tzdataFiles {
+		data, ok := files[file]
+		if !ok {
+			log.Fatalf("tzdata release is missing %s", file)
+		}
+
+		scanner := bufio.NewScanner(bytes.NewReader(data))
+
+		for scanner.Scan() {
+			line, comment, _ := strings.Cut(scanner.Text(), "#")
+			fields := strings.Fields(line)
+
+			switch {
+			case len(fields) >= 2 && fields[0] == "Zone":
+				names = append(names, fields[1])
+			case len(fields) == 3 && fields[0] == "Link":
+				names = append(names, fields[2])
+				links[fields[2]] = fields[1]
+
+				if target, ok := strings.CutPrefix(comment, "="); ok && file == "backward" {
+					intended[fields[2]] = strings.TrimSpace(target)
+				}
+			}
+		}
+
+		if err := scanner.Err(); err != nil {
+			log.Fatalf("error reading %s: %s", file, err)
+		}
+	}
+
+	slices.Sort(names)
+	names = slices.Compact(names)
+
+	aliases := make(map[string]string)
+	equivalents := make(map[string]string)
+
+	for link, target := range links {
+		if preferred[link] {
+			continue
+		}
+
+		if t, ok := intended[link]; ok {
+			target = t
+		}
+
+		for !preferred[target] && links[target] != "" {
+			target = links[target]
+		}
+
+		if _, found := slices.BinarySearch(names, target); !found {
+			log.Fatalf("alias %s has unknown target %s", link, target)
+		}
+
+		if link == "UTC" || link == "GMT" {
+			equivalents[link] = target
+
+			continue
+		}
+
+		aliases[link] = target
+	}
+
+	var b bytes.Buffer
+
+	b.WriteString("// Copyright IBM Corp. 2023, 2026\n")
+	b.WriteString("// SPDX-License-Identifier: MPL-2.0\n\n")
+	fmt.Fprintf(&b, "// Code generated by generate_time_zones.go from tzdata %s; DO NOT EDIT.\n\n", tzdataVersion)
+	b.WriteString("package timetypes\n\n")
+	b.WriteString("// timeZoneNames contains the sorted names of all time zones in the IANA time zone database embedded by time/tzdata.\n")
+	b.WriteString("var timeZoneNames = []string{\n")
+
+	for _, name := range names {
+		fmt.Fprintf(&b, "\t%q,\n", name)
+	}
+
+	b.WriteString("}\n\n")
+	b.WriteString("// timeZoneAliases maps deprecated time zone names, such as \"US/Pacific\", to their canonical time zone name.\n")
+	writeMap(&b, "timeZoneAliases", aliases)
+	b.WriteString("\n")
+	b.WriteString("// timeZoneEquivalents maps time zone names that are not deprecated, but are equivalent to another time zone name,\n")
+	b.WriteString("// such as \"UTC\", to their canonical time zone name.\n")
+	writeMap(&b, "timeZoneEquivalents", equivalents)
+
+	src, err := format.Source(b.Bytes())
+	if err != nil {
+		log.Fatalf("error formatting generated source: %s", err)
+	}
+
+	if err := os.WriteFile("time_zone_data.go", src, 0o644); err != nil {
+		log.Fatalf("error writing time_zone_data.go: %s", err)
+	}
+}
+
+// writeMap writes the given map as a sorted Go map literal variable declaration.
+func writeMap(b *bytes.Buffer, name string, m map[string]string) {
+	keys := make([]string, 0, len(m))
+
+	for key := range m {
+		keys = append(keys, key)
+	}
+
+	slices.Sort(keys)
+
+	fmt.Fprintf(b, "var %s = map[string]string{\n", name)
+
+	for _, key := range keys {
+		fmt.Fprintf(b, "\t%q: %q,\n", key, m[key])
+	}
+
+	b.WriteString("}\n")
+}
+
+// readRelease returns the contents of each file in the tzdata release tarball at the given path, or downloaded from
+// IANA if the path is empty.
+func readRelease(path string) map[string][]byte {
+	var r io.Reader
+
+	if path == "" {
+		url := "https://data.iana.org/time-zones/releases/tzdata" + tzdataVersion + ".tar.gz"
+
+		resp, err := http.Get(url)
+		if err != nil {
+			log.Fatalf("error downloading %s: %s", url, err)
+		}
+
+		defer resp.Body.Close()
+
+		if resp.StatusCode != http.StatusOK {
+			log.Fatalf("error downloading %s: %s", url, resp.Status)
+		}
+
+		r = resp.Body
+	} else {
+		f, err := os.Open(path)
+		if err != nil {
+			log.Fatalf("error opening %s: %s", path, err)
+		}
+
+		defer f.Close()
+
+		r = f
+	}
+
+	gz, err := gzip.NewReader(r)
+	if err != nil {
+		log.Fatalf("error reading tzdata release: %s", err)
+	}
+
+	files := make(map[string][]byte)
+
+	tr := tar.NewReader(gz)
+
+	for {
+		hdr, err := tr.Next()
+		if err == io.EOF {
+			break
+		}
+
+		if err != nil {
+			log.Fatalf("error reading tzdata release: %s", err)
+		}
+
+		if hdr.Typeflag != tar.TypeReg {
+			continue
+		}
+
+		data, err := io.ReadAll(tr)
+		if err != nil {
+			log.Fatalf("error reading %s: %s", hdr.Name, err)
+		}
+
+		files[strings.TrimPrefix(hdr.Name, "./")] = data
+	}
+
+	return files
+}
+
+// readTable returns the whitespace separated fields of each line of the given data, ignoring comments.
+func readTable(data []byte) [][]string {
+	var lines [][]string
+
+	scanner := bufio.NewScanner(bytes.NewReader(data))
+
+	for scanner.Scan() {
+		line := scanner.Text()
+
+		if strings.HasPrefix(line, "#") {
+			continue
+		}
+
+		lines = append(lines, strings.Fields(line))
+	}
+
+	if err := scanner.Err(); err != nil {
+		log.Fatalf("error reading table: %s", err)
+	}
+
+	return lines
+}
@@ -0,0 +1,149 @@
+// Copyright IBM Corp. 2023, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package timetypes
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+var (
+	_ basetypes.StringTypable = (*HTTPDateType)(nil)
+)
+
+// HTTPDateType is an attribute type that represents a valid RFC 9110 HTTP date string, such as
+// `Sun, 06 Nov 1994 08:49:37 GMT`. Semantic equality logic is defined for HTTPDateType such that dates representing
+// the same instant, including equivalent RFC3339 values, are considered equal.
+type HTTPDateType struct {
+	basetypes.StringType
+
+	// AllowObsoleteFormats enables accepting the obsolete RFC 850 and ANSI C asctime() date formats, such as
+	// `Sunday, 06-Nov-94 08:49:37 GMT` and `Sun Nov  6 08:49:37 1994`, in addition to the preferred IMF-fixdate format.
+	// Values of a type with AllowObsoleteFormats enabled are created with the NewValue, NewTimeValue, NewNull and
+	// NewUnknown methods of the type, rather than the NewHTTPDate functions.
+	AllowObsoleteFormats bool
+}
+
+// String returns a human-readable string of the type name.
+func (t HTTPDateType) String() string {
+	return "timetypes.HTTPDateType"
+}
+
+// ValueType returns the Value type.
+func (t HTTPDateType) ValueType(ctx context.Context) attr.Value {
+	return HTTPDate{
+		allowObsoleteFormats: t.AllowObsoleteFormats,
+	}
+}
+
+// Equal returns true if the given type is equivalent.
+func (t HTTPDateType) Equal(o attr.Type) bool {
+	other, ok := o.(HTTPDateType)
+
+	if !ok {
+		return false
+	}
+
+	return t.AllowObsoleteFormats == other.AllowObsoleteFormats && t.StringType.Equal(other.StringType)
+}
+
+// ValueFromString returns a StringValuable type given a StringValue.
+func (t HTTPDateType) ValueFromString(ctx context.Context, in basetypes.StringValue) (basetypes.StringValuable, diag.Diagnostics) {
+	return HTTPDate{
+		StringValue:          in,
+		allowObsoleteFormats: t.AllowObsoleteFormats,
+	}, nil
+}
+
+// ValueFromTerraform returns a Value given a tftypes.Value.  This is meant to convert the tftypes.Value into a more convenient Go type
+// for the provider to consume the data with.
+func (t HTTPDateType) ValueFromTerraform(ctx context.Context, in tftypes.Value) (attr.Value, error) {
+	attrValue, err := t.StringType.ValueFromTerraform(ctx, in)
+
+	if err != nil {
+		return nil, err
+	}
+
+	stringValue, ok := attrValue.(basetypes.StringValue)
+
+	if !ok {
+		return nil, fmt.Errorf("unexpected value type of %T", attrValue)
+	}
+
+	stringValuable, diags := t.ValueFromString(ctx, stringValue)
+
+	if diags.HasError() {
+		return nil, fmt.Errorf("unexpected error converting StringValue to StringValuable: %v", diags)
+	}
+
+	return stringValuable, nil
+}
+
+// NewNull creates an HTTPDate of this type with a null value. Determine whether the value is null via IsNull method.
+func (t HTTPDateType) NewNull() HTTPDate {
+	return HTTPDate{
+		StringValue:          basetypes.NewStringNull(),
+		allowObsoleteFormats: t.AllowObsoleteFormats,
+	}
+}
+
+// NewUnknown creates an HTTPDate of this type with an unknown value. Determine whether the value is unknown via
+// IsUnknown method.
+func (t HTTPDateType) NewUnknown() HTTPDate {
+	return HTTPDate{
+		StringValue:          basetypes.NewStringUnknown(),
+		allowObsoleteFormats: t.AllowObsoleteFormats,
+	}
+}
+
+// NewTimeValue creates an HTTPDate of this type with a known value in the IMF-fixdate format. The time.Time is
+// converted to UTC and any fractional seconds are truncated.
+func (t HTTPDateType) NewTimeValue(value time.Time) HTTPDate {
+	return HTTPDate{
+		StringValue:          basetypes.NewStringValue(value.UTC().Format(httpDateIMFFixdateLayout)),
+		allowObsoleteFormats: t.AllowObsoleteFormats,
+	}
+}
+
+// NewValue creates an HTTPDate of this type with a known value or raises an error diagnostic if the string is not in
+// a HTTP date format accepted by this type.
+func (t HTTPDateType) NewValue(value string) (HTTPDate, diag.Diagnostics) {
+	_, err := parseHTTPDate(value, t.AllowObsoleteFormats)
+
+	if err != nil {
+		// Returning an unknown value will guarantee that, as a last resort,
+		// Terraform will return an error if attempting to store into state.
+		return t.NewUnknown(), diag.Diagnostics{httpDateInvalidStringDiagnostic(value, err)}
+	}
+
+	return HTTPDate{
+		StringValue:          basetypes.NewStringValue(value),
+		allowObsoleteFormats: t.AllowObsoleteFormats,
+	}, nil
+}
+
+// NewValueMust creates an HTTPDate of this type with a known value or raises a panic if the string is not in a HTTP
+// date format accepted by this type.
+//
+// This creation function is only recommended to create HTTPDate values which
+// either will not potentially affect practitioners, such as testing, or within
+// exhaustively tested provider logic.
+func (t HTTPDateType) NewValueMust(value string) HTTPDate {
+	_, err := parseHTTPDate(value, t.AllowObsoleteFormats)
+
+	if err != nil {
+		panic(fmt.Sprintf("Invalid HTTP Date String Value (%s): %s", value, err))
+	}
+
+	return HTTPDate{
+		StringValue:          basetypes.NewStringValue(value),
+		allowObsoleteFormats: t.AllowObsoleteFormats,
+	}
+}
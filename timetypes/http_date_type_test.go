@@ -0,0 +1,165 @@
+// Copyright IBM Corp. 2023, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package timetypes_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+
+	"github.com/hashicorp/terraform-plugin-framework-timetypes/timetypes"
+)
+
+func TestHTTPDateTypeValueFromTerraform(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		typ         timetypes.HTTPDateType
+		in          tftypes.Value
+		expectation attr.Value
+		expectedErr string
+	}{
+		"true": {
+			in:          tftypes.NewValue(tftypes.String, "Tue, 25 Jul 2023 20:43:16 GMT"),
+			expectation: timetypes.NewHTTPDateValueMust("Tue, 25 Jul 2023 20:43:16 GMT"),
+		},
+		"true - obsolete formats": {
+			typ:         timetypes.HTTPDateType{AllowObsoleteFormats: true},
+			in:          tftypes.NewValue(tftypes.String, "Tue Jul 25 20:43:16 2023"),
+			expectation: httpDateValueWithObsoleteFormats("Tue Jul 25 20:43:16 2023"),
+		},
+		"unknown": {
+			in:          tftypes.NewValue(tftypes.String, tftypes.UnknownValue),
+			expectation: timetypes.NewHTTPDateUnknown(),
+		},
+		"null": {
+			in:          tftypes.NewValue(tftypes.String, nil),
+			expectation: timetypes.NewHTTPDateNull(),
+		},
+		"wrongType": {
+			in:          tftypes.NewValue(tftypes.Number, 123),
+			expectedErr: "can't unmarshal tftypes.Number into *string, expected string",
+		},
+	}
+	for name, testCase := range testCases {
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+			ctx := context.Background()
+
+			got, err := testCase.typ.ValueFromTerraform(ctx, testCase.in)
+			if err != nil {
+				if testCase.expectedErr == "" {
+					t.Fatalf("Unexpected error: %s", err)
+				}
+				if testCase.expectedErr != err.Error() {
+					t.Fatalf("Expected error to be %q, got %q", testCase.expectedErr, err.Error())
+				}
+				return
+			}
+			if err == nil && testCase.expectedErr != "" {
+				t.Fatalf("Expected error to be %q, didn't get an error", testCase.expectedErr)
+			}
+			if !got.Equal(testCase.expectation) {
+				t.Errorf("Expected %+v, got %+v", testCase.expectation, got)
+			}
+			if testCase.expectation.IsNull() != testCase.in.IsNull() {
+				t.Errorf("Expected null-ness match: expected %t, got %t", testCase.expectation.IsNull(), testCase.in.IsNull())
+			}
+			if testCase.expectation.IsUnknown() != !testCase.in.IsKnown() {
+				t.Errorf("Expected unknown-ness match: expected %t, got %t", testCase.expectation.IsUnknown(), !testCase.in.IsKnown())
+			}
+		})
+	}
+}
+
+func TestHTTPDateType_NewValue(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		typ           timetypes.HTTPDateType
+		value         string
+		expectedDiags diag.Diagnostics
+	}{
+		"IMF-fixdate": {
+			value: "Tue, 25 Jul 2023 20:43:16 GMT",
+		},
+		"IMF-fixdate - obsolete formats": {
+			typ:   timetypes.HTTPDateType{AllowObsoleteFormats: true},
+			value: "Tue, 25 Jul 2023 20:43:16 GMT",
+		},
+		"asctime - obsolete formats": {
+			typ:   timetypes.HTTPDateType{AllowObsoleteFormats: true},
+			value: "Tue Jul 25 20:43:16 2023",
+		},
+		"error - asctime": {
+			value: "Tue Jul 25 20:43:16 2023",
+			expectedDiags: diag.Diagnostics{
+				diag.NewErrorDiagnostic(
+					"Invalid HTTP Date String Value",
+					"A string value was provided that is not valid HTTP date string format, such as \"Sun, 06 Nov 1994 08:49:37 GMT\".\n\n"+
+						"Given Value: Tue Jul 25 20:43:16 2023\n"+
+						"Error: obsolete asctime date format is not allowed, expected IMF-fixdate format \"Tue, 25 Jul 2023 20:43:16 GMT\"",
+				),
+			},
+		},
+	}
+	for name, testCase := range testCases {
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+			ctx := context.Background()
+
+			got, diags := testCase.typ.NewValue(testCase.value)
+
+			if diff := cmp.Diff(diags, testCase.expectedDiags); diff != "" {
+				t.Errorf("Unexpected diagnostics (-got, +expected): %s", diff)
+			}
+
+			if !got.Type(ctx).Equal(testCase.typ) {
+				t.Errorf("Expected type %s, got %s", testCase.typ, got.Type(ctx))
+			}
+
+			if diags.HasError() {
+				return
+			}
+
+			expected, _ := testCase.typ.ValueFromString(ctx, basetypes.NewStringValue(testCase.value))
+
+			if !got.Equal(expected) {
+				t.Errorf("Expected %+v, got %+v", expected, got)
+			}
+		})
+	}
+}
+
+func TestHTTPDateType_NewNull(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	typ := timetypes.HTTPDateType{AllowObsoleteFormats: true}
+
+	expected, err := typ.ValueFromTerraform(ctx, tftypes.NewValue(tftypes.String, nil))
+	if err != nil {
+		t.Fatalf("Unexpected error: %s", err)
+	}
+
+	if got := typ.NewNull(); !got.Equal(expected) {
+		t.Errorf("Expected %+v, got %+v", expected, got)
+	}
+
+	expected, err = typ.ValueFromTerraform(ctx, tftypes.NewValue(tftypes.String, tftypes.UnknownValue))
+	if err != nil {
+		t.Fatalf("Unexpected error: %s", err)
+	}
+
+	if got := typ.NewUnknown(); !got.Equal(expected) {
+		t.Errorf("Expected %+v, got %+v", expected, got)
+	}
+}
@@ -0,0 +1,317 @@
+// Copyright IBM Corp. 2023, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package timetypes
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/attr/xattr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+)
+
+var (
+	_ basetypes.StringValuableWithSemanticEquals = (*HTTPDate)(nil)
+	_ xattr.ValidateableAttribute                = (*HTTPDate)(nil)
+	_ function.ValidateableParameter             = (*HTTPDate)(nil)
+)
+
+const (
+	// httpDateIMFFixdateLayout is the preferred IMF-fixdate HTTP date format.
+	httpDateIMFFixdateLayout = "Mon, 02 Jan 2006 15:04:05 GMT"
+
+	// httpDateRFC850Layout is the obsolete RFC 850 HTTP date format.
+	httpDateRFC850Layout = "Monday, 02-Jan-06 15:04:05 GMT"
+
+	// httpDateASCTimeLayout is the obsolete ANSI C asctime() HTTP date format.
+	httpDateASCTimeLayout = "Mon Jan _2 15:04:05 2006"
+)
+
+// HTTPDate represents a valid RFC 9110 HTTP date string, as used in HTTP headers such as `Last-Modified` and
+// `Expires`. By default, only the preferred IMF-fixdate format, such as `Sun, 06 Nov 1994 08:49:37 GMT`, is valid.
+// The obsolete RFC 850 and ANSI C asctime() formats are also valid if the AllowObsoleteFormats field of the
+// HTTPDateType is enabled.
+//
+// HTTP dates are always in UTC and must use the exact case, spacing and zero padding of their format. The day name
+// must match the date.
+//
+// See https://www.rfc-editor.org/rfc/rfc9110.html#section-5.6.7 for more details on the string format.
+type HTTPDate struct {
+	basetypes.StringValue
+
+	allowObsoleteFormats bool
+}
+
+// Type returns an HTTPDateType.
+func (v HTTPDate) Type(_ context.Context) attr.Type {
+	return HTTPDateType{
+		AllowObsoleteFormats: v.allowObsoleteFormats,
+	}
+}
+
+// Equal returns true if the given value is equivalent.
+func (v HTTPDate) Equal(o attr.Value) bool {
+	other, ok := o.(HTTPDate)
+
+	if !ok {
+		return false
+	}
+
+	return v.allowObsoleteFormats == other.allowObsoleteFormats && v.StringValue.Equal(other.StringValue)
+}
+
+// StringSemanticEquals returns true if the given HTTPDate or RFC3339 string value is semantically equal to the current
+// HTTPDate string value. Both values are parsed into time.Time instances, which are compared as instants.
+//
+// Examples:
+//   - `Sun, 06 Nov 1994 08:49:37 GMT` is semantically equal to `Sunday, 06-Nov-94 08:49:37 GMT`
+//   - `Sun, 06 Nov 1994 08:49:37 GMT` is semantically equal to the RFC3339 value `1994-11-06T08:49:37Z`
+//   - `Sun, 06 Nov 1994 08:49:37 GMT` is semantically equal to the RFC3339 value `1994-11-06T09:49:37+01:00`
+//
+// Counterexamples:
+//   - `Sun, 06 Nov 1994 08:49:37 GMT` is NOT semantically equal to `Sun, 06 Nov 1994 08:49:38 GMT`
+func (v HTTPDate) StringSemanticEquals(_ context.Context, newValuable basetypes.StringValuable) (bool, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	var newTime time.Time
+
+	// Values are already validated at this point, ignoring errors
+	switch newValue := newValuable.(type) {
+	case HTTPDate:
+		newTime, _ = parseHTTPDate(newValue.ValueString(), newValue.allowObsoleteFormats)
+	case RFC3339:
+		newTime, _ = time.Parse(time.RFC3339, newValue.ValueString())
+	default:
+		diags.AddError(
+			"Semantic Equality Check Error",
+			"An unexpected value type was received while performing semantic equality checks. "+
+				"Please report this to the provider developers.\n\n"+
+				"Expected Value Type: "+fmt.Sprintf("%T", v)+"\n"+
+				"Got Value Type: "+fmt.Sprintf("%T", newValuable),
+		)
+
+		return false, diags
+	}
+
+	currentTime, _ := parseHTTPDate(v.ValueString(), v.allowObsoleteFormats)
+
+	return currentTime.Equal(newTime), diags
+}
+
+// ValidateAttribute implements attribute value validation. This type requires the value to be a String value that
+// is a valid HTTP date in the IMF-fixdate format or, if enabled, one of the obsolete formats.
+func (v HTTPDate) ValidateAttribute(ctx context.Context, req xattr.ValidateAttributeRequest, resp *xattr.ValidateAttributeResponse) {
+	if v.IsUnknown() || v.IsNull() {
+		return
+	}
+
+	if _, err := parseHTTPDate(v.ValueString(), v.allowObsoleteFormats); err != nil {
+		resp.Diagnostics.Append(diag.WithPath(req.Path, httpDateInvalidStringDiagnostic(v.ValueString(), err)))
+
+		return
+	}
+}
+
+// ValidateParameter implements provider-defined function parameter value validation. This type requires the value to
+// be a String value that is a valid HTTP date in the IMF-fixdate format or, if enabled, one of the obsolete formats.
+func (v HTTPDate) ValidateParameter(ctx context.Context, req function.ValidateParameterRequest, resp *function.ValidateParameterResponse) {
+	if v.IsUnknown() || v.IsNull() {
+		return
+	}
+
+	if _, err := parseHTTPDate(v.ValueString(), v.allowObsoleteFormats); err != nil {
+		resp.Error = function.NewArgumentFuncError(
+			req.Position,
+			"Invalid HTTP Date String Value: "+
+				"A string value was provided that is not valid HTTP date string format, such as \"Sun, 06 Nov 1994 08:49:37 GMT\".\n\n"+
+				"Given Value: "+v.ValueString()+"\n"+
+				"Error: "+err.Error(),
+		)
+
+		return
+	}
+}
+
+// ValueHTTPDateTime creates a new time.Time instance in UTC with the HTTPDate StringValue. A null or unknown value
+// will produce an error diagnostic.
+func (v HTTPDate) ValueHTTPDateTime() (time.Time, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	if v.IsNull() {
+		diags.Append(diag.NewErrorDiagnostic("HTTPDate ValueHTTPDateTime Error", "HTTP date string value is null"))
+		return time.Time{}, diags
+	}
+
+	if v.IsUnknown() {
+		diags.Append(diag.NewErrorDiagnostic("HTTPDate ValueHTTPDateTime Error", "HTTP date string value is unknown"))
+		return time.Time{}, diags
+	}
+
+	httpDateTime, err := parseHTTPDate(v.ValueString(), v.allowObsoleteFormats)
+	if err != nil {
+		diags.Append(diag.NewErrorDiagnostic("HTTPDate ValueHTTPDateTime Error", err.Error()))
+		return time.Time{}, diags
+	}
+
+	return httpDateTime, nil
+}
+
+// ToRFC3339Value converts the HTTPDate to an RFC3339 value in UTC, such as `1994-11-06T08:49:37Z`. Null and unknown
+// values are converted to null and unknown RFC3339 values respectively.
+func (v HTTPDate) ToRFC3339Value() (RFC3339, diag.Diagnostics) {
+	if v.IsNull() {
+		return NewRFC3339Null(), nil
+	}
+
+	if v.IsUnknown() {
+		return NewRFC3339Unknown(), nil
+	}
+
+	httpDateTime, diags := v.ValueHTTPDateTime()
+	if diags.HasError() {
+		return NewRFC3339Unknown(), diags
+	}
+
+	return newRFC3339TimeNanoValue(httpDateTime), nil
+}
+
+// NewHTTPDateNull creates an HTTPDate with a null value. Determine whether the value is null via IsNull method. Use
+// HTTPDateType.NewNull to create a value of an HTTPDateType with AllowObsoleteFormats enabled.
+func NewHTTPDateNull() HTTPDate {
+	return HTTPDateType{}.NewNull()
+}
+
+// NewHTTPDateUnknown creates an HTTPDate with an unknown value. Determine whether the value is unknown via IsUnknown
+// method. Use HTTPDateType.NewUnknown to create a value of an HTTPDateType with AllowObsoleteFormats enabled.
+func NewHTTPDateUnknown() HTTPDate {
+	return HTTPDateType{}.NewUnknown()
+}
+
+// NewHTTPDateTimeValue creates an HTTPDate with a known value in the IMF-fixdate format. The time.Time is converted
+// to UTC and any fractional seconds are truncated. Use HTTPDateType.NewTimeValue to create a value of an HTTPDateType
+// with AllowObsoleteFormats enabled.
+func NewHTTPDateTimeValue(value time.Time) HTTPDate {
+	return HTTPDateType{}.NewTimeValue(value)
+}
+
+// NewHTTPDateTimePointerValue creates an HTTPDate with a null value if nil or
+// a known value in the IMF-fixdate format.
+func NewHTTPDateTimePointerValue(value *time.Time) HTTPDate {
+	if value == nil {
+		return NewHTTPDateNull()
+	}
+
+	return NewHTTPDateTimeValue(*value)
+}
+
+// NewHTTPDateValue creates an HTTPDate with a known value or raises an error
+// diagnostic if the string is not IMF-fixdate HTTP date format. Use
+// HTTPDateType.NewValue to create a value of an HTTPDateType with
+// AllowObsoleteFormats enabled.
+func NewHTTPDateValue(value string) (HTTPDate, diag.Diagnostics) {
+	return HTTPDateType{}.NewValue(value)
+}
+
+// NewHTTPDateValueMust creates an HTTPDate with a known value or raises a panic
+// if the string is not IMF-fixdate HTTP date format.
+//
+// This creation function is only recommended to create HTTPDate values which
+// either will not potentially affect practitioners, such as testing, or within
+// exhaustively tested provider logic.
+func NewHTTPDateValueMust(value string) HTTPDate {
+	return HTTPDateType{}.NewValueMust(value)
+}
+
+// NewHTTPDatePointerValue creates an HTTPDate with a null value if nil, a known
+// value, or raises an error diagnostic if the string is not IMF-fixdate HTTP
+// date format.
+func NewHTTPDatePointerValue(value *string) (HTTPDate, diag.Diagnostics) {
+	if value == nil {
+		return NewHTTPDateNull(), nil
+	}
+
+	return NewHTTPDateValue(*value)
+}
+
+// NewHTTPDatePointerValueMust creates an HTTPDate with a null value if nil, a
+// known value, or raises a panic if the string is not IMF-fixdate HTTP date
+// format.
+//
+// This creation function is only recommended to create HTTPDate values which
+// either will not potentially affect practitioners, such as testing, or within
+// exhaustively tested provider logic.
+func NewHTTPDatePointerValueMust(value *string) HTTPDate {
+	if value == nil {
+		return NewHTTPDateNull()
+	}
+
+	return NewHTTPDateValueMust(*value)
+}
+
+// parseHTTPDate parses an HTTP date string in the IMF-fixdate format into a time.Time in UTC. If allowObsoleteFormats
+// is true, the RFC 850 and asctime formats are also accepted.
+func parseHTTPDate(value string, allowObsoleteFormats bool) (time.Time, error) {
+	t, err := parseHTTPDateLayout(value, httpDateIMFFixdateLayout)
+
+	if err == nil {
+		return t, nil
+	}
+
+	for _, obsolete := range []struct {
+		name   string
+		layout string
+	}{
+		{name: "RFC 850", layout: httpDateRFC850Layout},
+		{name: "asctime", layout: httpDateASCTimeLayout},
+	} {
+		obsoleteTime, obsoleteErr := parseHTTPDateLayout(value, obsolete.layout)
+
+		if obsoleteErr != nil {
+			continue
+		}
+
+		if !allowObsoleteFormats {
+			return time.Time{}, fmt.Errorf("obsolete %s date format is not allowed, expected IMF-fixdate format %q", obsolete.name, obsoleteTime.Format(httpDateIMFFixdateLayout))
+		}
+
+		return obsoleteTime, nil
+	}
+
+	return time.Time{}, err
+}
+
+// parseHTTPDateLayout parses an HTTP date string in the given layout into a time.Time in UTC. The string must exactly
+// match the formatted time, which ensures the day name matches the date and that numbers are zero padded.
+func parseHTTPDateLayout(value string, layout string) (time.Time, error) {
+	t, err := time.Parse(layout, value)
+	if err != nil {
+		return time.Time{}, err
+	}
+
+	if layout == httpDateRFC850Layout {
+		t = t.AddDate(httpDateRFC850Year(t.Year()%100, time.Now().UTC())-t.Year(), 0, 0)
+	}
+
+	if expected := t.Format(layout); expected != value {
+		return time.Time{}, fmt.Errorf("expected %q, got %q", expected, value)
+	}
+
+	return t, nil
+}
+
+// httpDateRFC850Year returns the full year of a two digit RFC 850 year. As required by RFC 9110, a year that appears to
+// be more than 50 years in the future is interpreted as the most recent year in the past with the same last two digits.
+func httpDateRFC850Year(twoDigitYear int, now time.Time) int {
+	year := now.Year() - now.Year()%100 + twoDigitYear
+
+	if year > now.Year()+50 {
+		year -= 100
+	}
+
+	return year
+}
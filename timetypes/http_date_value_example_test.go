@@ -0,0 +1,34 @@
+// Copyright IBM Corp. 2023, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package timetypes_test
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework-timetypes/timetypes"
+)
+
+type ObjectDataSourceModel struct {
+	LastModified timetypes.HTTPDate `tfsdk:"last_modified"`
+}
+
+func ExampleHTTPDate_ValueHTTPDateTime() {
+	// For example purposes, typically the data model would be populated automatically by Plugin Framework via Config, Plan or State.
+	// https://developer.hashicorp.com/terraform/plugin/framework/handling-data/accessing-values
+	data := ObjectDataSourceModel{
+		LastModified: timetypes.NewHTTPDateValueMust("Tue, 25 Jul 2023 23:43:16 GMT"),
+	}
+
+	// Check that the HTTP date data is known and able to be converted to time.Time
+	if !data.LastModified.IsNull() && !data.LastModified.IsUnknown() {
+		t, diags := data.LastModified.ValueHTTPDateTime()
+		if diags.HasError() {
+			return
+		}
+
+		// Output: 2023-07-25T23:43:16Z
+		fmt.Println(t.Format(time.RFC3339))
+	}
+}
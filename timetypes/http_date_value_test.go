@@ -0,0 +1,383 @@
+// Copyright IBM Corp. 2023, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package timetypes_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-framework/attr/xattr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+
+	"github.com/hashicorp/terraform-plugin-framework-timetypes/timetypes"
+)
+
+func TestHTTPDate_StringSemanticEquals(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		currentHTTPDate timetypes.HTTPDate
+		givenValue      basetypes.StringValuable
+		expectedMatch   bool
+		expectedDiags   diag.Diagnostics
+	}{
+		"not equal - different times": {
+			currentHTTPDate: timetypes.NewHTTPDateValueMust("Sun, 06 Nov 1994 08:49:37 GMT"),
+			givenValue:      timetypes.NewHTTPDateValueMust("Sun, 06 Nov 1994 08:49:38 GMT"),
+			expectedMatch:   false,
+		},
+		"not equal - different RFC3339 time": {
+			currentHTTPDate: timetypes.NewHTTPDateValueMust("Sun, 06 Nov 1994 08:49:37 GMT"),
+			givenValue:      timetypes.NewRFC3339ValueMust("1994-11-06T08:49:37+01:00"),
+			expectedMatch:   false,
+		},
+		"semantically equal - byte for byte match": {
+			currentHTTPDate: timetypes.NewHTTPDateValueMust("Sun, 06 Nov 1994 08:49:37 GMT"),
+			givenValue:      timetypes.NewHTTPDateValueMust("Sun, 06 Nov 1994 08:49:37 GMT"),
+			expectedMatch:   true,
+		},
+		"semantically equal - obsolete RFC 850 format": {
+			currentHTTPDate: timetypes.NewHTTPDateValueMust("Sun, 06 Nov 1994 08:49:37 GMT"),
+			givenValue:      httpDateValueWithObsoleteFormats("Sunday, 06-Nov-94 08:49:37 GMT"),
+			expectedMatch:   true,
+		},
+		"semantically equal - obsolete asctime format": {
+			currentHTTPDate: httpDateValueWithObsoleteFormats("Sun Nov  6 08:49:37 1994"),
+			givenValue:      timetypes.NewHTTPDateValueMust("Sun, 06 Nov 1994 08:49:37 GMT"),
+			expectedMatch:   true,
+		},
+		"semantically equal - RFC3339 Z suffix": {
+			currentHTTPDate: timetypes.NewHTTPDateValueMust("Sun, 06 Nov 1994 08:49:37 GMT"),
+			givenValue:      timetypes.NewRFC3339ValueMust("1994-11-06T08:49:37Z"),
+			expectedMatch:   true,
+		},
+		"semantically equal - RFC3339 offset": {
+			currentHTTPDate: timetypes.NewHTTPDateValueMust("Sun, 06 Nov 1994 08:49:37 GMT"),
+			givenValue:      timetypes.NewRFC3339ValueMust("1994-11-06T09:49:37+01:00"),
+			expectedMatch:   true,
+		},
+		"error - not given HTTPDate or RFC3339 value": {
+			currentHTTPDate: timetypes.NewHTTPDateValueMust("Sun, 06 Nov 1994 08:49:37 GMT"),
+			givenValue:      basetypes.NewStringValue("Sun, 06 Nov 1994 08:49:37 GMT"),
+			expectedMatch:   false,
+			expectedDiags: diag.Diagnostics{
+				diag.NewErrorDiagnostic(
+					"Semantic Equality Check Error",
+					"An unexpected value type was received while performing semantic equality checks. "+
+						"Please report this to the provider developers.\n\n"+
+						"Expected Value Type: timetypes.HTTPDate\n"+
+						"Got Value Type: basetypes.StringValue",
+				),
+			},
+		},
+	}
+	for name, testCase := range testCases {
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			match, diags := testCase.currentHTTPDate.StringSemanticEquals(context.Background(), testCase.givenValue)
+
+			if testCase.expectedMatch != match {
+				t.Errorf("Expected StringSemanticEquals to return: %t, but got: %t", testCase.expectedMatch, match)
+			}
+
+			if diff := cmp.Diff(diags, testCase.expectedDiags); diff != "" {
+				t.Errorf("Unexpected diagnostics (-got, +expected): %s", diff)
+			}
+		})
+	}
+}
+
+func TestHTTPDateValidateAttribute(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		HTTPDate      timetypes.HTTPDate
+		expectedDiags diag.Diagnostics
+	}{
+		"empty-struct": {
+			HTTPDate: timetypes.HTTPDate{},
+		},
+		"null": {
+			HTTPDate: timetypes.NewHTTPDateNull(),
+		},
+		"unknown": {
+			HTTPDate: timetypes.NewHTTPDateUnknown(),
+		},
+		"valid IMF-fixdate": {
+			HTTPDate: timetypes.NewHTTPDateValueMust("Sun, 06 Nov 1994 08:49:37 GMT"),
+		},
+		"valid RFC 850 - obsolete formats allowed": {
+			HTTPDate: httpDateValueWithObsoleteFormats("Sunday, 06-Nov-94 08:49:37 GMT"),
+		},
+		"valid asctime - obsolete formats allowed": {
+			HTTPDate: httpDateValueWithObsoleteFormats("Sun Nov  6 08:49:37 1994"),
+		},
+		"invalid RFC 850 - obsolete formats not allowed": {
+			HTTPDate: timetypes.HTTPDate{
+				StringValue: basetypes.NewStringValue("Sunday, 06-Nov-94 08:49:37 GMT"),
+			},
+			expectedDiags: diag.Diagnostics{
+				diag.NewAttributeErrorDiagnostic(
+					path.Root("test"),
+					"Invalid HTTP Date String Value",
+					"A string value was provided that is not valid HTTP date string format, such as \"Sun, 06 Nov 1994 08:49:37 GMT\".\n\n"+
+						"Given Value: Sunday, 06-Nov-94 08:49:37 GMT\n"+
+						"Error: obsolete RFC 850 date format is not allowed, expected IMF-fixdate format \"Sun, 06 Nov 1994 08:49:37 GMT\"",
+				),
+			},
+		},
+		"invalid asctime - obsolete formats not allowed": {
+			HTTPDate: timetypes.HTTPDate{
+				StringValue: basetypes.NewStringValue("Sun Nov  6 08:49:37 1994"),
+			},
+			expectedDiags: diag.Diagnostics{
+				diag.NewAttributeErrorDiagnostic(
+					path.Root("test"),
+					"Invalid HTTP Date String Value",
+					"A string value was provided that is not valid HTTP date string format, such as \"Sun, 06 Nov 1994 08:49:37 GMT\".\n\n"+
+						"Given Value: Sun Nov  6 08:49:37 1994\n"+
+						"Error: obsolete asctime date format is not allowed, expected IMF-fixdate format \"Sun, 06 Nov 1994 08:49:37 GMT\"",
+				),
+			},
+		},
+		"invalid - wrong day name": {
+			HTTPDate: timetypes.HTTPDate{
+				StringValue: basetypes.NewStringValue("Mon, 06 Nov 1994 08:49:37 GMT"),
+			},
+			expectedDiags: diag.Diagnostics{
+				diag.NewAttributeErrorDiagnostic(
+					path.Root("test"),
+					"Invalid HTTP Date String Value",
+					"A string value was provided that is not valid HTTP date string format, such as \"Sun, 06 Nov 1994 08:49:37 GMT\".\n\n"+
+						"Given Value: Mon, 06 Nov 1994 08:49:37 GMT\n"+
+						"Error: expected \"Sun, 06 Nov 1994 08:49:37 GMT\", got \"Mon, 06 Nov 1994 08:49:37 GMT\"",
+				),
+			},
+		},
+		"invalid - missing zero padding": {
+			HTTPDate: timetypes.HTTPDate{
+				StringValue: basetypes.NewStringValue("Sun, 06 Nov 1994 8:49:37 GMT"),
+			},
+			expectedDiags: diag.Diagnostics{
+				diag.NewAttributeErrorDiagnostic(
+					path.Root("test"),
+					"Invalid HTTP Date String Value",
+					"A string value was provided that is not valid HTTP date string format, such as \"Sun, 06 Nov 1994 08:49:37 GMT\".\n\n"+
+						"Given Value: Sun, 06 Nov 1994 8:49:37 GMT\n"+
+						"Error: expected \"Sun, 06 Nov 1994 08:49:37 GMT\", got \"Sun, 06 Nov 1994 8:49:37 GMT\"",
+				),
+			},
+		},
+		"invalid - not GMT": {
+			HTTPDate: httpDateValueWithObsoleteFormats("Sun, 06 Nov 1994 08:49:37 UTC"),
+			expectedDiags: diag.Diagnostics{
+				diag.NewAttributeErrorDiagnostic(
+					path.Root("test"),
+					"Invalid HTTP Date String Value",
+					"A string value was provided that is not valid HTTP date string format, such as \"Sun, 06 Nov 1994 08:49:37 GMT\".\n\n"+
+						"Given Value: Sun, 06 Nov 1994 08:49:37 UTC\n"+
+						"Error: parsing time \"Sun, 06 Nov 1994 08:49:37 UTC\" as \"Mon, 02 Jan 2006 15:04:05 GMT\": cannot parse \"UTC\" as \" GMT\"",
+				),
+			},
+		},
+	}
+	for name, testCase := range testCases {
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			resp := xattr.ValidateAttributeResponse{}
+
+			testCase.HTTPDate.ValidateAttribute(
+				context.Background(),
+				xattr.ValidateAttributeRequest{
+					Path: path.Root("test"),
+				},
+				&resp,
+			)
+
+			if diff := cmp.Diff(resp.Diagnostics, testCase.expectedDiags); diff != "" {
+				t.Errorf("Unexpected diagnostics (-got, +expected): %s", diff)
+			}
+		})
+	}
+}
+
+func TestHTTPDateValidateParameter(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		HTTPDate        timetypes.HTTPDate
+		expectedFuncErr *function.FuncError
+	}{
+		"empty-struct": {
+			HTTPDate: timetypes.HTTPDate{},
+		},
+		"null": {
+			HTTPDate: timetypes.NewHTTPDateNull(),
+		},
+		"unknown": {
+			HTTPDate: timetypes.NewHTTPDateUnknown(),
+		},
+		"valid IMF-fixdate": {
+			HTTPDate: timetypes.NewHTTPDateValueMust("Sun, 06 Nov 1994 08:49:37 GMT"),
+		},
+		"invalid": {
+			HTTPDate: timetypes.HTTPDate{
+				StringValue: basetypes.NewStringValue("1994-11-06T08:49:37Z"),
+			},
+			expectedFuncErr: function.NewArgumentFuncError(
+				0,
+				"Invalid HTTP Date String Value: "+
+					"A string value was provided that is not valid HTTP date string format, such as \"Sun, 06 Nov 1994 08:49:37 GMT\".\n\n"+
+					"Given Value: 1994-11-06T08:49:37Z\n"+
+					"Error: parsing time \"1994-11-06T08:49:37Z\" as \"Mon, 02 Jan 2006 15:04:05 GMT\": cannot parse \"1994-11-06T08:49:37Z\" as \"Mon\"",
+			),
+		},
+	}
+	for name, testCase := range testCases {
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			resp := function.ValidateParameterResponse{}
+
+			testCase.HTTPDate.ValidateParameter(
+				context.Background(),
+				function.ValidateParameterRequest{
+					Position: int64(0),
+				},
+				&resp,
+			)
+
+			if diff := cmp.Diff(resp.Error, testCase.expectedFuncErr); diff != "" {
+				t.Errorf("Unexpected diagnostics (-got, +expected): %s", diff)
+			}
+		})
+	}
+}
+
+func TestHTTPDate_ValueHTTPDateTime(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		HTTPDate      timetypes.HTTPDate
+		expectedTime  time.Time
+		expectedDiags diag.Diagnostics
+	}{
+		"HTTP date string value is null ": {
+			HTTPDate: timetypes.NewHTTPDateNull(),
+			expectedDiags: diag.Diagnostics{
+				diag.NewErrorDiagnostic(
+					"HTTPDate ValueHTTPDateTime Error",
+					"HTTP date string value is null",
+				),
+			},
+		},
+		"HTTP date string value is unknown ": {
+			HTTPDate: timetypes.NewHTTPDateUnknown(),
+			expectedDiags: diag.Diagnostics{
+				diag.NewErrorDiagnostic(
+					"HTTPDate ValueHTTPDateTime Error",
+					"HTTP date string value is unknown",
+				),
+			},
+		},
+		"valid IMF-fixdate": {
+			HTTPDate:     timetypes.NewHTTPDateValueMust("Sun, 06 Nov 1994 08:49:37 GMT"),
+			expectedTime: time.Date(1994, time.November, 6, 8, 49, 37, 0, time.UTC),
+		},
+		"valid RFC 850": {
+			HTTPDate:     httpDateValueWithObsoleteFormats("Sunday, 06-Nov-94 08:49:37 GMT"),
+			expectedTime: time.Date(1994, time.November, 6, 8, 49, 37, 0, time.UTC),
+		},
+		"valid asctime": {
+			HTTPDate:     httpDateValueWithObsoleteFormats("Tue Jul 25 23:43:16 2023"),
+			expectedTime: time.Date(2023, time.July, 25, 23, 43, 16, 0, time.UTC),
+		},
+	}
+	for name, testCase := range testCases {
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			httpDateTime, diags := testCase.HTTPDate.ValueHTTPDateTime()
+
+			if !httpDateTime.Equal(testCase.expectedTime) {
+				t.Errorf("Unexpected time, got: %s, expected: %s", httpDateTime, testCase.expectedTime)
+			}
+
+			if diff := cmp.Diff(diags, testCase.expectedDiags); diff != "" {
+				t.Errorf("Unexpected diagnostics (-got, +expected): %s", diff)
+			}
+		})
+	}
+}
+
+func TestHTTPDate_ToRFC3339Value(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		HTTPDate timetypes.HTTPDate
+		expected timetypes.RFC3339
+	}{
+		"null": {
+			HTTPDate: timetypes.NewHTTPDateNull(),
+			expected: timetypes.NewRFC3339Null(),
+		},
+		"unknown": {
+			HTTPDate: timetypes.NewHTTPDateUnknown(),
+			expected: timetypes.NewRFC3339Unknown(),
+		},
+		"IMF-fixdate": {
+			HTTPDate: timetypes.NewHTTPDateValueMust("Sun, 06 Nov 1994 08:49:37 GMT"),
+			expected: timetypes.NewRFC3339ValueMust("1994-11-06T08:49:37Z"),
+		},
+		"asctime": {
+			HTTPDate: httpDateValueWithObsoleteFormats("Sun Nov  6 08:49:37 1994"),
+			expected: timetypes.NewRFC3339ValueMust("1994-11-06T08:49:37Z"),
+		},
+	}
+	for name, testCase := range testCases {
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got, diags := testCase.HTTPDate.ToRFC3339Value()
+
+			if !got.Equal(testCase.expected) {
+				t.Errorf("Expected %s, got %s", testCase.expected, got)
+			}
+
+			if diags.HasError() {
+				t.Errorf("Unexpected diagnostics: %v", diags)
+			}
+		})
+	}
+}
+
+func TestNewHTTPDateTimeValue(t *testing.T) {
+	t.Parallel()
+
+	got := timetypes.NewHTTPDateTimeValue(time.Date(1994, time.November, 6, 9, 49, 37, 999, time.FixedZone("", 60*60)))
+	expected := timetypes.NewHTTPDateValueMust("Sun, 06 Nov 1994 08:49:37 GMT")
+
+	if !got.Equal(expected) {
+		t.Errorf("Expected %s, got %s", expected, got)
+	}
+}
+
+// httpDateValueWithObsoleteFormats returns an HTTPDate that allows obsolete formats without validating the value.
+func httpDateValueWithObsoleteFormats(value string) timetypes.HTTPDate {
+	valuable, _ := timetypes.HTTPDateType{AllowObsoleteFormats: true}.ValueFromString(context.Background(), basetypes.NewStringValue(value))
+
+	return valuable.(timetypes.HTTPDate)
+}
@@ -0,0 +1,77 @@
+// Copyright IBM Corp. 2023, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package timetypes
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+var (
+	_ basetypes.StringTypable = (*ISO8601BasicDateTimeType)(nil)
+)
+
+// ISO8601BasicDateTimeType is an attribute type that represents a valid ISO 8601 basic format date and time string with a
+// UTC offset, such as `20261016T120000Z`. Semantic equality logic is defined for ISO8601BasicDateTimeType such that values
+// are equal to the extended format RFC3339 representation of the same instant and UTC offset.
+type ISO8601BasicDateTimeType struct {
+	basetypes.StringType
+}
+
+// String returns a human-readable string of the type name.
+func (t ISO8601BasicDateTimeType) String() string {
+	return "timetypes.ISO8601BasicDateTimeType"
+}
+
+// ValueType returns the Value type.
+func (t ISO8601BasicDateTimeType) ValueType(ctx context.Context) attr.Value {
+	return ISO8601BasicDateTime{}
+}
+
+// Equal returns true if the given type is equivalent.
+func (t ISO8601BasicDateTimeType) Equal(o attr.Type) bool {
+	other, ok := o.(ISO8601BasicDateTimeType)
+
+	if !ok {
+		return false
+	}
+
+	return t.StringType.Equal(other.StringType)
+}
+
+// ValueFromString returns a StringValuable type given a StringValue.
+func (t ISO8601BasicDateTimeType) ValueFromString(ctx context.Context, in basetypes.StringValue) (basetypes.StringValuable, diag.Diagnostics) {
+	return ISO8601BasicDateTime{
+		StringValue: in,
+	}, nil
+}
+
+// ValueFromTerraform returns a Value given a tftypes.Value.  This is meant to convert the tftypes.Value into a more convenient Go type
+// for the provider to consume the data with.
+func (t ISO8601BasicDateTimeType) ValueFromTerraform(ctx context.Context, in tftypes.Value) (attr.Value, error) {
+	attrValue, err := t.StringType.ValueFromTerraform(ctx, in)
+
+	if err != nil {
+		return nil, err
+	}
+
+	stringValue, ok := attrValue.(basetypes.StringValue)
+
+	if !ok {
+		return nil, fmt.Errorf("unexpected value type of %T", attrValue)
+	}
+
+	stringValuable, diags := t.ValueFromString(ctx, stringValue)
+
+	if diags.HasError() {
+		return nil, fmt.Errorf("unexpected error converting StringValue to StringValuable: %v", diags)
+	}
+
+	return stringValuable, nil
+}
@@ -0,0 +1,372 @@
+// Copyright IBM Corp. 2023, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package timetypes
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/attr/xattr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+)
+
+var (
+	_ basetypes.StringValuableWithSemanticEquals = (*ISO8601BasicDateTime)(nil)
+	_ xattr.ValidateableAttribute                = (*ISO8601BasicDateTime)(nil)
+	_ function.ValidateableParameter             = (*ISO8601BasicDateTime)(nil)
+)
+
+// iso8601BasicDateTimeLayout is the time.Time layout of an ISO 8601 basic format date and time. The `Z0700` element
+// formats a zero UTC offset as `Z`.
+const iso8601BasicDateTimeLayout = "20060102T150405.999999999Z0700"
+
+// ISO8601BasicDateTime represents a valid ISO 8601 basic (compact) format date and time string with a UTC offset, such
+// as `20261016T120000Z` or `20261016T120000+0200`, as used by AWS Signature Version 4 headers and in file names. The
+// format is `YYYYMMDDThhmmss`, optionally followed by fractional seconds with a `.` or `,` decimal separator, and then
+// a `Z`, `+hhmm` or `+hh` UTC offset. Semantic equality logic is defined for ISO8601BasicDateTime such that a value is
+// equal to the extended format RFC3339 representation of the same instant and UTC offset.
+type ISO8601BasicDateTime struct {
+	basetypes.StringValue
+}
+
+// Type returns an ISO8601BasicDateTimeType.
+func (v ISO8601BasicDateTime) Type(_ context.Context) attr.Type {
+	return ISO8601BasicDateTimeType{}
+}
+
+// Equal returns true if the given value is equivalent.
+func (v ISO8601BasicDateTime) Equal(o attr.Value) bool {
+	other, ok := o.(ISO8601BasicDateTime)
+
+	if !ok {
+		return false
+	}
+
+	return v.StringValue.Equal(other.StringValue)
+}
+
+// StringSemanticEquals returns true if the given ISO8601BasicDateTime or RFC3339 string value is semantically equal to
+// the current ISO8601BasicDateTime string value, which is when both values represent the same instant with the same
+// UTC offset. The `Z` suffix is considered equal to a `00:00` UTC offset.
+//
+// Examples:
+//   - `20261016T120000Z` is semantically equal to `2026-10-16T12:00:00Z`
+//   - `20261016T140000+0200` is semantically equal to `20261016T140000,000+02`
+//
+// Counterexamples:
+//   - `20261016T140000+0200` expresses the same time as `2026-10-16T12:00:00Z` but is NOT considered to be
+//     semantically equal.
+func (v ISO8601BasicDateTime) StringSemanticEquals(_ context.Context, newValuable basetypes.StringValuable) (bool, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	var newTime time.Time
+
+	// ISO 8601 basic date time and RFC3339 strings are already validated at this point, ignoring errors
+	switch newValue := newValuable.(type) {
+	case ISO8601BasicDateTime:
+		newTime, _ = parseISO8601BasicDateTime(newValue.ValueString())
+	case RFC3339:
+		newTime, _ = time.Parse(time.RFC3339, newValue.ValueString())
+	default:
+		diags.AddError(
+			"Semantic Equality Check Error",
+			"An unexpected value type was received while performing semantic equality checks. "+
+				"Please report this to the provider developers.\n\n"+
+				"Expected Value Type: "+fmt.Sprintf("%T", v)+"\n"+
+				"Got Value Type: "+fmt.Sprintf("%T", newValuable),
+		)
+
+		return false, diags
+	}
+
+	currentTime, _ := parseISO8601BasicDateTime(v.ValueString())
+
+	_, currentOffset := currentTime.Zone()
+	_, newOffset := newTime.Zone()
+
+	return currentTime.Equal(newTime) && currentOffset == newOffset, diags
+}
+
+// ValidateAttribute implements attribute value validation. This type requires the value to be a String value that
+// is valid ISO 8601 basic format date and time with a UTC offset.
+func (v ISO8601BasicDateTime) ValidateAttribute(ctx context.Context, req xattr.ValidateAttributeRequest, resp *xattr.ValidateAttributeResponse) {
+	if v.IsUnknown() || v.IsNull() {
+		return
+	}
+
+	if _, err := parseISO8601BasicDateTime(v.ValueString()); err != nil {
+		resp.Diagnostics.Append(diag.WithPath(req.Path, iso8601BasicDateTimeInvalidStringDiagnostic(v.ValueString(), err)))
+
+		return
+	}
+}
+
+// ValidateParameter implements provider-defined function parameter value validation. This type requires the value to
+// be a String value that is valid ISO 8601 basic format date and time with a UTC offset.
+func (v ISO8601BasicDateTime) ValidateParameter(ctx context.Context, req function.ValidateParameterRequest, resp *function.ValidateParameterResponse) {
+	if v.IsUnknown() || v.IsNull() {
+		return
+	}
+
+	if _, err := parseISO8601BasicDateTime(v.ValueString()); err != nil {
+		resp.Error = function.NewArgumentFuncError(
+			req.Position,
+			"Invalid ISO 8601 Basic Date Time String Value: "+
+				"A string value was provided that is not valid ISO 8601 basic date and time string format with a UTC offset, such as \"20261016T120000Z\" or \"20261016T120000+0200\".\n\n"+
+				"Given Value: "+v.ValueString()+"\n"+
+				"Error: "+err.Error(),
+		)
+
+		return
+	}
+}
+
+// ValueRFC3339Time creates a new time.Time instance with the ISO8601BasicDateTime StringValue, in its UTC offset. A
+// null or unknown value will produce an error diagnostic.
+func (v ISO8601BasicDateTime) ValueRFC3339Time() (time.Time, diag.Diagnostics) {
+	return v.valueISO8601BasicDateTime("ValueRFC3339Time")
+}
+
+// ToRFC3339Value converts the ISO8601BasicDateTime to an RFC3339 value in extended format, such as
+// `2026-10-16T14:00:00+02:00` for `20261016T140000+0200`. Fractional seconds are preserved. Null and unknown values
+// are converted to null and unknown RFC3339 values respectively.
+func (v ISO8601BasicDateTime) ToRFC3339Value() (RFC3339, diag.Diagnostics) {
+	if v.IsNull() {
+		return NewRFC3339Null(), nil
+	}
+
+	if v.IsUnknown() {
+		return NewRFC3339Unknown(), nil
+	}
+
+	t, diags := v.valueISO8601BasicDateTime("ToRFC3339Value")
+	if diags.HasError() {
+		return NewRFC3339Unknown(), diags
+	}
+
+	return newRFC3339TimeNanoValue(t), nil
+}
+
+// valueISO8601BasicDateTime returns the parsed ISO8601BasicDateTime, with error diagnostics summarized by the given
+// accessor method name.
+func (v ISO8601BasicDateTime) valueISO8601BasicDateTime(accessor string) (time.Time, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	summary := "ISO8601BasicDateTime " + accessor + " Error"
+
+	if v.IsNull() {
+		diags.Append(diag.NewErrorDiagnostic(summary, "ISO 8601 basic date time string value is null"))
+		return time.Time{}, diags
+	}
+
+	if v.IsUnknown() {
+		diags.Append(diag.NewErrorDiagnostic(summary, "ISO 8601 basic date time string value is unknown"))
+		return time.Time{}, diags
+	}
+
+	t, err := parseISO8601BasicDateTime(v.ValueString())
+	if err != nil {
+		diags.Append(diag.NewErrorDiagnostic(summary, err.Error()))
+		return time.Time{}, diags
+	}
+
+	return t, nil
+}
+
+// NewISO8601BasicDateTimeNull creates an ISO8601BasicDateTime with a null value. Determine whether the value is null via IsNull method.
+func NewISO8601BasicDateTimeNull() ISO8601BasicDateTime {
+	return ISO8601BasicDateTime{
+		StringValue: basetypes.NewStringNull(),
+	}
+}
+
+// NewISO8601BasicDateTimeUnknown creates an ISO8601BasicDateTime with an unknown value. Determine whether the value is unknown via IsUnknown method.
+func NewISO8601BasicDateTimeUnknown() ISO8601BasicDateTime {
+	return ISO8601BasicDateTime{
+		StringValue: basetypes.NewStringUnknown(),
+	}
+}
+
+// NewISO8601BasicDateTimeTimeValue creates an ISO8601BasicDateTime with a known value in ISO 8601 basic format, with
+// fractional seconds only if present.
+func NewISO8601BasicDateTimeTimeValue(value time.Time) ISO8601BasicDateTime {
+	return ISO8601BasicDateTime{
+		StringValue: basetypes.NewStringValue(value.Format(iso8601BasicDateTimeLayout)),
+	}
+}
+
+// NewISO8601BasicDateTimeTimePointerValue creates an ISO8601BasicDateTime with a null value if nil or
+// a known value in ISO 8601 basic format.
+func NewISO8601BasicDateTimeTimePointerValue(value *time.Time) ISO8601BasicDateTime {
+	if value == nil {
+		return NewISO8601BasicDateTimeNull()
+	}
+
+	return NewISO8601BasicDateTimeTimeValue(*value)
+}
+
+// NewISO8601BasicDateTimeValue creates an ISO8601BasicDateTime with a known value or raises an error
+// diagnostic if the string is not ISO 8601 basic date and time format with a UTC offset.
+func NewISO8601BasicDateTimeValue(value string) (ISO8601BasicDateTime, diag.Diagnostics) {
+	_, err := parseISO8601BasicDateTime(value)
+
+	if err != nil {
+		// Returning an unknown value will guarantee that, as a last resort,
+		// Terraform will return an error if attempting to store into state.
+		return NewISO8601BasicDateTimeUnknown(), diag.Diagnostics{iso8601BasicDateTimeInvalidStringDiagnostic(value, err)}
+	}
+
+	return ISO8601BasicDateTime{
+		StringValue: basetypes.NewStringValue(value),
+	}, nil
+}
+
+// NewISO8601BasicDateTimeValueMust creates an ISO8601BasicDateTime with a known value or raises a panic
+// if the string is not ISO 8601 basic date and time format with a UTC offset.
+//
+// This creation function is only recommended to create ISO8601BasicDateTime values which
+// either will not potentially affect practitioners, such as testing, or within
+// exhaustively tested provider logic.
+func NewISO8601BasicDateTimeValueMust(value string) ISO8601BasicDateTime {
+	_, err := parseISO8601BasicDateTime(value)
+
+	if err != nil {
+		panic(fmt.Sprintf("Invalid ISO 8601 Basic Date Time String Value (%s): %s", value, err))
+	}
+
+	return ISO8601BasicDateTime{
+		StringValue: basetypes.NewStringValue(value),
+	}
+}
+
+// NewISO8601BasicDateTimePointerValue creates an ISO8601BasicDateTime with a null value if nil, a known
+// value, or raises an error diagnostic if the string is not ISO 8601 basic date and time
+// format with a UTC offset.
+func NewISO8601BasicDateTimePointerValue(value *string) (ISO8601BasicDateTime, diag.Diagnostics) {
+	if value == nil {
+		return NewISO8601BasicDateTimeNull(), nil
+	}
+
+	return NewISO8601BasicDateTimeValue(*value)
+}
+
+// NewISO8601BasicDateTimePointerValueMust creates an ISO8601BasicDateTime with a null value if nil, a
+// known value, or raises a panic if the string is not ISO 8601 basic date and time format
+// with a UTC offset.
+//
+// This creation function is only recommended to create ISO8601BasicDateTime values which
+// either will not potentially affect practitioners, such as testing, or within
+// exhaustively tested provider logic.
+func NewISO8601BasicDateTimePointerValueMust(value *string) ISO8601BasicDateTime {
+	if value == nil {
+		return NewISO8601BasicDateTimeNull()
+	}
+
+	return NewISO8601BasicDateTimeValueMust(*value)
+}
+
+// parseISO8601BasicDateTime parses an ISO 8601 basic format date and time string of the format
+// `YYYYMMDDThhmmss[.fffffffff](Z|+hhmm|+hh)`, returning the time in its UTC offset.
+func parseISO8601BasicDateTime(value string) (time.Time, error) {
+	year, rest, err := parseFixedDigits(value, 4, "year", 0, 9999)
+	if err != nil {
+		return time.Time{}, err
+	}
+
+	month, rest, err := parseFixedDigits(rest, 2, "month", 1, 12)
+	if err != nil {
+		return time.Time{}, err
+	}
+
+	day, rest, err := parseFixedDigits(rest, 2, "day", 1, 31)
+	if err != nil {
+		return time.Time{}, err
+	}
+
+	if err := checkDayOfMonth(year, month, day); err != nil {
+		return time.Time{}, err
+	}
+
+	if rest, err = parseSeparator(rest, 'T', "day"); err != nil {
+		return time.Time{}, err
+	}
+
+	hour, rest, err := parseFixedDigits(rest, 2, "hour", 0, 23)
+	if err != nil {
+		return time.Time{}, err
+	}
+
+	minute, rest, err := parseFixedDigits(rest, 2, "minute", 0, 59)
+	if err != nil {
+		return time.Time{}, err
+	}
+
+	second, rest, err := parseFixedDigits(rest, 2, "second", 0, 59)
+	if err != nil {
+		return time.Time{}, err
+	}
+
+	var nanosecond int
+
+	if rest != "" && (rest[0] == '.' || rest[0] == ',') {
+		i := 1
+
+		for i < len(rest) && rest[i] >= '0' && rest[i] <= '9' {
+			nanosecond = nanosecond*10 + int(rest[i]-'0')
+			i++
+		}
+
+		switch {
+		case i == 1:
+			return time.Time{}, fmt.Errorf("expected digits after decimal separator in fractional seconds, got %q", rest[1:])
+		case i > 10:
+			return time.Time{}, fmt.Errorf("fractional seconds must not have more than 9 digits, got %q", rest[1:i])
+		}
+
+		for n := i - 1; n < 9; n++ {
+			nanosecond *= 10
+		}
+
+		rest = rest[i:]
+	}
+
+	loc := time.UTC
+
+	switch {
+	case rest == "Z":
+	case rest != "" && (rest[0] == '+' || rest[0] == '-'):
+		offsetHours, offsetRest, err := parseFixedDigits(rest[1:], 2, "offset hour", 0, 23)
+		if err != nil {
+			return time.Time{}, err
+		}
+
+		var offsetMinutes int
+
+		if offsetRest != "" {
+			if offsetMinutes, offsetRest, err = parseFixedDigits(offsetRest, 2, "offset minute", 0, 59); err != nil {
+				return time.Time{}, err
+			}
+		}
+
+		if offsetRest != "" {
+			return time.Time{}, fmt.Errorf("unexpected text after UTC offset: %q", offsetRest)
+		}
+
+		offset := offsetHours*60*60 + offsetMinutes*60
+
+		if rest[0] == '-' {
+			offset = -offset
+		}
+
+		loc = time.FixedZone("", offset)
+	default:
+		return time.Time{}, fmt.Errorf(`expected a "Z" or "+hhmm" UTC offset after time, got %q`, rest)
+	}
+
+	return time.Date(year, time.Month(month), day, hour, minute, second, nanosecond, loc), nil
+}
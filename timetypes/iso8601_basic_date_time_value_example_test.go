@@ -0,0 +1,34 @@
+// Copyright IBM Corp. 2023, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package timetypes_test
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework-timetypes/timetypes"
+)
+
+type SignedRequestDataSourceModel struct {
+	SignedAt timetypes.ISO8601BasicDateTime `tfsdk:"signed_at"`
+}
+
+func ExampleISO8601BasicDateTime_ValueRFC3339Time() {
+	// For example purposes, typically the data model would be populated automatically by Plugin Framework via Config, Plan or State.
+	// https://developer.hashicorp.com/terraform/plugin/framework/handling-data/accessing-values
+	data := SignedRequestDataSourceModel{
+		SignedAt: timetypes.NewISO8601BasicDateTimeValueMust("20261016T120000Z"),
+	}
+
+	// Check that the ISO 8601 basic date time data is known and able to be converted to time.Time
+	if !data.SignedAt.IsNull() && !data.SignedAt.IsUnknown() {
+		t, diags := data.SignedAt.ValueRFC3339Time()
+		if diags.HasError() {
+			return
+		}
+
+		// Output: 2026-10-16T12:00:00Z
+		fmt.Println(t.Format(time.RFC3339))
+	}
+}
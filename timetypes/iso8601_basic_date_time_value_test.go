@@ -0,0 +1,417 @@
+// Copyright IBM Corp. 2023, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package timetypes_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-framework/attr/xattr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+
+	"github.com/hashicorp/terraform-plugin-framework-timetypes/timetypes"
+)
+
+func TestISO8601BasicDateTime_StringSemanticEquals(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		currentISO8601BasicDateTime timetypes.ISO8601BasicDateTime
+		givenISO8601BasicDateTime   basetypes.StringValuable
+		expectedMatch               bool
+		expectedDiags               diag.Diagnostics
+	}{
+		"not equal - different time": {
+			currentISO8601BasicDateTime: timetypes.NewISO8601BasicDateTimeValueMust("20261016T120000Z"),
+			givenISO8601BasicDateTime:   timetypes.NewISO8601BasicDateTimeValueMust("20261016T120001Z"),
+			expectedMatch:               false,
+		},
+		"not equal - same instant with different offset": {
+			currentISO8601BasicDateTime: timetypes.NewISO8601BasicDateTimeValueMust("20261016T140000+0200"),
+			givenISO8601BasicDateTime:   timetypes.NewISO8601BasicDateTimeValueMust("20261016T120000Z"),
+			expectedMatch:               false,
+		},
+		"not equal - RFC3339 with different offset": {
+			currentISO8601BasicDateTime: timetypes.NewISO8601BasicDateTimeValueMust("20261016T140000+0200"),
+			givenISO8601BasicDateTime:   timetypes.NewRFC3339ValueMust("2026-10-16T12:00:00Z"),
+			expectedMatch:               false,
+		},
+		"semantically equal - byte for byte match": {
+			currentISO8601BasicDateTime: timetypes.NewISO8601BasicDateTimeValueMust("20261016T120000Z"),
+			givenISO8601BasicDateTime:   timetypes.NewISO8601BasicDateTimeValueMust("20261016T120000Z"),
+			expectedMatch:               true,
+		},
+		"semantically equal - fractional seconds and offset without minutes": {
+			currentISO8601BasicDateTime: timetypes.NewISO8601BasicDateTimeValueMust("20261016T140000+0200"),
+			givenISO8601BasicDateTime:   timetypes.NewISO8601BasicDateTimeValueMust("20261016T140000,000+02"),
+			expectedMatch:               true,
+		},
+		"semantically equal - Z and +0000 offsets": {
+			currentISO8601BasicDateTime: timetypes.NewISO8601BasicDateTimeValueMust("20261016T120000Z"),
+			givenISO8601BasicDateTime:   timetypes.NewISO8601BasicDateTimeValueMust("20261016T120000+0000"),
+			expectedMatch:               true,
+		},
+		"semantically equal - RFC3339": {
+			currentISO8601BasicDateTime: timetypes.NewISO8601BasicDateTimeValueMust("20261016T120000Z"),
+			givenISO8601BasicDateTime:   timetypes.NewRFC3339ValueMust("2026-10-16T12:00:00Z"),
+			expectedMatch:               true,
+		},
+		"semantically equal - RFC3339 with offset": {
+			currentISO8601BasicDateTime: timetypes.NewISO8601BasicDateTimeValueMust("20261016T140000.5+0200"),
+			givenISO8601BasicDateTime:   timetypes.NewRFC3339ValueMust("2026-10-16T14:00:00.5+02:00"),
+			expectedMatch:               true,
+		},
+		"error - not given ISO8601BasicDateTime or RFC3339 value": {
+			currentISO8601BasicDateTime: timetypes.NewISO8601BasicDateTimeValueMust("20261016T120000Z"),
+			givenISO8601BasicDateTime:   basetypes.NewStringValue("20261016T120000Z"),
+			expectedMatch:               false,
+			expectedDiags: diag.Diagnostics{
+				diag.NewErrorDiagnostic(
+					"Semantic Equality Check Error",
+					"An unexpected value type was received while performing semantic equality checks. "+
+						"Please report this to the provider developers.\n\n"+
+						"Expected Value Type: timetypes.ISO8601BasicDateTime\n"+
+						"Got Value Type: basetypes.StringValue",
+				),
+			},
+		},
+	}
+	for name, testCase := range testCases {
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			match, diags := testCase.currentISO8601BasicDateTime.StringSemanticEquals(context.Background(), testCase.givenISO8601BasicDateTime)
+
+			if testCase.expectedMatch != match {
+				t.Errorf("Expected StringSemanticEquals to return: %t, but got: %t", testCase.expectedMatch, match)
+			}
+
+			if diff := cmp.Diff(diags, testCase.expectedDiags); diff != "" {
+				t.Errorf("Unexpected diagnostics (-got, +expected): %s", diff)
+			}
+		})
+	}
+}
+
+func TestISO8601BasicDateTimeValidateAttribute(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		iso8601BasicDateTime timetypes.ISO8601BasicDateTime
+		expectedDiags        diag.Diagnostics
+	}{
+		"empty-struct": {
+			iso8601BasicDateTime: timetypes.ISO8601BasicDateTime{},
+		},
+		"null": {
+			iso8601BasicDateTime: timetypes.NewISO8601BasicDateTimeNull(),
+		},
+		"unknown": {
+			iso8601BasicDateTime: timetypes.NewISO8601BasicDateTimeUnknown(),
+		},
+		"valid - UTC": {
+			iso8601BasicDateTime: timetypes.NewISO8601BasicDateTimeValueMust("20261016T120000Z"),
+		},
+		"valid - UTC offset": {
+			iso8601BasicDateTime: timetypes.NewISO8601BasicDateTimeValueMust("20261016T120000-0530"),
+		},
+		"valid - UTC offset without minutes": {
+			iso8601BasicDateTime: timetypes.NewISO8601BasicDateTimeValueMust("20261016T120000+02"),
+		},
+		"valid - fractional seconds": {
+			iso8601BasicDateTime: timetypes.NewISO8601BasicDateTimeValueMust("20261016T120000.123456789Z"),
+		},
+		"valid - leap day": {
+			iso8601BasicDateTime: timetypes.NewISO8601BasicDateTimeValueMust("20280229T000000Z"),
+		},
+		"invalid - extended format": {
+			iso8601BasicDateTime: timetypes.ISO8601BasicDateTime{
+				StringValue: basetypes.NewStringValue("2026-10-16T12:00:00Z"),
+			},
+			expectedDiags: diag.Diagnostics{
+				diag.NewAttributeErrorDiagnostic(
+					path.Root("test"),
+					"Invalid ISO 8601 Basic Date Time String Value",
+					"A string value was provided that is not valid ISO 8601 basic date and time string format with a UTC offset, such as \"20261016T120000Z\" or \"20261016T120000+0200\".\n\n"+
+						"Given Value: 2026-10-16T12:00:00Z\n"+
+						"Error: expected 2 digit month, got \"-1\"",
+				),
+			},
+		},
+		"invalid - missing UTC offset": {
+			iso8601BasicDateTime: timetypes.ISO8601BasicDateTime{
+				StringValue: basetypes.NewStringValue("20261016T120000"),
+			},
+			expectedDiags: diag.Diagnostics{
+				diag.NewAttributeErrorDiagnostic(
+					path.Root("test"),
+					"Invalid ISO 8601 Basic Date Time String Value",
+					"A string value was provided that is not valid ISO 8601 basic date and time string format with a UTC offset, such as \"20261016T120000Z\" or \"20261016T120000+0200\".\n\n"+
+						"Given Value: 20261016T120000\n"+
+						"Error: expected a \"Z\" or \"+hhmm\" UTC offset after time, got \"\"",
+				),
+			},
+		},
+		"invalid - extended UTC offset": {
+			iso8601BasicDateTime: timetypes.ISO8601BasicDateTime{
+				StringValue: basetypes.NewStringValue("20261016T120000+02:00"),
+			},
+			expectedDiags: diag.Diagnostics{
+				diag.NewAttributeErrorDiagnostic(
+					path.Root("test"),
+					"Invalid ISO 8601 Basic Date Time String Value",
+					"A string value was provided that is not valid ISO 8601 basic date and time string format with a UTC offset, such as \"20261016T120000Z\" or \"20261016T120000+0200\".\n\n"+
+						"Given Value: 20261016T120000+02:00\n"+
+						"Error: expected 2 digit offset minute, got \":0\"",
+				),
+			},
+		},
+		"invalid - day out of range": {
+			iso8601BasicDateTime: timetypes.ISO8601BasicDateTime{
+				StringValue: basetypes.NewStringValue("20260229T120000Z"),
+			},
+			expectedDiags: diag.Diagnostics{
+				diag.NewAttributeErrorDiagnostic(
+					path.Root("test"),
+					"Invalid ISO 8601 Basic Date Time String Value",
+					"A string value was provided that is not valid ISO 8601 basic date and time string format with a UTC offset, such as \"20261016T120000Z\" or \"20261016T120000+0200\".\n\n"+
+						"Given Value: 20260229T120000Z\n"+
+						"Error: day 29 is out of range for 2026-02, which has 28 days",
+				),
+			},
+		},
+		"invalid - fractional seconds": {
+			iso8601BasicDateTime: timetypes.ISO8601BasicDateTime{
+				StringValue: basetypes.NewStringValue("20261016T120000.Z"),
+			},
+			expectedDiags: diag.Diagnostics{
+				diag.NewAttributeErrorDiagnostic(
+					path.Root("test"),
+					"Invalid ISO 8601 Basic Date Time String Value",
+					"A string value was provided that is not valid ISO 8601 basic date and time string format with a UTC offset, such as \"20261016T120000Z\" or \"20261016T120000+0200\".\n\n"+
+						"Given Value: 20261016T120000.Z\n"+
+						"Error: expected digits after decimal separator in fractional seconds, got \"Z\"",
+				),
+			},
+		},
+		"invalid - hour": {
+			iso8601BasicDateTime: timetypes.ISO8601BasicDateTime{
+				StringValue: basetypes.NewStringValue("20261016T240000Z"),
+			},
+			expectedDiags: diag.Diagnostics{
+				diag.NewAttributeErrorDiagnostic(
+					path.Root("test"),
+					"Invalid ISO 8601 Basic Date Time String Value",
+					"A string value was provided that is not valid ISO 8601 basic date and time string format with a UTC offset, such as \"20261016T120000Z\" or \"20261016T120000+0200\".\n\n"+
+						"Given Value: 20261016T240000Z\n"+
+						"Error: hour \"24\" is out of range [0, 23]",
+				),
+			},
+		},
+	}
+	for name, testCase := range testCases {
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			resp := xattr.ValidateAttributeResponse{}
+
+			testCase.iso8601BasicDateTime.ValidateAttribute(
+				context.Background(),
+				xattr.ValidateAttributeRequest{
+					Path: path.Root("test"),
+				},
+				&resp,
+			)
+
+			if diff := cmp.Diff(resp.Diagnostics, testCase.expectedDiags); diff != "" {
+				t.Errorf("Unexpected diagnostics (-got, +expected): %s", diff)
+			}
+		})
+	}
+}
+
+func TestISO8601BasicDateTimeValidateParameter(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		iso8601BasicDateTime timetypes.ISO8601BasicDateTime
+		expectedFuncErr      *function.FuncError
+	}{
+		"empty-struct": {
+			iso8601BasicDateTime: timetypes.ISO8601BasicDateTime{},
+		},
+		"null": {
+			iso8601BasicDateTime: timetypes.NewISO8601BasicDateTimeNull(),
+		},
+		"unknown": {
+			iso8601BasicDateTime: timetypes.NewISO8601BasicDateTimeUnknown(),
+		},
+		"valid": {
+			iso8601BasicDateTime: timetypes.NewISO8601BasicDateTimeValueMust("20261016T120000+0200"),
+		},
+		"invalid": {
+			iso8601BasicDateTime: timetypes.ISO8601BasicDateTime{
+				StringValue: basetypes.NewStringValue("20261016T120000"),
+			},
+			expectedFuncErr: function.NewArgumentFuncError(
+				0,
+				"Invalid ISO 8601 Basic Date Time String Value: "+
+					"A string value was provided that is not valid ISO 8601 basic date and time string format with a UTC offset, such as \"20261016T120000Z\" or \"20261016T120000+0200\".\n\n"+
+					"Given Value: 20261016T120000\n"+
+					"Error: expected a \"Z\" or \"+hhmm\" UTC offset after time, got \"\"",
+			),
+		},
+	}
+	for name, testCase := range testCases {
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			resp := function.ValidateParameterResponse{}
+
+			testCase.iso8601BasicDateTime.ValidateParameter(
+				context.Background(),
+				function.ValidateParameterRequest{
+					Position: int64(0),
+				},
+				&resp,
+			)
+
+			if diff := cmp.Diff(resp.Error, testCase.expectedFuncErr); diff != "" {
+				t.Errorf("Unexpected diagnostics (-got, +expected): %s", diff)
+			}
+		})
+	}
+}
+
+func TestISO8601BasicDateTime_ValueRFC3339Time(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		iso8601BasicDateTime timetypes.ISO8601BasicDateTime
+		expectedTime         time.Time
+		expectedDiags        diag.Diagnostics
+	}{
+		"ISO 8601 basic date time string value is null": {
+			iso8601BasicDateTime: timetypes.NewISO8601BasicDateTimeNull(),
+			expectedDiags: diag.Diagnostics{
+				diag.NewErrorDiagnostic("ISO8601BasicDateTime ValueRFC3339Time Error", "ISO 8601 basic date time string value is null"),
+			},
+		},
+		"ISO 8601 basic date time string value is unknown": {
+			iso8601BasicDateTime: timetypes.NewISO8601BasicDateTimeUnknown(),
+			expectedDiags: diag.Diagnostics{
+				diag.NewErrorDiagnostic("ISO8601BasicDateTime ValueRFC3339Time Error", "ISO 8601 basic date time string value is unknown"),
+			},
+		},
+		"UTC": {
+			iso8601BasicDateTime: timetypes.NewISO8601BasicDateTimeValueMust("20261016T120000Z"),
+			expectedTime:         time.Date(2026, time.October, 16, 12, 0, 0, 0, time.UTC),
+		},
+		"UTC offset with fractional seconds": {
+			iso8601BasicDateTime: timetypes.NewISO8601BasicDateTimeValueMust("20261016T063000,25-0530"),
+			expectedTime:         time.Date(2026, time.October, 16, 12, 0, 0, 250000000, time.UTC),
+		},
+	}
+	for name, testCase := range testCases {
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got, diags := testCase.iso8601BasicDateTime.ValueRFC3339Time()
+
+			if !got.Equal(testCase.expectedTime) {
+				t.Errorf("Unexpected difference in time.Time, got: %s, expected: %s", got, testCase.expectedTime)
+			}
+
+			if diff := cmp.Diff(diags, testCase.expectedDiags); diff != "" {
+				t.Errorf("Unexpected diagnostics (-got, +expected): %s", diff)
+			}
+		})
+	}
+}
+
+func TestISO8601BasicDateTime_ToRFC3339Value(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		iso8601BasicDateTime timetypes.ISO8601BasicDateTime
+		expected             timetypes.RFC3339
+		expectedDiags        diag.Diagnostics
+	}{
+		"null": {
+			iso8601BasicDateTime: timetypes.NewISO8601BasicDateTimeNull(),
+			expected:             timetypes.NewRFC3339Null(),
+		},
+		"unknown": {
+			iso8601BasicDateTime: timetypes.NewISO8601BasicDateTimeUnknown(),
+			expected:             timetypes.NewRFC3339Unknown(),
+		},
+		"UTC": {
+			iso8601BasicDateTime: timetypes.NewISO8601BasicDateTimeValueMust("20261016T120000Z"),
+			expected:             timetypes.NewRFC3339ValueMust("2026-10-16T12:00:00Z"),
+		},
+		"UTC offset with fractional seconds": {
+			iso8601BasicDateTime: timetypes.NewISO8601BasicDateTimeValueMust("20261016T140000.5+02"),
+			expected:             timetypes.NewRFC3339ValueMust("2026-10-16T14:00:00.5+02:00"),
+		},
+	}
+	for name, testCase := range testCases {
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got, diags := testCase.iso8601BasicDateTime.ToRFC3339Value()
+
+			if !got.Equal(testCase.expected) {
+				t.Errorf("Expected %s, got %s", testCase.expected, got)
+			}
+
+			if diff := cmp.Diff(diags, testCase.expectedDiags); diff != "" {
+				t.Errorf("Unexpected diagnostics (-got, +expected): %s", diff)
+			}
+		})
+	}
+}
+
+func TestNewISO8601BasicDateTimeTimeValue(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		time     time.Time
+		expected timetypes.ISO8601BasicDateTime
+	}{
+		"UTC": {
+			time:     time.Date(2026, time.October, 16, 12, 0, 0, 0, time.UTC),
+			expected: timetypes.NewISO8601BasicDateTimeValueMust("20261016T120000Z"),
+		},
+		"fixed zone": {
+			time:     time.Date(2026, time.October, 16, 14, 0, 0, 0, time.FixedZone("", 2*60*60)),
+			expected: timetypes.NewISO8601BasicDateTimeValueMust("20261016T140000+0200"),
+		},
+		"fractional seconds": {
+			time:     time.Date(2026, time.October, 16, 12, 0, 0, 120000000, time.UTC),
+			expected: timetypes.NewISO8601BasicDateTimeValueMust("20261016T120000.12Z"),
+		},
+	}
+	for name, testCase := range testCases {
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got := timetypes.NewISO8601BasicDateTimeTimeValue(testCase.time)
+
+			if !got.Equal(testCase.expected) {
+				t.Errorf("Expected %s, got %s", testCase.expected, got)
+			}
+		})
+	}
+}
@@ -0,0 +1,77 @@
+// Copyright IBM Corp. 2023, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package timetypes
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+var (
+	_ basetypes.StringTypable = (*ISO8601DurationType)(nil)
+)
+
+// ISO8601DurationType is an attribute type that represents a valid ISO 8601 duration string, such as `P1DT2H30M`.
+// Semantic equality logic is defined for ISO8601DurationType such that durations expressing the same
+// calendar and fixed-length components, such as `PT60M` and `PT1H`, are considered equal.
+type ISO8601DurationType struct {
+	basetypes.StringType
+}
+
+// String returns a human-readable string of the type name.
+func (t ISO8601DurationType) String() string {
+	return "timetypes.ISO8601DurationType"
+}
+
+// ValueType returns the Value type.
+func (t ISO8601DurationType) ValueType(ctx context.Context) attr.Value {
+	return ISO8601Duration{}
+}
+
+// Equal returns true if the given type is equivalent.
+func (t ISO8601DurationType) Equal(o attr.Type) bool {
+	other, ok := o.(ISO8601DurationType)
+
+	if !ok {
+		return false
+	}
+
+	return t.StringType.Equal(other.StringType)
+}
+
+// ValueFromString returns a StringValuable type given a StringValue.
+func (t ISO8601DurationType) ValueFromString(ctx context.Context, in basetypes.StringValue) (basetypes.StringValuable, diag.Diagnostics) {
+	return ISO8601Duration{
+		StringValue: in,
+	}, nil
+}
+
+// ValueFromTerraform returns a Value given a tftypes.Value.  This is meant to convert the tftypes.Value into a more convenient Go type
+// for the provider to consume the data with.
+func (t ISO8601DurationType) ValueFromTerraform(ctx context.Context, in tftypes.Value) (attr.Value, error) {
+	attrValue, err := t.StringType.ValueFromTerraform(ctx, in)
+
+	if err != nil {
+		return nil, err
+	}
+
+	stringValue, ok := attrValue.(basetypes.StringValue)
+
+	if !ok {
+		return nil, fmt.Errorf("unexpected value type of %T", attrValue)
+	}
+
+	stringValuable, diags := t.ValueFromString(ctx, stringValue)
+
+	if diags.HasError() {
+		return nil, fmt.Errorf("unexpected error converting StringValue to StringValuable: %v", diags)
+	}
+
+	return stringValuable, nil
+}
@@ -0,0 +1,581 @@
+// Copyright IBM Corp. 2023, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package timetypes
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/attr/xattr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+)
+
+var (
+	_ basetypes.StringValuableWithSemanticEquals = (*ISO8601Duration)(nil)
+	_ xattr.ValidateableAttribute                = (*ISO8601Duration)(nil)
+	_ function.ValidateableParameter             = (*ISO8601Duration)(nil)
+)
+
+// ISO8601Duration represents a valid ISO 8601 duration string, such as `P1DT2H30M` or `PT0.5S`.
+//
+// The supported format is `PnYnMnWnDTnHnMnS`, where every component is optional but at least one must be present,
+// and components must appear in that order. Only the hour, minute and second components may contain a fractional
+// value, using either `.` or `,` as the decimal separator, and only when it is the smallest component given.
+// Fractional values are stored with nanosecond precision.
+type ISO8601Duration struct {
+	basetypes.StringValue
+}
+
+// ISO8601DurationComponents represents the parts of an ISO 8601 duration. The calendar components (years, months
+// and days) vary in length depending on the point in time they are applied to, so they are kept separately from the
+// fixed-length hour, minute and second components which are combined into Duration.
+type ISO8601DurationComponents struct {
+	// Years is the number of calendar years.
+	Years int64
+
+	// Months is the number of calendar months.
+	Months int64
+
+	// Days is the number of calendar days. Weeks are converted into days, as an ISO 8601 week is always 7 days.
+	Days int64
+
+	// Duration is the fixed-length part of the duration, made up of the hour, minute and second components.
+	Duration time.Duration
+}
+
+// AddTo returns the time t with the duration components added. The calendar components are added first via
+// time.Time.AddDate, followed by the fixed-length Duration.
+func (c ISO8601DurationComponents) AddTo(t time.Time) time.Time {
+	return t.AddDate(int(c.Years), int(c.Months), int(c.Days)).Add(c.Duration)
+}
+
+// String returns the ISO 8601 duration string format of the components, such as `P1Y2M3DT4H5M6.5S`. Components which
+// are zero are omitted and an all zero value is returned as `PT0S`. Negative components cannot be expressed in the
+// ISO 8601 duration format, so NewISO8601DurationValue rejects them.
+func (c ISO8601DurationComponents) String() string {
+	var b strings.Builder
+
+	b.WriteString("P")
+
+	if c.Years != 0 {
+		b.WriteString(strconv.FormatInt(c.Years, 10) + "Y")
+	}
+
+	if c.Months != 0 {
+		b.WriteString(strconv.FormatInt(c.Months, 10) + "M")
+	}
+
+	if c.Days != 0 {
+		b.WriteString(strconv.FormatInt(c.Days, 10) + "D")
+	}
+
+	if c.Duration == 0 {
+		if b.Len() == 1 {
+			b.WriteString("T0S")
+		}
+
+		return b.String()
+	}
+
+	b.WriteString("T")
+
+	hours := c.Duration / time.Hour
+	minutes := (c.Duration % time.Hour) / time.Minute
+	seconds := (c.Duration % time.Minute) / time.Second
+	nanoseconds := c.Duration % time.Second
+
+	if hours != 0 {
+		b.WriteString(strconv.FormatInt(int64(hours), 10) + "H")
+	}
+
+	if minutes != 0 {
+		b.WriteString(strconv.FormatInt(int64(minutes), 10) + "M")
+	}
+
+	if seconds != 0 || nanoseconds != 0 {
+		b.WriteString(strconv.FormatInt(int64(seconds), 10))
+
+		if nanoseconds != 0 {
+			b.WriteString(strings.TrimRight(fmt.Sprintf(".%09d", nanoseconds), "0"))
+		}
+
+		b.WriteString("S")
+	}
+
+	return b.String()
+}
+
+// Type returns an ISO8601DurationType.
+func (d ISO8601Duration) Type(_ context.Context) attr.Type {
+	return ISO8601DurationType{}
+}
+
+// Equal returns true if the given value is equivalent.
+func (d ISO8601Duration) Equal(o attr.Value) bool {
+	other, ok := o.(ISO8601Duration)
+
+	if !ok {
+		return false
+	}
+
+	return d.StringValue.Equal(other.StringValue)
+}
+
+// ValidateAttribute implements attribute value validation. This type requires the value to be a String value that
+// is a valid ISO 8601 duration.
+func (d ISO8601Duration) ValidateAttribute(ctx context.Context, req xattr.ValidateAttributeRequest, resp *xattr.ValidateAttributeResponse) {
+	if d.IsUnknown() || d.IsNull() {
+		return
+	}
+
+	if _, err := parseISO8601Duration(d.ValueString()); err != nil {
+		resp.Diagnostics.Append(diag.WithPath(req.Path, iso8601DurationInvalidStringDiagnostic(d.ValueString(), err)))
+
+		return
+	}
+}
+
+// ValidateParameter implements provider-defined function parameter value validation. This type requires the value to
+// be a String value that is a valid ISO 8601 duration.
+func (d ISO8601Duration) ValidateParameter(ctx context.Context, req function.ValidateParameterRequest, resp *function.ValidateParameterResponse) {
+	if d.IsUnknown() || d.IsNull() {
+		return
+	}
+
+	if _, err := parseISO8601Duration(d.ValueString()); err != nil {
+		resp.Error = function.NewArgumentFuncError(
+			req.Position,
+			"Invalid ISO 8601 Duration String Value: "+
+				"A string value was provided that is not valid ISO 8601 duration string format. "+
+				`An ISO 8601 duration has the format "PnYnMnWnDTnHnMnS", such as "P1DT2H30M" or "PT0.5S".`+"\n\n"+
+				"Given Value: "+d.ValueString()+"\n"+
+				"Error: "+err.Error(),
+		)
+
+		return
+	}
+}
+
+// ValueISO8601Duration creates a new ISO8601DurationComponents instance with the ISO 8601 duration StringValue. A null
+// or unknown value will produce an error diagnostic.
+func (d ISO8601Duration) ValueISO8601Duration() (ISO8601DurationComponents, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	if d.IsNull() {
+		diags.Append(diag.NewErrorDiagnostic("ISO 8601 Duration ValueISO8601Duration Error", "ISO 8601 duration string value is null"))
+		return ISO8601DurationComponents{}, diags
+	}
+
+	if d.IsUnknown() {
+		diags.Append(diag.NewErrorDiagnostic("ISO 8601 Duration ValueISO8601Duration Error", "ISO 8601 duration string value is unknown"))
+		return ISO8601DurationComponents{}, diags
+	}
+
+	components, err := parseISO8601Duration(d.ValueString())
+	if err != nil {
+		diags.Append(diag.NewErrorDiagnostic("ISO 8601 Duration ValueISO8601Duration Error", err.Error()))
+		return ISO8601DurationComponents{}, diags
+	}
+
+	return components, nil
+}
+
+// StringSemanticEquals returns true if the given ISO8601Duration string value is semantically equal to the current
+// ISO8601Duration string value. Both durations are parsed into their components and then compared, where years are
+// converted into 12 months, weeks into 7 days, and hours, minutes and seconds into a single fixed-length duration.
+//
+// Examples:
+//   - `PT60M` is semantically equal to `PT1H`
+//   - `P1Y` is semantically equal to `P12M`
+//   - `P2W` is semantically equal to `P14D`
+//
+// Counterexamples:
+//   - `P1D` is NOT semantically equal to `PT24H`, as a calendar day is not always 24 hours long.
+//   - `P1M` is NOT semantically equal to `P30D`, as a calendar month does not have a fixed number of days.
+func (d ISO8601Duration) StringSemanticEquals(_ context.Context, newValuable basetypes.StringValuable) (bool, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	newValue, ok := newValuable.(ISO8601Duration)
+	if !ok {
+		diags.AddError(
+			"Semantic Equality Check Error",
+			"An unexpected value type was received while performing semantic equality checks. "+
+				"Please report this to the provider developers.\n\n"+
+				"Expected Value Type: "+fmt.Sprintf("%T", d)+"\n"+
+				"Got Value Type: "+fmt.Sprintf("%T", newValuable),
+		)
+
+		return false, diags
+	}
+
+	// ISO 8601 duration strings are already validated at this point, ignoring errors
+	priorComponents, _ := parseISO8601Duration(d.ValueString())
+	newComponents, _ := parseISO8601Duration(newValue.ValueString())
+
+	return priorComponents.normalize() == newComponents.normalize(), diags
+}
+
+// NewISO8601DurationNull creates an ISO8601Duration with a null value. Determine whether the value is null via IsNull method.
+func NewISO8601DurationNull() ISO8601Duration {
+	return ISO8601Duration{
+		StringValue: basetypes.NewStringNull(),
+	}
+}
+
+// NewISO8601DurationUnknown creates an ISO8601Duration with an unknown value. Determine whether the value is unknown via IsUnknown method.
+func NewISO8601DurationUnknown() ISO8601Duration {
+	return ISO8601Duration{
+		StringValue: basetypes.NewStringUnknown(),
+	}
+}
+
+// NewISO8601DurationValue creates an ISO8601Duration with a known value or raises an error diagnostic if any
+// component is negative, as ISO 8601 durations cannot express negative values, or the years and months combined are
+// out of range.
+func NewISO8601DurationValue(value ISO8601DurationComponents) (ISO8601Duration, diag.Diagnostics) {
+	if err := value.validate(); err != nil {
+		// Returning an unknown value will guarantee that, as a last resort,
+		// Terraform will return an error if attempting to store into state.
+		return NewISO8601DurationUnknown(), diag.Diagnostics{iso8601DurationInvalidComponentsDiagnostic(value, err)}
+	}
+
+	return ISO8601Duration{
+		StringValue: basetypes.NewStringValue(value.String()),
+	}, nil
+}
+
+// NewISO8601DurationValueMust creates an ISO8601Duration with a known value or raises a panic if any component is
+// negative or the years and months combined are out of range.
+//
+// This creation function is only recommended to create ISO8601Duration values which
+// either will not potentially affect practitioners, such as testing, or within
+// exhaustively tested provider logic.
+func NewISO8601DurationValueMust(value ISO8601DurationComponents) ISO8601Duration {
+	if err := value.validate(); err != nil {
+		panic(fmt.Sprintf("Invalid ISO 8601 Duration Components Value (Years: %d, Months: %d, Days: %d, Duration: %s): %s", value.Years, value.Months, value.Days, value.Duration, err))
+	}
+
+	return ISO8601Duration{
+		StringValue: basetypes.NewStringValue(value.String()),
+	}
+}
+
+// NewISO8601DurationPointerValue creates an ISO8601Duration with a null value if nil, a known value, or raises an
+// error diagnostic if any component is negative or the years and months combined are out of range.
+func NewISO8601DurationPointerValue(value *ISO8601DurationComponents) (ISO8601Duration, diag.Diagnostics) {
+	if value == nil {
+		return NewISO8601DurationNull(), nil
+	}
+
+	return NewISO8601DurationValue(*value)
+}
+
+// NewISO8601DurationPointerValueMust creates an ISO8601Duration with a null value if nil, a known value, or raises a
+// panic if any component is negative or the years and months combined are out of range.
+//
+// This creation function is only recommended to create ISO8601Duration values which
+// either will not potentially affect practitioners, such as testing, or within
+// exhaustively tested provider logic.
+func NewISO8601DurationPointerValueMust(value *ISO8601DurationComponents) ISO8601Duration {
+	if value == nil {
+		return NewISO8601DurationNull()
+	}
+
+	return NewISO8601DurationValueMust(*value)
+}
+
+// NewISO8601DurationValueFromString creates an ISO8601Duration with a known value or raises an error
+// diagnostic if the string is not ISO 8601 duration format.
+func NewISO8601DurationValueFromString(value string) (ISO8601Duration, diag.Diagnostics) {
+	_, err := parseISO8601Duration(value)
+
+	if err != nil {
+		// Returning an unknown value will guarantee that, as a last resort,
+		// Terraform will return an error if attempting to store into state.
+		return NewISO8601DurationUnknown(), diag.Diagnostics{iso8601DurationInvalidStringDiagnostic(value, err)}
+	}
+
+	return ISO8601Duration{
+		StringValue: basetypes.NewStringValue(value),
+	}, nil
+}
+
+// NewISO8601DurationValueFromStringMust creates an ISO8601Duration with a known value or raises a panic
+// if the string is not ISO 8601 duration format.
+//
+// This creation function is only recommended to create ISO8601Duration values which
+// either will not potentially affect practitioners, such as testing, or within
+// exhaustively tested provider logic.
+func NewISO8601DurationValueFromStringMust(value string) ISO8601Duration {
+	_, err := parseISO8601Duration(value)
+
+	if err != nil {
+		panic(fmt.Sprintf("Invalid ISO 8601 Duration String Value (%s): %s", value, err))
+	}
+
+	return ISO8601Duration{
+		StringValue: basetypes.NewStringValue(value),
+	}
+}
+
+// NewISO8601DurationValueFromPointerString creates an ISO8601Duration with a null value if nil, a known
+// value, or raises an error diagnostic if the string is not ISO 8601 duration format.
+func NewISO8601DurationValueFromPointerString(value *string) (ISO8601Duration, diag.Diagnostics) {
+	if value == nil {
+		return NewISO8601DurationNull(), nil
+	}
+
+	return NewISO8601DurationValueFromString(*value)
+}
+
+// NewISO8601DurationValueFromPointerStringMust creates an ISO8601Duration with a null value if nil, a
+// known value, or raises a panic if the string is not ISO 8601 duration format.
+//
+// This creation function is only recommended to create ISO8601Duration values which
+// either will not potentially affect practitioners, such as testing, or within
+// exhaustively tested provider logic.
+func NewISO8601DurationValueFromPointerStringMust(value *string) ISO8601Duration {
+	if value == nil {
+		return NewISO8601DurationNull()
+	}
+
+	return NewISO8601DurationValueFromStringMust(*value)
+}
+
+// validate returns an error if the components cannot be expressed as an ISO 8601 duration string, as a component is
+// negative, or cannot be normalized, as the years and months combined are out of range.
+func (c ISO8601DurationComponents) validate() error {
+	switch {
+	case c.Years < 0:
+		return fmt.Errorf("years %d must not be negative", c.Years)
+	case c.Months < 0:
+		return fmt.Errorf("months %d must not be negative", c.Months)
+	case c.Days < 0:
+		return fmt.Errorf("days %d must not be negative", c.Days)
+	case c.Duration < 0:
+		return fmt.Errorf("duration %s must not be negative", c.Duration)
+	case c.Years > (math.MaxInt64-c.Months)/12:
+		return fmt.Errorf("years %d and months %d are out of range", c.Years, c.Months)
+	}
+
+	return nil
+}
+
+// normalize returns the components with years converted into months, which is used for semantic equality. The
+// components are expected to be valid, such that the conversion does not overflow.
+func (c ISO8601DurationComponents) normalize() ISO8601DurationComponents {
+	return ISO8601DurationComponents{
+		Months:   c.Years*12 + c.Months,
+		Days:     c.Days,
+		Duration: c.Duration,
+	}
+}
+
+// subtractFrom returns the time t with the duration components subtracted, which is the inverse of AddTo. The
+// fixed-length Duration is subtracted first, followed by the calendar components via time.Time.AddDate.
+func (c ISO8601DurationComponents) subtractFrom(t time.Time) time.Time {
+	return t.Add(-c.Duration).AddDate(-int(c.Years), -int(c.Months), -int(c.Days))
+}
+
+// parseISO8601Duration parses an ISO 8601 duration string of the format `PnYnMnWnDTnHnMnS` into its components.
+func parseISO8601Duration(value string) (ISO8601DurationComponents, error) {
+	var components ISO8601DurationComponents
+
+	rest, ok := strings.CutPrefix(value, "P")
+	if !ok {
+		return components, errors.New(`duration must start with "P"`)
+	}
+
+	datePart, timePart, hasTime := strings.Cut(rest, "T")
+
+	if datePart == "" && timePart == "" {
+		return components, errors.New("duration must contain at least one component")
+	}
+
+	if hasTime && timePart == "" {
+		return components, errors.New(`duration must contain at least one component after "T"`)
+	}
+
+	dateComponents, err := parseISO8601DurationPart(datePart, "YMWD")
+	if err != nil {
+		return components, err
+	}
+
+	timeComponents, err := parseISO8601DurationPart(timePart, "HMS")
+	if err != nil {
+		return components, err
+	}
+
+	for _, c := range dateComponents {
+		if c.fraction != "" {
+			return components, fmt.Errorf("fractional values are only supported for hour, minute and second components, got %q", c.text)
+		}
+
+		n, err := strconv.ParseInt(c.integer, 10, 64)
+		if err != nil {
+			return components, fmt.Errorf("component %q is out of range", c.text)
+		}
+
+		switch c.designator {
+		case 'Y':
+			// Years are converted into months for semantic equality.
+			if n > math.MaxInt64/12 {
+				return components, fmt.Errorf("component %q is out of range", c.text)
+			}
+
+			components.Years = n
+		case 'M':
+			if components.Years > (math.MaxInt64-n)/12 {
+				return components, fmt.Errorf("component %q is out of range", c.text)
+			}
+
+			components.Months = n
+		case 'W':
+			if n > math.MaxInt64/7 || components.Days > math.MaxInt64-n*7 {
+				return components, fmt.Errorf("component %q is out of range", c.text)
+			}
+
+			components.Days += n * 7
+		case 'D':
+			if components.Days > math.MaxInt64-n {
+				return components, fmt.Errorf("component %q is out of range", c.text)
+			}
+
+			components.Days += n
+		}
+	}
+
+	for _, c := range timeComponents {
+		unit := time.Second
+
+		switch c.designator {
+		case 'H':
+			unit = time.Hour
+		case 'M':
+			unit = time.Minute
+		}
+
+		d, err := iso8601DurationComponentValue(c, unit)
+		if err != nil {
+			return components, err
+		}
+
+		if components.Duration > math.MaxInt64-d {
+			return components, fmt.Errorf("component %q is out of range", c.text)
+		}
+
+		components.Duration += d
+	}
+
+	return components, nil
+}
+
+// iso8601DurationComponent is a single number and designator pair of an ISO 8601 duration, such as `1.5H`.
+type iso8601DurationComponent struct {
+	text       string
+	integer    string
+	fraction   string
+	designator byte
+}
+
+// parseISO8601DurationPart splits the date or time part of an ISO 8601 duration into its components, ensuring each
+// designator is one of the given designators and that they appear in the given order.
+func parseISO8601DurationPart(part string, designators string) ([]iso8601DurationComponent, error) {
+	var components []iso8601DurationComponent
+
+	lastIndex := -1
+
+	for part != "" {
+		i := 0
+
+		for i < len(part) && part[i] >= '0' && part[i] <= '9' {
+			i++
+		}
+
+		if i == 0 {
+			return nil, fmt.Errorf("expected a number, got %q", part)
+		}
+
+		c := iso8601DurationComponent{
+			integer: part[:i],
+		}
+
+		if i < len(part) && (part[i] == '.' || part[i] == ',') {
+			j := i + 1
+
+			for j < len(part) && part[j] >= '0' && part[j] <= '9' {
+				j++
+			}
+
+			if j == i+1 {
+				return nil, fmt.Errorf("expected digits after decimal separator in %q", part[:j])
+			}
+
+			c.fraction = part[i+1 : j]
+			i = j
+		}
+
+		if i == len(part) {
+			return nil, fmt.Errorf("missing designator after %q", part)
+		}
+
+		c.designator = part[i]
+		c.text = part[:i+1]
+
+		index := strings.IndexByte(designators, c.designator)
+
+		if index == -1 {
+			return nil, fmt.Errorf("unexpected designator %q in %q, expected one of %q", c.designator, c.text, designators)
+		}
+
+		if index <= lastIndex {
+			return nil, fmt.Errorf("designator %q in %q is out of order, expected order %q", c.designator, c.text, designators)
+		}
+
+		if c.fraction != "" && i+1 != len(part) {
+			return nil, fmt.Errorf("only the smallest component may contain a fractional value, got %q", c.text)
+		}
+
+		lastIndex = index
+		components = append(components, c)
+		part = part[i+1:]
+	}
+
+	return components, nil
+}
+
+// iso8601DurationComponentValue returns the fixed-length duration of an hour, minute or second component, including
+// any fractional value up to nanosecond precision.
+func iso8601DurationComponentValue(c iso8601DurationComponent, unit time.Duration) (time.Duration, error) {
+	n, err := strconv.ParseInt(c.integer, 10, 64)
+	if err != nil || n > int64(math.MaxInt64/unit) {
+		return 0, fmt.Errorf("component %q is out of range", c.text)
+	}
+
+	d := time.Duration(n) * unit
+	scale := unit
+
+	// The hour, minute and second units are all evenly divisible by 10^9, so each fractional digit up to
+	// nanosecond precision can be converted without rounding. Any further digits are truncated.
+	for i := 0; i < len(c.fraction) && i < 9; i++ {
+		scale /= 10
+		digit := time.Duration(c.fraction[i] - '0')
+
+		if d > math.MaxInt64-digit*scale {
+			return 0, fmt.Errorf("component %q is out of range", c.text)
+		}
+
+		d += digit * scale
+	}
+
+	return d, nil
+}
@@ -0,0 +1,36 @@
+// Copyright IBM Corp. 2023, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package timetypes_test
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework-timetypes/timetypes"
+)
+
+type ISO8601DurationResourceModel struct {
+	Duration timetypes.ISO8601Duration `tfsdk:"duration"`
+}
+
+func ExampleISO8601Duration_ValueISO8601Duration() {
+	// For example purposes, typically the data model would be populated automatically by Plugin Framework via Config, Plan or State.
+	// https://developer.hashicorp.com/terraform/plugin/framework/handling-data/accessing-values
+	data := ISO8601DurationResourceModel{
+		Duration: timetypes.NewISO8601DurationValueFromStringMust("P1MT2H30M"),
+	}
+
+	// Check that the ISO 8601 duration data is known and able to be converted to its components
+	if !data.Duration.IsNull() && !data.Duration.IsUnknown() {
+		d, diags := data.Duration.ValueISO8601Duration()
+		if diags.HasError() {
+			return
+		}
+
+		start := time.Date(2023, time.January, 31, 0, 0, 0, 0, time.UTC)
+
+		// Output: 2023-03-03T02:30:00Z
+		fmt.Println(d.AddTo(start).Format(time.RFC3339))
+	}
+}
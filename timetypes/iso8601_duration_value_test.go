@@ -0,0 +1,550 @@
+// Copyright IBM Corp. 2023, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package timetypes_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-framework/attr/xattr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+
+	"github.com/hashicorp/terraform-plugin-framework-timetypes/timetypes"
+)
+
+func TestISO8601Duration_StringSemanticEquals(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		currentDuration timetypes.ISO8601Duration
+		givenDuration   basetypes.StringValuable
+		expectedMatch   bool
+		expectedDiags   diag.Diagnostics
+	}{
+		"not equal - different durations": {
+			currentDuration: timetypes.NewISO8601DurationValueFromStringMust("PT50S"),
+			givenDuration:   timetypes.NewISO8601DurationValueFromStringMust("PT50M"),
+			expectedMatch:   false,
+		},
+		"not equal - day and 24 hours": {
+			currentDuration: timetypes.NewISO8601DurationValueFromStringMust("P1D"),
+			givenDuration:   timetypes.NewISO8601DurationValueFromStringMust("PT24H"),
+			expectedMatch:   false,
+		},
+		"not equal - month and 30 days": {
+			currentDuration: timetypes.NewISO8601DurationValueFromStringMust("P1M"),
+			givenDuration:   timetypes.NewISO8601DurationValueFromStringMust("P30D"),
+			expectedMatch:   false,
+		},
+		"equal - exactly the same string": {
+			currentDuration: timetypes.NewISO8601DurationValueFromStringMust("P1DT2H30M"),
+			givenDuration:   timetypes.NewISO8601DurationValueFromStringMust("P1DT2H30M"),
+			expectedMatch:   true,
+		},
+		"equal - minutes and hours": {
+			currentDuration: timetypes.NewISO8601DurationValueFromStringMust("PT60M"),
+			givenDuration:   timetypes.NewISO8601DurationValueFromStringMust("PT1H"),
+			expectedMatch:   true,
+		},
+		"equal - fractional and whole seconds": {
+			currentDuration: timetypes.NewISO8601DurationValueFromStringMust("PT0.5M"),
+			givenDuration:   timetypes.NewISO8601DurationValueFromStringMust("PT30S"),
+			expectedMatch:   true,
+		},
+		"equal - year and months": {
+			currentDuration: timetypes.NewISO8601DurationValueFromStringMust("P1Y"),
+			givenDuration:   timetypes.NewISO8601DurationValueFromStringMust("P12M"),
+			expectedMatch:   true,
+		},
+		"equal - weeks and days": {
+			currentDuration: timetypes.NewISO8601DurationValueFromStringMust("P2W"),
+			givenDuration:   timetypes.NewISO8601DurationValueFromStringMust("P14D"),
+			expectedMatch:   true,
+		},
+		"error - not an ISO8601Duration value": {
+			currentDuration: timetypes.NewISO8601DurationValueFromStringMust("PT56S"),
+			givenDuration:   basetypes.NewStringValue("PT56S"),
+			expectedMatch:   false,
+			expectedDiags: diag.Diagnostics{
+				diag.NewErrorDiagnostic(
+					"Semantic Equality Check Error",
+					"An unexpected value type was received while performing semantic equality checks. "+
+						"Please report this to the provider developers.\n\n"+
+						"Expected Value Type: timetypes.ISO8601Duration\n"+
+						"Got Value Type: basetypes.StringValue",
+				),
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			match, diags := testCase.currentDuration.StringSemanticEquals(context.Background(), testCase.givenDuration)
+
+			if testCase.expectedMatch != match {
+				t.Errorf("Expected StringSemanticEquals to return: %t, but got: %t", testCase.expectedMatch, match)
+			}
+
+			if diff := cmp.Diff(diags, testCase.expectedDiags); diff != "" {
+				t.Errorf("Unexpected diagnostics (-got, +expected): %s", diff)
+			}
+		})
+	}
+}
+
+func TestISO8601DurationValidateAttribute(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		Duration      timetypes.ISO8601Duration
+		expectedDiags diag.Diagnostics
+	}{
+		"empty-struct": {
+			Duration: timetypes.ISO8601Duration{},
+		},
+		"null": {
+			Duration: timetypes.NewISO8601DurationNull(),
+		},
+		"unknown": {
+			Duration: timetypes.NewISO8601DurationUnknown(),
+		},
+		"valid duration - all components": {
+			Duration: timetypes.NewISO8601DurationValueFromStringMust("P1Y2M3W4DT5H6M7.5S"),
+		},
+		"valid duration - weeks": {
+			Duration: timetypes.NewISO8601DurationValueFromStringMust("P2W"),
+		},
+		"valid duration - fractional seconds with comma": {
+			Duration: timetypes.NewISO8601DurationValueFromStringMust("PT0,5S"),
+		},
+		"invalid duration - missing prefix": {
+			Duration: timetypes.ISO8601Duration{
+				StringValue: basetypes.NewStringValue("1DT2H"),
+			},
+			expectedDiags: diag.Diagnostics{
+				diag.NewAttributeErrorDiagnostic(
+					path.Root("test"),
+					"Invalid ISO 8601 Duration String Value",
+					"A string value was provided that is not valid ISO 8601 duration string format. "+
+						`An ISO 8601 duration has the format "PnYnMnWnDTnHnMnS", such as "P1DT2H30M" or "PT0.5S".`+"\n\n"+
+						"Given Value: 1DT2H\n"+
+						"Error: duration must start with \"P\"",
+				),
+			},
+		},
+		"invalid duration - no components": {
+			Duration: timetypes.ISO8601Duration{
+				StringValue: basetypes.NewStringValue("PT"),
+			},
+			expectedDiags: diag.Diagnostics{
+				diag.NewAttributeErrorDiagnostic(
+					path.Root("test"),
+					"Invalid ISO 8601 Duration String Value",
+					"A string value was provided that is not valid ISO 8601 duration string format. "+
+						`An ISO 8601 duration has the format "PnYnMnWnDTnHnMnS", such as "P1DT2H30M" or "PT0.5S".`+"\n\n"+
+						"Given Value: PT\n"+
+						"Error: duration must contain at least one component",
+				),
+			},
+		},
+		"invalid duration - time designator in date part": {
+			Duration: timetypes.ISO8601Duration{
+				StringValue: basetypes.NewStringValue("P2H"),
+			},
+			expectedDiags: diag.Diagnostics{
+				diag.NewAttributeErrorDiagnostic(
+					path.Root("test"),
+					"Invalid ISO 8601 Duration String Value",
+					"A string value was provided that is not valid ISO 8601 duration string format. "+
+						`An ISO 8601 duration has the format "PnYnMnWnDTnHnMnS", such as "P1DT2H30M" or "PT0.5S".`+"\n\n"+
+						"Given Value: P2H\n"+
+						"Error: unexpected designator 'H' in \"2H\", expected one of \"YMWD\"",
+				),
+			},
+		},
+		"invalid duration - out of order": {
+			Duration: timetypes.ISO8601Duration{
+				StringValue: basetypes.NewStringValue("PT5M1H"),
+			},
+			expectedDiags: diag.Diagnostics{
+				diag.NewAttributeErrorDiagnostic(
+					path.Root("test"),
+					"Invalid ISO 8601 Duration String Value",
+					"A string value was provided that is not valid ISO 8601 duration string format. "+
+						`An ISO 8601 duration has the format "PnYnMnWnDTnHnMnS", such as "P1DT2H30M" or "PT0.5S".`+"\n\n"+
+						"Given Value: PT5M1H\n"+
+						"Error: designator 'H' in \"1H\" is out of order, expected order \"HMS\"",
+				),
+			},
+		},
+		"invalid duration - fractional day": {
+			Duration: timetypes.ISO8601Duration{
+				StringValue: basetypes.NewStringValue("P1.5D"),
+			},
+			expectedDiags: diag.Diagnostics{
+				diag.NewAttributeErrorDiagnostic(
+					path.Root("test"),
+					"Invalid ISO 8601 Duration String Value",
+					"A string value was provided that is not valid ISO 8601 duration string format. "+
+						`An ISO 8601 duration has the format "PnYnMnWnDTnHnMnS", such as "P1DT2H30M" or "PT0.5S".`+"\n\n"+
+						"Given Value: P1.5D\n"+
+						"Error: fractional values are only supported for hour, minute and second components, got \"1.5D\"",
+				),
+			},
+		},
+		"invalid duration - fraction not on smallest component": {
+			Duration: timetypes.ISO8601Duration{
+				StringValue: basetypes.NewStringValue("PT1.5H30M"),
+			},
+			expectedDiags: diag.Diagnostics{
+				diag.NewAttributeErrorDiagnostic(
+					path.Root("test"),
+					"Invalid ISO 8601 Duration String Value",
+					"A string value was provided that is not valid ISO 8601 duration string format. "+
+						`An ISO 8601 duration has the format "PnYnMnWnDTnHnMnS", such as "P1DT2H30M" or "PT0.5S".`+"\n\n"+
+						"Given Value: PT1.5H30M\n"+
+						"Error: only the smallest component may contain a fractional value, got \"1.5H\"",
+				),
+			},
+		},
+		"invalid duration - years out of range": {
+			Duration: timetypes.ISO8601Duration{
+				StringValue: basetypes.NewStringValue("P768614336404564651Y"),
+			},
+			expectedDiags: diag.Diagnostics{
+				diag.NewAttributeErrorDiagnostic(
+					path.Root("test"),
+					"Invalid ISO 8601 Duration String Value",
+					"A string value was provided that is not valid ISO 8601 duration string format. "+
+						`An ISO 8601 duration has the format "PnYnMnWnDTnHnMnS", such as "P1DT2H30M" or "PT0.5S".`+"\n\n"+
+						"Given Value: P768614336404564651Y\n"+
+						"Error: component \"768614336404564651Y\" is out of range",
+				),
+			},
+		},
+		"invalid duration - years and months out of range": {
+			Duration: timetypes.ISO8601Duration{
+				StringValue: basetypes.NewStringValue("P768614336404564650Y8M"),
+			},
+			expectedDiags: diag.Diagnostics{
+				diag.NewAttributeErrorDiagnostic(
+					path.Root("test"),
+					"Invalid ISO 8601 Duration String Value",
+					"A string value was provided that is not valid ISO 8601 duration string format. "+
+						`An ISO 8601 duration has the format "PnYnMnWnDTnHnMnS", such as "P1DT2H30M" or "PT0.5S".`+"\n\n"+
+						"Given Value: P768614336404564650Y8M\n"+
+						"Error: component \"8M\" is out of range",
+				),
+			},
+		},
+	}
+	for name, testCase := range testCases {
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			resp := xattr.ValidateAttributeResponse{}
+
+			testCase.Duration.ValidateAttribute(
+				context.Background(),
+				xattr.ValidateAttributeRequest{
+					Path: path.Root("test"),
+				},
+				&resp,
+			)
+
+			if diff := cmp.Diff(resp.Diagnostics, testCase.expectedDiags); diff != "" {
+				t.Errorf("Unexpected diagnostics (-got, +expected): %s", diff)
+			}
+		})
+	}
+}
+
+func TestISO8601DurationValidateParameter(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		Duration        timetypes.ISO8601Duration
+		expectedFuncErr *function.FuncError
+	}{
+		"empty-struct": {
+			Duration: timetypes.ISO8601Duration{},
+		},
+		"null": {
+			Duration: timetypes.NewISO8601DurationNull(),
+		},
+		"unknown": {
+			Duration: timetypes.NewISO8601DurationUnknown(),
+		},
+		"valid duration": {
+			Duration: timetypes.NewISO8601DurationValueFromStringMust("P1DT2H30M"),
+		},
+		"invalid duration": {
+			Duration: timetypes.ISO8601Duration{
+				StringValue: basetypes.NewStringValue("P1DT"),
+			},
+			expectedFuncErr: function.NewArgumentFuncError(
+				0,
+				"Invalid ISO 8601 Duration String Value: "+
+					"A string value was provided that is not valid ISO 8601 duration string format. "+
+					`An ISO 8601 duration has the format "PnYnMnWnDTnHnMnS", such as "P1DT2H30M" or "PT0.5S".`+"\n\n"+
+					"Given Value: P1DT\n"+
+					"Error: duration must contain at least one component after \"T\"",
+			),
+		},
+	}
+	for name, testCase := range testCases {
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			resp := function.ValidateParameterResponse{}
+
+			testCase.Duration.ValidateParameter(
+				context.Background(),
+				function.ValidateParameterRequest{
+					Position: int64(0),
+				},
+				&resp,
+			)
+
+			if diff := cmp.Diff(resp.Error, testCase.expectedFuncErr); diff != "" {
+				t.Errorf("Unexpected diagnostics (-got, +expected): %s", diff)
+			}
+		})
+	}
+}
+
+func TestISO8601Duration_ValueISO8601Duration(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		Duration           timetypes.ISO8601Duration
+		expectedComponents timetypes.ISO8601DurationComponents
+		expectedDiags      diag.Diagnostics
+	}{
+		"ISO 8601 duration string value is null ": {
+			Duration: timetypes.NewISO8601DurationNull(),
+			expectedDiags: diag.Diagnostics{
+				diag.NewErrorDiagnostic(
+					"ISO 8601 Duration ValueISO8601Duration Error",
+					"ISO 8601 duration string value is null",
+				),
+			},
+		},
+		"ISO 8601 duration string value is unknown ": {
+			Duration: timetypes.NewISO8601DurationUnknown(),
+			expectedDiags: diag.Diagnostics{
+				diag.NewErrorDiagnostic(
+					"ISO 8601 Duration ValueISO8601Duration Error",
+					"ISO 8601 duration string value is unknown",
+				),
+			},
+		},
+		"valid duration - calendar and fixed-length components": {
+			Duration: timetypes.NewISO8601DurationValueFromStringMust("P1Y2M1W3DT2H30M"),
+			expectedComponents: timetypes.ISO8601DurationComponents{
+				Years:    1,
+				Months:   2,
+				Days:     10,
+				Duration: 2*time.Hour + 30*time.Minute,
+			},
+		},
+		"valid duration - fractional hours": {
+			Duration: timetypes.NewISO8601DurationValueFromStringMust("PT1.25H"),
+			expectedComponents: timetypes.ISO8601DurationComponents{
+				Duration: time.Hour + 15*time.Minute,
+			},
+		},
+		"valid duration - fractional seconds": {
+			Duration: timetypes.NewISO8601DurationValueFromStringMust("PT0.000000001S"),
+			expectedComponents: timetypes.ISO8601DurationComponents{
+				Duration: time.Nanosecond,
+			},
+		},
+	}
+	for name, testCase := range testCases {
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			components, diags := testCase.Duration.ValueISO8601Duration()
+
+			if diff := cmp.Diff(components, testCase.expectedComponents); diff != "" {
+				t.Errorf("Unexpected difference in components (-got, +expected): %s", diff)
+			}
+
+			if diff := cmp.Diff(diags, testCase.expectedDiags); diff != "" {
+				t.Errorf("Unexpected diagnostics (-got, +expected): %s", diff)
+			}
+		})
+	}
+}
+
+func TestISO8601DurationComponents_String(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		components timetypes.ISO8601DurationComponents
+		expected   string
+	}{
+		"zero": {
+			components: timetypes.ISO8601DurationComponents{},
+			expected:   "PT0S",
+		},
+		"calendar components": {
+			components: timetypes.ISO8601DurationComponents{
+				Years:  1,
+				Months: 2,
+				Days:   3,
+			},
+			expected: "P1Y2M3D",
+		},
+		"fixed-length components": {
+			components: timetypes.ISO8601DurationComponents{
+				Duration: 26*time.Hour + 3*time.Minute + 500*time.Millisecond,
+			},
+			expected: "PT26H3M0.5S",
+		},
+		"all components": {
+			components: timetypes.ISO8601DurationComponents{
+				Years:    1,
+				Days:     1,
+				Duration: 90 * time.Second,
+			},
+			expected: "P1Y1DT1M30S",
+		},
+	}
+	for name, testCase := range testCases {
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got := testCase.components.String()
+
+			if got != testCase.expected {
+				t.Errorf("Expected %q, got %q", testCase.expected, got)
+			}
+
+			// Ensure the string format round trips through the value constructor
+			if _, diags := timetypes.NewISO8601DurationValueFromString(got); diags.HasError() {
+				t.Errorf("Unexpected diagnostics: %v", diags)
+			}
+		})
+	}
+}
+
+func TestNewISO8601DurationValue(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		components    timetypes.ISO8601DurationComponents
+		expected      timetypes.ISO8601Duration
+		expectedDiags diag.Diagnostics
+	}{
+		"valid": {
+			components: timetypes.ISO8601DurationComponents{
+				Years:    1,
+				Duration: 90 * time.Minute,
+			},
+			expected: timetypes.NewISO8601DurationValueMust(timetypes.ISO8601DurationComponents{
+				Years:    1,
+				Duration: 90 * time.Minute,
+			}),
+		},
+		"maximum years and months": {
+			components: timetypes.ISO8601DurationComponents{
+				Years:  768614336404564650,
+				Months: 7,
+			},
+			expected: timetypes.ISO8601Duration{
+				StringValue: basetypes.NewStringValue("P768614336404564650Y7M"),
+			},
+		},
+		"negative hours and minutes": {
+			components: timetypes.ISO8601DurationComponents{
+				Duration: -90 * time.Minute,
+			},
+			expected: timetypes.NewISO8601DurationUnknown(),
+			expectedDiags: diag.Diagnostics{
+				diag.NewErrorDiagnostic(
+					"Invalid ISO 8601 Duration Components Value",
+					"ISO 8601 duration components were provided that cannot be expressed as an ISO 8601 duration string. "+
+						"ISO 8601 durations cannot be negative.\n\n"+
+						"Given Value: Years: 0, Months: 0, Days: 0, Duration: -1h30m0s\n"+
+						"Error: duration -1h30m0s must not be negative",
+				),
+			},
+		},
+		"negative days": {
+			components: timetypes.ISO8601DurationComponents{
+				Days: -1,
+			},
+			expected: timetypes.NewISO8601DurationUnknown(),
+			expectedDiags: diag.Diagnostics{
+				diag.NewErrorDiagnostic(
+					"Invalid ISO 8601 Duration Components Value",
+					"ISO 8601 duration components were provided that cannot be expressed as an ISO 8601 duration string. "+
+						"ISO 8601 durations cannot be negative.\n\n"+
+						"Given Value: Years: 0, Months: 0, Days: -1, Duration: 0s\n"+
+						"Error: days -1 must not be negative",
+				),
+			},
+		},
+		"years and months out of range": {
+			components: timetypes.ISO8601DurationComponents{
+				Years:  768614336404564650,
+				Months: 8,
+			},
+			expected: timetypes.NewISO8601DurationUnknown(),
+			expectedDiags: diag.Diagnostics{
+				diag.NewErrorDiagnostic(
+					"Invalid ISO 8601 Duration Components Value",
+					"ISO 8601 duration components were provided that cannot be expressed as an ISO 8601 duration string. "+
+						"ISO 8601 durations cannot be negative.\n\n"+
+						"Given Value: Years: 768614336404564650, Months: 8, Days: 0, Duration: 0s\n"+
+						"Error: years 768614336404564650 and months 8 are out of range",
+				),
+			},
+		},
+	}
+	for name, testCase := range testCases {
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got, diags := timetypes.NewISO8601DurationValue(testCase.components)
+
+			if diff := cmp.Diff(got, testCase.expected); diff != "" {
+				t.Errorf("Unexpected result (-got, +expected): %s", diff)
+			}
+
+			if diff := cmp.Diff(diags, testCase.expectedDiags); diff != "" {
+				t.Errorf("Unexpected diagnostics (-got, +expected): %s", diff)
+			}
+		})
+	}
+}
+
+func TestNewISO8601DurationPointerValue(t *testing.T) {
+	t.Parallel()
+
+	got, diags := timetypes.NewISO8601DurationPointerValue(nil)
+
+	if diff := cmp.Diff(got, timetypes.NewISO8601DurationNull()); diff != "" {
+		t.Errorf("Unexpected result (-got, +expected): %s", diff)
+	}
+
+	if diags.HasError() {
+		t.Errorf("Unexpected diagnostics: %v", diags)
+	}
+}
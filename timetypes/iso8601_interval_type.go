@@ -0,0 +1,77 @@
+// Copyright IBM Corp. 2023, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package timetypes
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+var (
+	_ basetypes.StringTypable = (*ISO8601IntervalType)(nil)
+)
+
+// ISO8601IntervalType is an attribute type that represents a valid ISO 8601 time interval string, such as
+// `2026-10-16T00:00:00Z/P1D`. Semantic equality logic is defined for ISO8601IntervalType such that intervals with the
+// same start and end are considered equal, regardless of whether they are expressed with a duration.
+type ISO8601IntervalType struct {
+	basetypes.StringType
+}
+
+// String returns a human-readable string of the type name.
+func (t ISO8601IntervalType) String() string {
+	return "timetypes.ISO8601IntervalType"
+}
+
+// ValueType returns the Value type.
+func (t ISO8601IntervalType) ValueType(ctx context.Context) attr.Value {
+	return ISO8601Interval{}
+}
+
+// Equal returns true if the given type is equivalent.
+func (t ISO8601IntervalType) Equal(o attr.Type) bool {
+	other, ok := o.(ISO8601IntervalType)
+
+	if !ok {
+		return false
+	}
+
+	return t.StringType.Equal(other.StringType)
+}
+
+// ValueFromString returns a StringValuable type given a StringValue.
+func (t ISO8601IntervalType) ValueFromString(ctx context.Context, in basetypes.StringValue) (basetypes.StringValuable, diag.Diagnostics) {
+	return ISO8601Interval{
+		StringValue: in,
+	}, nil
+}
+
+// ValueFromTerraform returns a Value given a tftypes.Value.  This is meant to convert the tftypes.Value into a more convenient Go type
+// for the provider to consume the data with.
+func (t ISO8601IntervalType) ValueFromTerraform(ctx context.Context, in tftypes.Value) (attr.Value, error) {
+	attrValue, err := t.StringType.ValueFromTerraform(ctx, in)
+
+	if err != nil {
+		return nil, err
+	}
+
+	stringValue, ok := attrValue.(basetypes.StringValue)
+
+	if !ok {
+		return nil, fmt.Errorf("unexpected value type of %T", attrValue)
+	}
+
+	stringValuable, diags := t.ValueFromString(ctx, stringValue)
+
+	if diags.HasError() {
+		return nil, fmt.Errorf("unexpected error converting StringValue to StringValuable: %v", diags)
+	}
+
+	return stringValuable, nil
+}
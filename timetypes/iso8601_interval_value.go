@@ -0,0 +1,312 @@
+// Copyright IBM Corp. 2023, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package timetypes
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/attr/xattr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+)
+
+var (
+	_ basetypes.StringValuableWithSemanticEquals = (*ISO8601Interval)(nil)
+	_ xattr.ValidateableAttribute                = (*ISO8601Interval)(nil)
+	_ function.ValidateableParameter             = (*ISO8601Interval)(nil)
+)
+
+// ISO8601Interval represents a valid ISO 8601 time interval string, such as `2026-10-16T00:00:00Z/P1D`.
+//
+// The supported formats are `start/end`, `start/duration` and `duration/end`, where start and end are RFC 3339
+// date-times and duration is an ISO 8601 duration, as supported by ISO8601Duration. The end of the interval must not
+// be before the start. The abbreviated end date-time and `--` separator forms of ISO 8601 are not supported.
+type ISO8601Interval struct {
+	basetypes.StringValue
+}
+
+// Type returns an ISO8601IntervalType.
+func (v ISO8601Interval) Type(_ context.Context) attr.Type {
+	return ISO8601IntervalType{}
+}
+
+// Equal returns true if the given value is equivalent.
+func (v ISO8601Interval) Equal(o attr.Value) bool {
+	other, ok := o.(ISO8601Interval)
+
+	if !ok {
+		return false
+	}
+
+	return v.StringValue.Equal(other.StringValue)
+}
+
+// StringSemanticEquals returns true if the given ISO 8601 interval string value is semantically equal to the current
+// ISO 8601 interval string value. Both intervals are resolved into start and end time.Time instances, which are then
+// compared as RFC 3339-formatted strings, consistent with RFC3339 semantic equality. A duration is resolved using the
+// UTC offset of the date-time it is given with.
+//
+// Examples:
+//   - `2026-10-16T00:00:00Z/P1D` is semantically equal to `2026-10-16T00:00:00Z/2026-10-17T00:00:00Z`
+//   - `P1D/2026-10-17T00:00:00Z` is semantically equal to `2026-10-16T00:00:00+00:00/PT24H`
+//
+// Counterexamples:
+//   - `2026-10-16T02:00:00+02:00/P1D` expresses the same interval as `2026-10-16T00:00:00Z/P1D` but is NOT
+//     considered to be semantically equal.
+func (v ISO8601Interval) StringSemanticEquals(_ context.Context, newValuable basetypes.StringValuable) (bool, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	newValue, ok := newValuable.(ISO8601Interval)
+	if !ok {
+		diags.AddError(
+			"Semantic Equality Check Error",
+			"An unexpected value type was received while performing semantic equality checks. "+
+				"Please report this to the provider developers.\n\n"+
+				"Expected Value Type: "+fmt.Sprintf("%T", v)+"\n"+
+				"Got Value Type: "+fmt.Sprintf("%T", newValuable),
+		)
+
+		return false, diags
+	}
+
+	// ISO 8601 interval strings are already validated at this point, ignoring errors
+	currentStart, currentEnd, _ := parseISO8601Interval(v.ValueString())
+	newStart, newEnd, _ := parseISO8601Interval(newValue.ValueString())
+
+	return currentStart.Format(time.RFC3339Nano) == newStart.Format(time.RFC3339Nano) &&
+		currentEnd.Format(time.RFC3339Nano) == newEnd.Format(time.RFC3339Nano), diags
+}
+
+// ValidateAttribute implements attribute value validation. This type requires the value to be a String value that
+// is a valid ISO 8601 time interval.
+func (v ISO8601Interval) ValidateAttribute(ctx context.Context, req xattr.ValidateAttributeRequest, resp *xattr.ValidateAttributeResponse) {
+	if v.IsUnknown() || v.IsNull() {
+		return
+	}
+
+	if _, _, err := parseISO8601Interval(v.ValueString()); err != nil {
+		resp.Diagnostics.Append(diag.WithPath(req.Path, iso8601IntervalInvalidStringDiagnostic(v.ValueString(), err)))
+
+		return
+	}
+}
+
+// ValidateParameter implements provider-defined function parameter value validation. This type requires the value to
+// be a String value that is a valid ISO 8601 time interval.
+func (v ISO8601Interval) ValidateParameter(ctx context.Context, req function.ValidateParameterRequest, resp *function.ValidateParameterResponse) {
+	if v.IsUnknown() || v.IsNull() {
+		return
+	}
+
+	if _, _, err := parseISO8601Interval(v.ValueString()); err != nil {
+		resp.Error = function.NewArgumentFuncError(
+			req.Position,
+			"Invalid ISO 8601 Interval String Value: "+
+				"A string value was provided that is not valid ISO 8601 interval string format. "+
+				`An ISO 8601 interval has the format "start/end", "start/duration" or "duration/end", such as "2026-10-16T00:00:00Z/P1D".`+"\n\n"+
+				"Given Value: "+v.ValueString()+"\n"+
+				"Error: "+err.Error(),
+		)
+
+		return
+	}
+}
+
+// ValueStartTime creates a new time.Time instance with the resolved start of the ISO8601Interval StringValue. A null
+// or unknown value will produce an error diagnostic.
+func (v ISO8601Interval) ValueStartTime() (time.Time, diag.Diagnostics) {
+	start, _, diags := v.valueInterval("ValueStartTime")
+
+	return start, diags
+}
+
+// ValueEndTime creates a new time.Time instance with the resolved end of the ISO8601Interval StringValue. A null or
+// unknown value will produce an error diagnostic.
+func (v ISO8601Interval) ValueEndTime() (time.Time, diag.Diagnostics) {
+	_, end, diags := v.valueInterval("ValueEndTime")
+
+	return end, diags
+}
+
+// valueInterval returns the resolved start and end of the ISO8601Interval, with error diagnostics summarized by the
+// given accessor method name.
+func (v ISO8601Interval) valueInterval(accessor string) (time.Time, time.Time, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	summary := "ISO8601Interval " + accessor + " Error"
+
+	if v.IsNull() {
+		diags.Append(diag.NewErrorDiagnostic(summary, "ISO 8601 interval string value is null"))
+		return time.Time{}, time.Time{}, diags
+	}
+
+	if v.IsUnknown() {
+		diags.Append(diag.NewErrorDiagnostic(summary, "ISO 8601 interval string value is unknown"))
+		return time.Time{}, time.Time{}, diags
+	}
+
+	start, end, err := parseISO8601Interval(v.ValueString())
+	if err != nil {
+		diags.Append(diag.NewErrorDiagnostic(summary, err.Error()))
+		return time.Time{}, time.Time{}, diags
+	}
+
+	return start, end, nil
+}
+
+// NewISO8601IntervalNull creates an ISO8601Interval with a null value. Determine whether the value is null via IsNull method.
+func NewISO8601IntervalNull() ISO8601Interval {
+	return ISO8601Interval{
+		StringValue: basetypes.NewStringNull(),
+	}
+}
+
+// NewISO8601IntervalUnknown creates an ISO8601Interval with an unknown value. Determine whether the value is unknown via IsUnknown method.
+func NewISO8601IntervalUnknown() ISO8601Interval {
+	return ISO8601Interval{
+		StringValue: basetypes.NewStringUnknown(),
+	}
+}
+
+// NewISO8601IntervalTimeValue creates an ISO8601Interval with a known value of the `start/end` format, such as
+// `2026-10-16T00:00:00Z/2026-10-17T00:00:00Z`, or raises an error diagnostic if the end is before the start.
+func NewISO8601IntervalTimeValue(start, end time.Time) (ISO8601Interval, diag.Diagnostics) {
+	value := start.Format(time.RFC3339Nano) + "/" + end.Format(time.RFC3339Nano)
+
+	if end.Before(start) {
+		// Returning an unknown value will guarantee that, as a last resort,
+		// Terraform will return an error if attempting to store into state.
+		return NewISO8601IntervalUnknown(), diag.Diagnostics{iso8601IntervalInvalidStringDiagnostic(value, iso8601IntervalOrderError(start, end))}
+	}
+
+	return ISO8601Interval{
+		StringValue: basetypes.NewStringValue(value),
+	}, nil
+}
+
+// NewISO8601IntervalValue creates an ISO8601Interval with a known value or
+// raises an error diagnostic if the string is not ISO 8601 interval format.
+func NewISO8601IntervalValue(value string) (ISO8601Interval, diag.Diagnostics) {
+	_, _, err := parseISO8601Interval(value)
+
+	if err != nil {
+		// Returning an unknown value will guarantee that, as a last resort,
+		// Terraform will return an error if attempting to store into state.
+		return NewISO8601IntervalUnknown(), diag.Diagnostics{iso8601IntervalInvalidStringDiagnostic(value, err)}
+	}
+
+	return ISO8601Interval{
+		StringValue: basetypes.NewStringValue(value),
+	}, nil
+}
+
+// NewISO8601IntervalValueMust creates an ISO8601Interval with a known value or
+// raises a panic if the string is not ISO 8601 interval format.
+//
+// This creation function is only recommended to create ISO8601Interval values which
+// either will not potentially affect practitioners, such as testing, or within
+// exhaustively tested provider logic.
+func NewISO8601IntervalValueMust(value string) ISO8601Interval {
+	_, _, err := parseISO8601Interval(value)
+
+	if err != nil {
+		panic(fmt.Sprintf("Invalid ISO 8601 Interval String Value (%s): %s", value, err))
+	}
+
+	return ISO8601Interval{
+		StringValue: basetypes.NewStringValue(value),
+	}
+}
+
+// NewISO8601IntervalPointerValue creates an ISO8601Interval with a null value
+// if nil, a known value, or raises an error diagnostic if the string is not
+// ISO 8601 interval format.
+func NewISO8601IntervalPointerValue(value *string) (ISO8601Interval, diag.Diagnostics) {
+	if value == nil {
+		return NewISO8601IntervalNull(), nil
+	}
+
+	return NewISO8601IntervalValue(*value)
+}
+
+// NewISO8601IntervalPointerValueMust creates an ISO8601Interval with a null
+// value if nil, a known value, or raises a panic if the string is not ISO 8601
+// interval format.
+//
+// This creation function is only recommended to create ISO8601Interval values which
+// either will not potentially affect practitioners, such as testing, or within
+// exhaustively tested provider logic.
+func NewISO8601IntervalPointerValueMust(value *string) ISO8601Interval {
+	if value == nil {
+		return NewISO8601IntervalNull()
+	}
+
+	return NewISO8601IntervalValueMust(*value)
+}
+
+// parseISO8601Interval parses an ISO 8601 interval string of the format `start/end`, `start/duration` or
+// `duration/end` into its resolved start and end.
+func parseISO8601Interval(value string) (time.Time, time.Time, error) {
+	startValue, endValue, ok := strings.Cut(value, "/")
+	if !ok || strings.Contains(endValue, "/") {
+		return time.Time{}, time.Time{}, fmt.Errorf(`expected a single "/" separating the start and end of the interval, got %q`, value)
+	}
+
+	startIsDuration := strings.HasPrefix(startValue, "P")
+	endIsDuration := strings.HasPrefix(endValue, "P")
+
+	if startIsDuration && endIsDuration {
+		return time.Time{}, time.Time{}, errors.New("expected at least one of the start and end of the interval to be a date-time, got two durations")
+	}
+
+	var start, end time.Time
+
+	if !startIsDuration {
+		var err error
+
+		if start, err = time.Parse(time.RFC3339, startValue); err != nil {
+			return time.Time{}, time.Time{}, fmt.Errorf("invalid interval start: %w", err)
+		}
+	}
+
+	if !endIsDuration {
+		var err error
+
+		if end, err = time.Parse(time.RFC3339, endValue); err != nil {
+			return time.Time{}, time.Time{}, fmt.Errorf("invalid interval end: %w", err)
+		}
+	}
+
+	switch {
+	case startIsDuration:
+		duration, err := parseISO8601Duration(startValue)
+		if err != nil {
+			return time.Time{}, time.Time{}, fmt.Errorf("invalid interval duration: %w", err)
+		}
+
+		start = duration.subtractFrom(end)
+	case endIsDuration:
+		duration, err := parseISO8601Duration(endValue)
+		if err != nil {
+			return time.Time{}, time.Time{}, fmt.Errorf("invalid interval duration: %w", err)
+		}
+
+		end = duration.AddTo(start)
+	case end.Before(start):
+		return time.Time{}, time.Time{}, iso8601IntervalOrderError(start, end)
+	}
+
+	return start, end, nil
+}
+
+// iso8601IntervalOrderError returns the error for an interval with an end before its start.
+func iso8601IntervalOrderError(start, end time.Time) error {
+	return fmt.Errorf("interval end %s is before start %s", end.Format(time.RFC3339Nano), start.Format(time.RFC3339Nano))
+}
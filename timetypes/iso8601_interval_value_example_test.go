@@ -0,0 +1,34 @@
+// Copyright IBM Corp. 2023, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package timetypes_test
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework-timetypes/timetypes"
+)
+
+type ReservationResourceModel struct {
+	Window timetypes.ISO8601Interval `tfsdk:"window"`
+}
+
+func ExampleISO8601Interval_ValueEndTime() {
+	// For example purposes, typically the data model would be populated automatically by Plugin Framework via Config, Plan or State.
+	// https://developer.hashicorp.com/terraform/plugin/framework/handling-data/accessing-values
+	data := ReservationResourceModel{
+		Window: timetypes.NewISO8601IntervalValueMust("2026-10-16T09:00:00Z/PT1H30M"),
+	}
+
+	// Check that the ISO 8601 interval data is known and able to be resolved to its end time.Time
+	if !data.Window.IsNull() && !data.Window.IsUnknown() {
+		end, diags := data.Window.ValueEndTime()
+		if diags.HasError() {
+			return
+		}
+
+		// Output: 2026-10-16T10:30:00Z
+		fmt.Println(end.Format(time.RFC3339))
+	}
+}
@@ -0,0 +1,396 @@
+// Copyright IBM Corp. 2023, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package timetypes_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-framework/attr/xattr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+
+	"github.com/hashicorp/terraform-plugin-framework-timetypes/timetypes"
+)
+
+func TestISO8601Interval_StringSemanticEquals(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		currentISO8601Interval timetypes.ISO8601Interval
+		givenISO8601Interval   basetypes.StringValuable
+		expectedMatch          bool
+		expectedDiags          diag.Diagnostics
+	}{
+		"not equal - different start": {
+			currentISO8601Interval: timetypes.NewISO8601IntervalValueMust("2026-10-16T00:00:00Z/2026-10-18T00:00:00Z"),
+			givenISO8601Interval:   timetypes.NewISO8601IntervalValueMust("2026-10-17T00:00:00Z/2026-10-18T00:00:00Z"),
+			expectedMatch:          false,
+		},
+		"not equal - different duration": {
+			currentISO8601Interval: timetypes.NewISO8601IntervalValueMust("2026-10-16T00:00:00Z/P1D"),
+			givenISO8601Interval:   timetypes.NewISO8601IntervalValueMust("2026-10-16T00:00:00Z/P1W"),
+			expectedMatch:          false,
+		},
+		"not equal - same interval with different offsets": {
+			currentISO8601Interval: timetypes.NewISO8601IntervalValueMust("2026-10-16T02:00:00+02:00/P1D"),
+			givenISO8601Interval:   timetypes.NewISO8601IntervalValueMust("2026-10-16T00:00:00Z/P1D"),
+			expectedMatch:          false,
+		},
+		"semantically equal - byte for byte match": {
+			currentISO8601Interval: timetypes.NewISO8601IntervalValueMust("2026-10-16T00:00:00Z/P1D"),
+			givenISO8601Interval:   timetypes.NewISO8601IntervalValueMust("2026-10-16T00:00:00Z/P1D"),
+			expectedMatch:          true,
+		},
+		"semantically equal - start/duration and start/end": {
+			currentISO8601Interval: timetypes.NewISO8601IntervalValueMust("2026-10-16T00:00:00Z/P1D"),
+			givenISO8601Interval:   timetypes.NewISO8601IntervalValueMust("2026-10-16T00:00:00Z/2026-10-17T00:00:00Z"),
+			expectedMatch:          true,
+		},
+		"semantically equal - duration/end and start/duration": {
+			currentISO8601Interval: timetypes.NewISO8601IntervalValueMust("P1D/2026-10-17T00:00:00Z"),
+			givenISO8601Interval:   timetypes.NewISO8601IntervalValueMust("2026-10-16T00:00:00+00:00/PT24H"),
+			expectedMatch:          true,
+		},
+		"semantically equal - calendar month": {
+			currentISO8601Interval: timetypes.NewISO8601IntervalValueMust("P1M/2026-03-01T00:00:00Z"),
+			givenISO8601Interval:   timetypes.NewISO8601IntervalValueMust("2026-02-01T00:00:00Z/P28D"),
+			expectedMatch:          true,
+		},
+		"error - not given ISO8601Interval value": {
+			currentISO8601Interval: timetypes.NewISO8601IntervalValueMust("2026-10-16T00:00:00Z/P1D"),
+			givenISO8601Interval:   basetypes.NewStringValue("2026-10-16T00:00:00Z/P1D"),
+			expectedMatch:          false,
+			expectedDiags: diag.Diagnostics{
+				diag.NewErrorDiagnostic(
+					"Semantic Equality Check Error",
+					"An unexpected value type was received while performing semantic equality checks. "+
+						"Please report this to the provider developers.\n\n"+
+						"Expected Value Type: timetypes.ISO8601Interval\n"+
+						"Got Value Type: basetypes.StringValue",
+				),
+			},
+		},
+	}
+	for name, testCase := range testCases {
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			match, diags := testCase.currentISO8601Interval.StringSemanticEquals(context.Background(), testCase.givenISO8601Interval)
+
+			if testCase.expectedMatch != match {
+				t.Errorf("Expected StringSemanticEquals to return: %t, but got: %t", testCase.expectedMatch, match)
+			}
+
+			if diff := cmp.Diff(diags, testCase.expectedDiags); diff != "" {
+				t.Errorf("Unexpected diagnostics (-got, +expected): %s", diff)
+			}
+		})
+	}
+}
+
+func TestISO8601IntervalValidateAttribute(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		iso8601Interval timetypes.ISO8601Interval
+		expectedDiags   diag.Diagnostics
+	}{
+		"empty-struct": {
+			iso8601Interval: timetypes.ISO8601Interval{},
+		},
+		"null": {
+			iso8601Interval: timetypes.NewISO8601IntervalNull(),
+		},
+		"unknown": {
+			iso8601Interval: timetypes.NewISO8601IntervalUnknown(),
+		},
+		"valid ISO 8601 interval - start/end": {
+			iso8601Interval: timetypes.NewISO8601IntervalValueMust("2026-10-16T00:00:00Z/2026-10-16T02:00:00.5+02:00"),
+		},
+		"valid ISO 8601 interval - start/duration": {
+			iso8601Interval: timetypes.NewISO8601IntervalValueMust("2026-10-16T00:00:00Z/P1Y2M3DT4H"),
+		},
+		"valid ISO 8601 interval - duration/end": {
+			iso8601Interval: timetypes.NewISO8601IntervalValueMust("PT30M/2026-10-16T00:00:00-07:00"),
+		},
+		"valid ISO 8601 interval - empty": {
+			iso8601Interval: timetypes.NewISO8601IntervalValueMust("2026-10-16T00:00:00Z/2026-10-16T00:00:00Z"),
+		},
+		"invalid ISO 8601 interval - no separator": {
+			iso8601Interval: timetypes.ISO8601Interval{
+				StringValue: basetypes.NewStringValue("2026-10-16T00:00:00Z"),
+			},
+			expectedDiags: diag.Diagnostics{
+				diag.NewAttributeErrorDiagnostic(
+					path.Root("test"),
+					"Invalid ISO 8601 Interval String Value",
+					"A string value was provided that is not valid ISO 8601 interval string format. "+
+						`An ISO 8601 interval has the format "start/end", "start/duration" or "duration/end", such as "2026-10-16T00:00:00Z/P1D".`+"\n\n"+
+						"Given Value: 2026-10-16T00:00:00Z\n"+
+						"Error: expected a single \"/\" separating the start and end of the interval, got \"2026-10-16T00:00:00Z\"",
+				),
+			},
+		},
+		"invalid ISO 8601 interval - two durations": {
+			iso8601Interval: timetypes.ISO8601Interval{
+				StringValue: basetypes.NewStringValue("P1D/PT1H"),
+			},
+			expectedDiags: diag.Diagnostics{
+				diag.NewAttributeErrorDiagnostic(
+					path.Root("test"),
+					"Invalid ISO 8601 Interval String Value",
+					"A string value was provided that is not valid ISO 8601 interval string format. "+
+						`An ISO 8601 interval has the format "start/end", "start/duration" or "duration/end", such as "2026-10-16T00:00:00Z/P1D".`+"\n\n"+
+						"Given Value: P1D/PT1H\n"+
+						"Error: expected at least one of the start and end of the interval to be a date-time, got two durations",
+				),
+			},
+		},
+		"invalid ISO 8601 interval - invalid start": {
+			iso8601Interval: timetypes.ISO8601Interval{
+				StringValue: basetypes.NewStringValue("2026-10-16/P1D"),
+			},
+			expectedDiags: diag.Diagnostics{
+				diag.NewAttributeErrorDiagnostic(
+					path.Root("test"),
+					"Invalid ISO 8601 Interval String Value",
+					"A string value was provided that is not valid ISO 8601 interval string format. "+
+						`An ISO 8601 interval has the format "start/end", "start/duration" or "duration/end", such as "2026-10-16T00:00:00Z/P1D".`+"\n\n"+
+						"Given Value: 2026-10-16/P1D\n"+
+						"Error: invalid interval start: parsing time \"2026-10-16\" as \"2006-01-02T15:04:05Z07:00\": cannot parse \"\" as \"T\"",
+				),
+			},
+		},
+		"invalid ISO 8601 interval - invalid duration": {
+			iso8601Interval: timetypes.ISO8601Interval{
+				StringValue: basetypes.NewStringValue("2026-10-16T00:00:00Z/P1H"),
+			},
+			expectedDiags: diag.Diagnostics{
+				diag.NewAttributeErrorDiagnostic(
+					path.Root("test"),
+					"Invalid ISO 8601 Interval String Value",
+					"A string value was provided that is not valid ISO 8601 interval string format. "+
+						`An ISO 8601 interval has the format "start/end", "start/duration" or "duration/end", such as "2026-10-16T00:00:00Z/P1D".`+"\n\n"+
+						"Given Value: 2026-10-16T00:00:00Z/P1H\n"+
+						"Error: invalid interval duration: unexpected designator 'H' in \"1H\", expected one of \"YMWD\"",
+				),
+			},
+		},
+		"invalid ISO 8601 interval - end before start": {
+			iso8601Interval: timetypes.ISO8601Interval{
+				StringValue: basetypes.NewStringValue("2026-10-16T00:00:00Z/2026-10-16T01:00:00+02:00"),
+			},
+			expectedDiags: diag.Diagnostics{
+				diag.NewAttributeErrorDiagnostic(
+					path.Root("test"),
+					"Invalid ISO 8601 Interval String Value",
+					"A string value was provided that is not valid ISO 8601 interval string format. "+
+						`An ISO 8601 interval has the format "start/end", "start/duration" or "duration/end", such as "2026-10-16T00:00:00Z/P1D".`+"\n\n"+
+						"Given Value: 2026-10-16T00:00:00Z/2026-10-16T01:00:00+02:00\n"+
+						"Error: interval end 2026-10-16T01:00:00+02:00 is before start 2026-10-16T00:00:00Z",
+				),
+			},
+		},
+	}
+	for name, testCase := range testCases {
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			resp := xattr.ValidateAttributeResponse{}
+
+			testCase.iso8601Interval.ValidateAttribute(
+				context.Background(),
+				xattr.ValidateAttributeRequest{
+					Path: path.Root("test"),
+				},
+				&resp,
+			)
+
+			if diff := cmp.Diff(resp.Diagnostics, testCase.expectedDiags); diff != "" {
+				t.Errorf("Unexpected diagnostics (-got, +expected): %s", diff)
+			}
+		})
+	}
+}
+
+func TestISO8601IntervalValidateParameter(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		iso8601Interval timetypes.ISO8601Interval
+		expectedFuncErr *function.FuncError
+	}{
+		"empty-struct": {
+			iso8601Interval: timetypes.ISO8601Interval{},
+		},
+		"null": {
+			iso8601Interval: timetypes.NewISO8601IntervalNull(),
+		},
+		"unknown": {
+			iso8601Interval: timetypes.NewISO8601IntervalUnknown(),
+		},
+		"valid ISO 8601 interval": {
+			iso8601Interval: timetypes.NewISO8601IntervalValueMust("2026-10-16T00:00:00Z/P1D"),
+		},
+		"invalid ISO 8601 interval": {
+			iso8601Interval: timetypes.ISO8601Interval{
+				StringValue: basetypes.NewStringValue("2026-10-16T00:00:00Z/P1D/P1D"),
+			},
+			expectedFuncErr: function.NewArgumentFuncError(
+				0,
+				"Invalid ISO 8601 Interval String Value: "+
+					"A string value was provided that is not valid ISO 8601 interval string format. "+
+					`An ISO 8601 interval has the format "start/end", "start/duration" or "duration/end", such as "2026-10-16T00:00:00Z/P1D".`+"\n\n"+
+					"Given Value: 2026-10-16T00:00:00Z/P1D/P1D\n"+
+					"Error: expected a single \"/\" separating the start and end of the interval, got \"2026-10-16T00:00:00Z/P1D/P1D\"",
+			),
+		},
+	}
+	for name, testCase := range testCases {
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			resp := function.ValidateParameterResponse{}
+
+			testCase.iso8601Interval.ValidateParameter(
+				context.Background(),
+				function.ValidateParameterRequest{
+					Position: int64(0),
+				},
+				&resp,
+			)
+
+			if diff := cmp.Diff(resp.Error, testCase.expectedFuncErr); diff != "" {
+				t.Errorf("Unexpected diagnostics (-got, +expected): %s", diff)
+			}
+		})
+	}
+}
+
+func TestISO8601Interval_ValueStartTime_ValueEndTime(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		iso8601Interval    timetypes.ISO8601Interval
+		expectedStart      time.Time
+		expectedEnd        time.Time
+		expectedStartDiags diag.Diagnostics
+		expectedEndDiags   diag.Diagnostics
+	}{
+		"ISO 8601 interval string value is null": {
+			iso8601Interval: timetypes.NewISO8601IntervalNull(),
+			expectedStartDiags: diag.Diagnostics{
+				diag.NewErrorDiagnostic("ISO8601Interval ValueStartTime Error", "ISO 8601 interval string value is null"),
+			},
+			expectedEndDiags: diag.Diagnostics{
+				diag.NewErrorDiagnostic("ISO8601Interval ValueEndTime Error", "ISO 8601 interval string value is null"),
+			},
+		},
+		"ISO 8601 interval string value is unknown": {
+			iso8601Interval: timetypes.NewISO8601IntervalUnknown(),
+			expectedStartDiags: diag.Diagnostics{
+				diag.NewErrorDiagnostic("ISO8601Interval ValueStartTime Error", "ISO 8601 interval string value is unknown"),
+			},
+			expectedEndDiags: diag.Diagnostics{
+				diag.NewErrorDiagnostic("ISO8601Interval ValueEndTime Error", "ISO 8601 interval string value is unknown"),
+			},
+		},
+		"start/end": {
+			iso8601Interval: timetypes.NewISO8601IntervalValueMust("2026-10-16T00:00:00Z/2026-10-17T12:00:00+02:00"),
+			expectedStart:   time.Date(2026, time.October, 16, 0, 0, 0, 0, time.UTC),
+			expectedEnd:     time.Date(2026, time.October, 17, 10, 0, 0, 0, time.UTC),
+		},
+		"start/duration": {
+			iso8601Interval: timetypes.NewISO8601IntervalValueMust("2026-01-31T00:00:00Z/P1MT2H30M"),
+			expectedStart:   time.Date(2026, time.January, 31, 0, 0, 0, 0, time.UTC),
+			expectedEnd:     time.Date(2026, time.March, 3, 2, 30, 0, 0, time.UTC),
+		},
+		"duration/end": {
+			iso8601Interval: timetypes.NewISO8601IntervalValueMust("P1MT2H30M/2026-03-03T02:30:00Z"),
+			expectedStart:   time.Date(2026, time.February, 3, 0, 0, 0, 0, time.UTC),
+			expectedEnd:     time.Date(2026, time.March, 3, 2, 30, 0, 0, time.UTC),
+		},
+	}
+	for name, testCase := range testCases {
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			start, diags := testCase.iso8601Interval.ValueStartTime()
+
+			if !start.Equal(testCase.expectedStart) {
+				t.Errorf("Unexpected start time, got: %s, expected: %s", start, testCase.expectedStart)
+			}
+
+			if diff := cmp.Diff(diags, testCase.expectedStartDiags); diff != "" {
+				t.Errorf("Unexpected start diagnostics (-got, +expected): %s", diff)
+			}
+
+			end, diags := testCase.iso8601Interval.ValueEndTime()
+
+			if !end.Equal(testCase.expectedEnd) {
+				t.Errorf("Unexpected end time, got: %s, expected: %s", end, testCase.expectedEnd)
+			}
+
+			if diff := cmp.Diff(diags, testCase.expectedEndDiags); diff != "" {
+				t.Errorf("Unexpected end diagnostics (-got, +expected): %s", diff)
+			}
+		})
+	}
+}
+
+func TestNewISO8601IntervalTimeValue(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		start         time.Time
+		end           time.Time
+		expected      timetypes.ISO8601Interval
+		expectedDiags diag.Diagnostics
+	}{
+		"valid": {
+			start:    time.Date(2026, time.October, 16, 0, 0, 0, 0, time.UTC),
+			end:      time.Date(2026, time.October, 17, 0, 0, 0, 500000000, time.FixedZone("", 2*60*60)),
+			expected: timetypes.NewISO8601IntervalValueMust("2026-10-16T00:00:00Z/2026-10-17T00:00:00.5+02:00"),
+		},
+		"end before start": {
+			start:    time.Date(2026, time.October, 17, 0, 0, 0, 0, time.UTC),
+			end:      time.Date(2026, time.October, 16, 0, 0, 0, 0, time.UTC),
+			expected: timetypes.NewISO8601IntervalUnknown(),
+			expectedDiags: diag.Diagnostics{
+				diag.NewErrorDiagnostic(
+					"Invalid ISO 8601 Interval String Value",
+					"A string value was provided that is not valid ISO 8601 interval string format. "+
+						`An ISO 8601 interval has the format "start/end", "start/duration" or "duration/end", such as "2026-10-16T00:00:00Z/P1D".`+"\n\n"+
+						"Given Value: 2026-10-17T00:00:00Z/2026-10-16T00:00:00Z\n"+
+						"Error: interval end 2026-10-16T00:00:00Z is before start 2026-10-17T00:00:00Z",
+				),
+			},
+		},
+	}
+	for name, testCase := range testCases {
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got, diags := timetypes.NewISO8601IntervalTimeValue(testCase.start, testCase.end)
+
+			if !got.Equal(testCase.expected) {
+				t.Errorf("Expected %s, got %s", testCase.expected, got)
+			}
+
+			if diff := cmp.Diff(diags, testCase.expectedDiags); diff != "" {
+				t.Errorf("Unexpected diagnostics (-got, +expected): %s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,378 @@
+// Copyright IBM Corp. 2023, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package timetypes
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/attr/xattr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+)
+
+var (
+	_ basetypes.StringValuableWithSemanticEquals = (*ISO8601RepeatingInterval)(nil)
+	_ xattr.ValidateableAttribute                = (*ISO8601RepeatingInterval)(nil)
+	_ function.ValidateableParameter             = (*ISO8601RepeatingInterval)(nil)
+)
+
+// iso8601RepeatingIntervalMaxOccurrences is the maximum number of occurrences that
+// ISO8601RepeatingInterval.ValueOccurrences will return, such that a large n does not allocate unbounded memory.
+const iso8601RepeatingIntervalMaxOccurrences = 100_000
+
+// iso8601RepeatingInterval is a parsed ISO 8601 repeating interval.
+type iso8601RepeatingInterval struct {
+	// repetitions is the number of occurrences, or -1 if unbounded.
+	repetitions int64
+
+	start  time.Time
+	period ISO8601DurationComponents
+}
+
+// ISO8601RepeatingInterval represents a valid ISO 8601 repeating interval string, such as
+// `R5/2026-10-16T00:00:00Z/PT1H`.
+//
+// The supported formats are `Rn/start/duration` and `Rn/start/end`, where n is the number of occurrences, or omitted
+// for an unbounded number of occurrences, and the interval is as supported by ISO8601Interval. The period of each
+// repetition is the duration, or the fixed-length time between start and end, and must be greater than zero. The
+// `Rn/duration/end` format, which repeats backwards from the end, is not supported.
+type ISO8601RepeatingInterval struct {
+	basetypes.StringValue
+}
+
+// Type returns an ISO8601RepeatingIntervalType.
+func (v ISO8601RepeatingInterval) Type(_ context.Context) attr.Type {
+	return ISO8601RepeatingIntervalType{}
+}
+
+// Equal returns true if the given value is equivalent.
+func (v ISO8601RepeatingInterval) Equal(o attr.Value) bool {
+	other, ok := o.(ISO8601RepeatingInterval)
+
+	if !ok {
+		return false
+	}
+
+	return v.StringValue.Equal(other.StringValue)
+}
+
+// StringSemanticEquals returns true if the given ISO 8601 repeating interval string value is semantically equal to
+// the current ISO 8601 repeating interval string value. Both values are parsed into their repetition count, start and
+// period, where the start is compared as an RFC 3339-formatted string, consistent with RFC3339 semantic equality, and
+// the period is compared consistent with ISO8601Duration semantic equality.
+//
+// Examples:
+//   - `R5/2026-10-16T00:00:00Z/PT1H` is semantically equal to `R5/2026-10-16T00:00:00Z/PT60M`
+//   - `R/2026-10-16T00:00:00Z/PT1H` is semantically equal to `R/2026-10-16T00:00:00+00:00/2026-10-16T01:00:00Z`
+//
+// Counterexamples:
+//   - `R5/2026-10-16T00:00:00Z/P1D` is NOT semantically equal to `R5/2026-10-16T00:00:00Z/PT24H`, as a calendar
+//     day is not always 24 hours long.
+func (v ISO8601RepeatingInterval) StringSemanticEquals(_ context.Context, newValuable basetypes.StringValuable) (bool, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	newValue, ok := newValuable.(ISO8601RepeatingInterval)
+	if !ok {
+		diags.AddError(
+			"Semantic Equality Check Error",
+			"An unexpected value type was received while performing semantic equality checks. "+
+				"Please report this to the provider developers.\n\n"+
+				"Expected Value Type: "+fmt.Sprintf("%T", v)+"\n"+
+				"Got Value Type: "+fmt.Sprintf("%T", newValuable),
+		)
+
+		return false, diags
+	}
+
+	// ISO 8601 repeating interval strings are already validated at this point, ignoring errors
+	current, _ := parseISO8601RepeatingInterval(v.ValueString())
+	given, _ := parseISO8601RepeatingInterval(newValue.ValueString())
+
+	return current.repetitions == given.repetitions &&
+		current.start.Format(time.RFC3339Nano) == given.start.Format(time.RFC3339Nano) &&
+		current.period.normalize() == given.period.normalize(), diags
+}
+
+// ValidateAttribute implements attribute value validation. This type requires the value to be a String value that
+// is a valid ISO 8601 repeating interval.
+func (v ISO8601RepeatingInterval) ValidateAttribute(ctx context.Context, req xattr.ValidateAttributeRequest, resp *xattr.ValidateAttributeResponse) {
+	if v.IsUnknown() || v.IsNull() {
+		return
+	}
+
+	if _, err := parseISO8601RepeatingInterval(v.ValueString()); err != nil {
+		resp.Diagnostics.Append(diag.WithPath(req.Path, iso8601RepeatingIntervalInvalidStringDiagnostic(v.ValueString(), err)))
+
+		return
+	}
+}
+
+// ValidateParameter implements provider-defined function parameter value validation. This type requires the value to
+// be a String value that is a valid ISO 8601 repeating interval.
+func (v ISO8601RepeatingInterval) ValidateParameter(ctx context.Context, req function.ValidateParameterRequest, resp *function.ValidateParameterResponse) {
+	if v.IsUnknown() || v.IsNull() {
+		return
+	}
+
+	if _, err := parseISO8601RepeatingInterval(v.ValueString()); err != nil {
+		resp.Error = function.NewArgumentFuncError(
+			req.Position,
+			"Invalid ISO 8601 Repeating Interval String Value: "+
+				"A string value was provided that is not valid ISO 8601 repeating interval string format. "+
+				`An ISO 8601 repeating interval has the format "Rn/start/duration" or "Rn/start/end", where n is optional, such as "R5/2026-10-16T00:00:00Z/PT1H".`+"\n\n"+
+				"Given Value: "+v.ValueString()+"\n"+
+				"Error: "+err.Error(),
+		)
+
+		return
+	}
+}
+
+// ValueRepetitions returns the number of occurrences of the ISO8601RepeatingInterval, or -1 if the number of
+// occurrences is unbounded. A null or unknown value will produce an error diagnostic.
+func (v ISO8601RepeatingInterval) ValueRepetitions() (int64, diag.Diagnostics) {
+	interval, diags := v.valueRepeatingInterval("ValueRepetitions")
+	if diags.HasError() {
+		return 0, diags
+	}
+
+	return interval.repetitions, nil
+}
+
+// ValueStartTime creates a new time.Time instance with the start of the first occurrence of the
+// ISO8601RepeatingInterval. A null or unknown value will produce an error diagnostic.
+func (v ISO8601RepeatingInterval) ValueStartTime() (time.Time, diag.Diagnostics) {
+	interval, diags := v.valueRepeatingInterval("ValueStartTime")
+	if diags.HasError() {
+		return time.Time{}, diags
+	}
+
+	return interval.start, nil
+}
+
+// ValuePeriod creates a new ISO8601DurationComponents instance with the period between occurrences of the
+// ISO8601RepeatingInterval. A null or unknown value will produce an error diagnostic.
+func (v ISO8601RepeatingInterval) ValuePeriod() (ISO8601DurationComponents, diag.Diagnostics) {
+	interval, diags := v.valueRepeatingInterval("ValuePeriod")
+	if diags.HasError() {
+		return ISO8601DurationComponents{}, diags
+	}
+
+	return interval.period, nil
+}
+
+// ValueOccurrences returns the start times of the first n occurrences of the ISO8601RepeatingInterval, or fewer if the
+// repetition count is less than n. Each occurrence is calculated by adding a multiple of the period to the start via
+// ISO8601DurationComponents.AddTo, so calendar periods do not drift, for example a period of `P1M` from January 31st
+// gives March 3rd, as February 31st is normalized, then March 31st. A null or unknown value, a negative n, more than
+// 100,000 occurrences, or occurrences whose multiple of the period overflows, will produce an error diagnostic.
+func (v ISO8601RepeatingInterval) ValueOccurrences(n int) ([]time.Time, diag.Diagnostics) {
+	interval, diags := v.valueRepeatingInterval("ValueOccurrences")
+	if diags.HasError() {
+		return nil, diags
+	}
+
+	if n < 0 {
+		diags.Append(diag.NewErrorDiagnostic("ISO8601RepeatingInterval ValueOccurrences Error", fmt.Sprintf("number of occurrences %d must not be negative", n)))
+		return nil, diags
+	}
+
+	if interval.repetitions >= 0 && int64(n) > interval.repetitions {
+		n = int(interval.repetitions)
+	}
+
+	if n > iso8601RepeatingIntervalMaxOccurrences {
+		diags.Append(diag.NewErrorDiagnostic("ISO8601RepeatingInterval ValueOccurrences Error", fmt.Sprintf("number of occurrences %d exceeds the maximum of %d", n, iso8601RepeatingIntervalMaxOccurrences)))
+		return nil, diags
+	}
+
+	// The largest multiple of the period is that of the last occurrence.
+	if last := int64(n - 1); last > 0 {
+		for _, component := range []int64{interval.period.Years, interval.period.Months, interval.period.Days, int64(interval.period.Duration)} {
+			if component > math.MaxInt64/last || component < math.MinInt64/last {
+				diags.Append(diag.NewErrorDiagnostic("ISO8601RepeatingInterval ValueOccurrences Error", fmt.Sprintf("period %s multiplied by %d overflows", interval.period, last)))
+				return nil, diags
+			}
+		}
+	}
+
+	occurrences := make([]time.Time, n)
+
+	for i := range occurrences {
+		occurrences[i] = ISO8601DurationComponents{
+			Years:    interval.period.Years * int64(i),
+			Months:   interval.period.Months * int64(i),
+			Days:     interval.period.Days * int64(i),
+			Duration: interval.period.Duration * time.Duration(i),
+		}.AddTo(interval.start)
+	}
+
+	return occurrences, nil
+}
+
+// valueRepeatingInterval returns the parsed ISO8601RepeatingInterval, with error diagnostics summarized by the given
+// accessor method name.
+func (v ISO8601RepeatingInterval) valueRepeatingInterval(accessor string) (iso8601RepeatingInterval, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	summary := "ISO8601RepeatingInterval " + accessor + " Error"
+
+	if v.IsNull() {
+		diags.Append(diag.NewErrorDiagnostic(summary, "ISO 8601 repeating interval string value is null"))
+		return iso8601RepeatingInterval{}, diags
+	}
+
+	if v.IsUnknown() {
+		diags.Append(diag.NewErrorDiagnostic(summary, "ISO 8601 repeating interval string value is unknown"))
+		return iso8601RepeatingInterval{}, diags
+	}
+
+	interval, err := parseISO8601RepeatingInterval(v.ValueString())
+	if err != nil {
+		diags.Append(diag.NewErrorDiagnostic(summary, err.Error()))
+		return iso8601RepeatingInterval{}, diags
+	}
+
+	return interval, nil
+}
+
+// NewISO8601RepeatingIntervalNull creates an ISO8601RepeatingInterval with a null value. Determine whether the value is null via IsNull method.
+func NewISO8601RepeatingIntervalNull() ISO8601RepeatingInterval {
+	return ISO8601RepeatingInterval{
+		StringValue: basetypes.NewStringNull(),
+	}
+}
+
+// NewISO8601RepeatingIntervalUnknown creates an ISO8601RepeatingInterval with an unknown value. Determine whether the value is unknown via IsUnknown method.
+func NewISO8601RepeatingIntervalUnknown() ISO8601RepeatingInterval {
+	return ISO8601RepeatingInterval{
+		StringValue: basetypes.NewStringUnknown(),
+	}
+}
+
+// NewISO8601RepeatingIntervalValue creates an ISO8601RepeatingInterval with a
+// known value or raises an error diagnostic if the string is not ISO 8601
+// repeating interval format.
+func NewISO8601RepeatingIntervalValue(value string) (ISO8601RepeatingInterval, diag.Diagnostics) {
+	_, err := parseISO8601RepeatingInterval(value)
+
+	if err != nil {
+		// Returning an unknown value will guarantee that, as a last resort,
+		// Terraform will return an error if attempting to store into state.
+		return NewISO8601RepeatingIntervalUnknown(), diag.Diagnostics{iso8601RepeatingIntervalInvalidStringDiagnostic(value, err)}
+	}
+
+	return ISO8601RepeatingInterval{
+		StringValue: basetypes.NewStringValue(value),
+	}, nil
+}
+
+// NewISO8601RepeatingIntervalValueMust creates an ISO8601RepeatingInterval with
+// a known value or raises a panic if the string is not ISO 8601 repeating
+// interval format.
+//
+// This creation function is only recommended to create ISO8601RepeatingInterval values which
+// either will not potentially affect practitioners, such as testing, or within
+// exhaustively tested provider logic.
+func NewISO8601RepeatingIntervalValueMust(value string) ISO8601RepeatingInterval {
+	_, err := parseISO8601RepeatingInterval(value)
+
+	if err != nil {
+		panic(fmt.Sprintf("Invalid ISO 8601 Repeating Interval String Value (%s): %s", value, err))
+	}
+
+	return ISO8601RepeatingInterval{
+		StringValue: basetypes.NewStringValue(value),
+	}
+}
+
+// NewISO8601RepeatingIntervalPointerValue creates an ISO8601RepeatingInterval
+// with a null value if nil, a known value, or raises an error diagnostic if the
+// string is not ISO 8601 repeating interval format.
+func NewISO8601RepeatingIntervalPointerValue(value *string) (ISO8601RepeatingInterval, diag.Diagnostics) {
+	if value == nil {
+		return NewISO8601RepeatingIntervalNull(), nil
+	}
+
+	return NewISO8601RepeatingIntervalValue(*value)
+}
+
+// NewISO8601RepeatingIntervalPointerValueMust creates an
+// ISO8601RepeatingInterval with a null value if nil, a known value, or raises a
+// panic if the string is not ISO 8601 repeating interval format.
+//
+// This creation function is only recommended to create ISO8601RepeatingInterval values which
+// either will not potentially affect practitioners, such as testing, or within
+// exhaustively tested provider logic.
+func NewISO8601RepeatingIntervalPointerValueMust(value *string) ISO8601RepeatingInterval {
+	if value == nil {
+		return NewISO8601RepeatingIntervalNull()
+	}
+
+	return NewISO8601RepeatingIntervalValueMust(*value)
+}
+
+// parseISO8601RepeatingInterval parses an ISO 8601 repeating interval string of the format `Rn/start/duration` or
+// `Rn/start/end` into its repetition count, start and period.
+func parseISO8601RepeatingInterval(value string) (iso8601RepeatingInterval, error) {
+	rest, ok := strings.CutPrefix(value, "R")
+	if !ok {
+		return iso8601RepeatingInterval{}, errors.New(`repeating interval must start with "R"`)
+	}
+
+	countValue, intervalValue, ok := strings.Cut(rest, "/")
+	if !ok {
+		return iso8601RepeatingInterval{}, fmt.Errorf(`expected "/" after repetition count, got %q`, rest)
+	}
+
+	repetitions := int64(-1)
+
+	if countValue != "" {
+		var err error
+
+		if repetitions, err = strconv.ParseInt(countValue, 10, 64); !isDigits(countValue) || err != nil {
+			return iso8601RepeatingInterval{}, fmt.Errorf("expected a non-negative repetition count, got %q", countValue)
+		}
+	}
+
+	if strings.HasPrefix(intervalValue, "P") {
+		return iso8601RepeatingInterval{}, fmt.Errorf("expected the interval to start with a date-time, got %q", intervalValue)
+	}
+
+	start, end, err := parseISO8601Interval(intervalValue)
+	if err != nil {
+		return iso8601RepeatingInterval{}, err
+	}
+
+	var period ISO8601DurationComponents
+
+	if _, periodValue, _ := strings.Cut(intervalValue, "/"); strings.HasPrefix(periodValue, "P") {
+		// The duration is already validated by parseISO8601Interval, ignoring errors
+		period, _ = parseISO8601Duration(periodValue)
+	} else {
+		period.Duration = end.Sub(start)
+
+		if !start.Add(period.Duration).Equal(end) {
+			return iso8601RepeatingInterval{}, fmt.Errorf("interval %q is too long to use as a repetition period", intervalValue)
+		}
+	}
+
+	if period.normalize() == (ISO8601DurationComponents{}) {
+		return iso8601RepeatingInterval{}, errors.New("repetition period must be greater than zero")
+	}
+
+	return iso8601RepeatingInterval{
+		repetitions: repetitions,
+		start:       start,
+		period:      period,
+	}, nil
+}
@@ -0,0 +1,40 @@
+// Copyright IBM Corp. 2023, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package timetypes_test
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework-timetypes/timetypes"
+)
+
+type SnapshotPolicyResourceModel struct {
+	Schedule timetypes.ISO8601RepeatingInterval `tfsdk:"schedule"`
+}
+
+func ExampleISO8601RepeatingInterval_ValueOccurrences() {
+	// For example purposes, typically the data model would be populated automatically by Plugin Framework via Config, Plan or State.
+	// https://developer.hashicorp.com/terraform/plugin/framework/handling-data/accessing-values
+	data := SnapshotPolicyResourceModel{
+		Schedule: timetypes.NewISO8601RepeatingIntervalValueMust("R3/2026-10-16T00:00:00Z/PT6H"),
+	}
+
+	// Check that the ISO 8601 repeating interval data is known and able to be enumerated
+	if !data.Schedule.IsNull() && !data.Schedule.IsUnknown() {
+		occurrences, diags := data.Schedule.ValueOccurrences(10)
+		if diags.HasError() {
+			return
+		}
+
+		for _, occurrence := range occurrences {
+			fmt.Println(occurrence.Format(time.RFC3339))
+		}
+
+		// Output:
+		// 2026-10-16T00:00:00Z
+		// 2026-10-16T06:00:00Z
+		// 2026-10-16T12:00:00Z
+	}
+}
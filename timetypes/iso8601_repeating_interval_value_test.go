@@ -0,0 +1,451 @@
+// Copyright IBM Corp. 2023, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package timetypes_test
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-framework/attr/xattr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+
+	"github.com/hashicorp/terraform-plugin-framework-timetypes/timetypes"
+)
+
+func TestISO8601RepeatingInterval_StringSemanticEquals(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		currentRepeatingInterval timetypes.ISO8601RepeatingInterval
+		givenRepeatingInterval   basetypes.StringValuable
+		expectedMatch            bool
+		expectedDiags            diag.Diagnostics
+	}{
+		"not equal - different repetitions": {
+			currentRepeatingInterval: timetypes.NewISO8601RepeatingIntervalValueMust("R5/2026-10-16T00:00:00Z/PT1H"),
+			givenRepeatingInterval:   timetypes.NewISO8601RepeatingIntervalValueMust("R/2026-10-16T00:00:00Z/PT1H"),
+			expectedMatch:            false,
+		},
+		"not equal - different start": {
+			currentRepeatingInterval: timetypes.NewISO8601RepeatingIntervalValueMust("R5/2026-10-16T00:00:00Z/PT1H"),
+			givenRepeatingInterval:   timetypes.NewISO8601RepeatingIntervalValueMust("R5/2026-10-16T02:00:00+02:00/PT1H"),
+			expectedMatch:            false,
+		},
+		"not equal - calendar and fixed-length period": {
+			currentRepeatingInterval: timetypes.NewISO8601RepeatingIntervalValueMust("R5/2026-10-16T00:00:00Z/P1D"),
+			givenRepeatingInterval:   timetypes.NewISO8601RepeatingIntervalValueMust("R5/2026-10-16T00:00:00Z/PT24H"),
+			expectedMatch:            false,
+		},
+		"semantically equal - byte for byte match": {
+			currentRepeatingInterval: timetypes.NewISO8601RepeatingIntervalValueMust("R5/2026-10-16T00:00:00Z/PT1H"),
+			givenRepeatingInterval:   timetypes.NewISO8601RepeatingIntervalValueMust("R5/2026-10-16T00:00:00Z/PT1H"),
+			expectedMatch:            true,
+		},
+		"semantically equal - equivalent periods": {
+			currentRepeatingInterval: timetypes.NewISO8601RepeatingIntervalValueMust("R5/2026-10-16T00:00:00Z/PT1H"),
+			givenRepeatingInterval:   timetypes.NewISO8601RepeatingIntervalValueMust("R5/2026-10-16T00:00:00Z/PT60M"),
+			expectedMatch:            true,
+		},
+		"semantically equal - start/end and start/duration": {
+			currentRepeatingInterval: timetypes.NewISO8601RepeatingIntervalValueMust("R/2026-10-16T00:00:00Z/PT1H"),
+			givenRepeatingInterval:   timetypes.NewISO8601RepeatingIntervalValueMust("R/2026-10-16T00:00:00+00:00/2026-10-16T01:00:00Z"),
+			expectedMatch:            true,
+		},
+		"error - not given ISO8601RepeatingInterval value": {
+			currentRepeatingInterval: timetypes.NewISO8601RepeatingIntervalValueMust("R5/2026-10-16T00:00:00Z/PT1H"),
+			givenRepeatingInterval:   basetypes.NewStringValue("R5/2026-10-16T00:00:00Z/PT1H"),
+			expectedMatch:            false,
+			expectedDiags: diag.Diagnostics{
+				diag.NewErrorDiagnostic(
+					"Semantic Equality Check Error",
+					"An unexpected value type was received while performing semantic equality checks. "+
+						"Please report this to the provider developers.\n\n"+
+						"Expected Value Type: timetypes.ISO8601RepeatingInterval\n"+
+						"Got Value Type: basetypes.StringValue",
+				),
+			},
+		},
+	}
+	for name, testCase := range testCases {
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			match, diags := testCase.currentRepeatingInterval.StringSemanticEquals(context.Background(), testCase.givenRepeatingInterval)
+
+			if testCase.expectedMatch != match {
+				t.Errorf("Expected StringSemanticEquals to return: %t, but got: %t", testCase.expectedMatch, match)
+			}
+
+			if diff := cmp.Diff(diags, testCase.expectedDiags); diff != "" {
+				t.Errorf("Unexpected diagnostics (-got, +expected): %s", diff)
+			}
+		})
+	}
+}
+
+func TestISO8601RepeatingIntervalValidateAttribute(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		repeatingInterval timetypes.ISO8601RepeatingInterval
+		expectedDiags     diag.Diagnostics
+	}{
+		"empty-struct": {
+			repeatingInterval: timetypes.ISO8601RepeatingInterval{},
+		},
+		"null": {
+			repeatingInterval: timetypes.NewISO8601RepeatingIntervalNull(),
+		},
+		"unknown": {
+			repeatingInterval: timetypes.NewISO8601RepeatingIntervalUnknown(),
+		},
+		"valid ISO 8601 repeating interval - bounded": {
+			repeatingInterval: timetypes.NewISO8601RepeatingIntervalValueMust("R5/2026-10-16T00:00:00Z/PT1H"),
+		},
+		"valid ISO 8601 repeating interval - unbounded": {
+			repeatingInterval: timetypes.NewISO8601RepeatingIntervalValueMust("R/2026-10-16T00:00:00Z/P1M"),
+		},
+		"valid ISO 8601 repeating interval - start/end": {
+			repeatingInterval: timetypes.NewISO8601RepeatingIntervalValueMust("R0/2026-10-16T00:00:00Z/2026-10-17T00:00:00Z"),
+		},
+		"invalid ISO 8601 repeating interval - missing R": {
+			repeatingInterval: timetypes.ISO8601RepeatingInterval{
+				StringValue: basetypes.NewStringValue("2026-10-16T00:00:00Z/PT1H"),
+			},
+			expectedDiags: diag.Diagnostics{
+				diag.NewAttributeErrorDiagnostic(
+					path.Root("test"),
+					"Invalid ISO 8601 Repeating Interval String Value",
+					"A string value was provided that is not valid ISO 8601 repeating interval string format. "+
+						`An ISO 8601 repeating interval has the format "Rn/start/duration" or "Rn/start/end", where n is optional, such as "R5/2026-10-16T00:00:00Z/PT1H".`+"\n\n"+
+						"Given Value: 2026-10-16T00:00:00Z/PT1H\n"+
+						"Error: repeating interval must start with \"R\"",
+				),
+			},
+		},
+		"invalid ISO 8601 repeating interval - negative repetitions": {
+			repeatingInterval: timetypes.ISO8601RepeatingInterval{
+				StringValue: basetypes.NewStringValue("R-1/2026-10-16T00:00:00Z/PT1H"),
+			},
+			expectedDiags: diag.Diagnostics{
+				diag.NewAttributeErrorDiagnostic(
+					path.Root("test"),
+					"Invalid ISO 8601 Repeating Interval String Value",
+					"A string value was provided that is not valid ISO 8601 repeating interval string format. "+
+						`An ISO 8601 repeating interval has the format "Rn/start/duration" or "Rn/start/end", where n is optional, such as "R5/2026-10-16T00:00:00Z/PT1H".`+"\n\n"+
+						"Given Value: R-1/2026-10-16T00:00:00Z/PT1H\n"+
+						"Error: expected a non-negative repetition count, got \"-1\"",
+				),
+			},
+		},
+		"invalid ISO 8601 repeating interval - missing interval": {
+			repeatingInterval: timetypes.ISO8601RepeatingInterval{
+				StringValue: basetypes.NewStringValue("R5"),
+			},
+			expectedDiags: diag.Diagnostics{
+				diag.NewAttributeErrorDiagnostic(
+					path.Root("test"),
+					"Invalid ISO 8601 Repeating Interval String Value",
+					"A string value was provided that is not valid ISO 8601 repeating interval string format. "+
+						`An ISO 8601 repeating interval has the format "Rn/start/duration" or "Rn/start/end", where n is optional, such as "R5/2026-10-16T00:00:00Z/PT1H".`+"\n\n"+
+						"Given Value: R5\n"+
+						"Error: expected \"/\" after repetition count, got \"5\"",
+				),
+			},
+		},
+		"invalid ISO 8601 repeating interval - duration/end": {
+			repeatingInterval: timetypes.ISO8601RepeatingInterval{
+				StringValue: basetypes.NewStringValue("R5/PT1H/2026-10-16T00:00:00Z"),
+			},
+			expectedDiags: diag.Diagnostics{
+				diag.NewAttributeErrorDiagnostic(
+					path.Root("test"),
+					"Invalid ISO 8601 Repeating Interval String Value",
+					"A string value was provided that is not valid ISO 8601 repeating interval string format. "+
+						`An ISO 8601 repeating interval has the format "Rn/start/duration" or "Rn/start/end", where n is optional, such as "R5/2026-10-16T00:00:00Z/PT1H".`+"\n\n"+
+						"Given Value: R5/PT1H/2026-10-16T00:00:00Z\n"+
+						"Error: expected the interval to start with a date-time, got \"PT1H/2026-10-16T00:00:00Z\"",
+				),
+			},
+		},
+		"invalid ISO 8601 repeating interval - invalid period": {
+			repeatingInterval: timetypes.ISO8601RepeatingInterval{
+				StringValue: basetypes.NewStringValue("R5/2026-10-16T00:00:00Z/1H"),
+			},
+			expectedDiags: diag.Diagnostics{
+				diag.NewAttributeErrorDiagnostic(
+					path.Root("test"),
+					"Invalid ISO 8601 Repeating Interval String Value",
+					"A string value was provided that is not valid ISO 8601 repeating interval string format. "+
+						`An ISO 8601 repeating interval has the format "Rn/start/duration" or "Rn/start/end", where n is optional, such as "R5/2026-10-16T00:00:00Z/PT1H".`+"\n\n"+
+						"Given Value: R5/2026-10-16T00:00:00Z/1H\n"+
+						"Error: invalid interval end: parsing time \"1H\" as \"2006-01-02T15:04:05Z07:00\": cannot parse \"1H\" as \"2006\"",
+				),
+			},
+		},
+		"invalid ISO 8601 repeating interval - zero period": {
+			repeatingInterval: timetypes.ISO8601RepeatingInterval{
+				StringValue: basetypes.NewStringValue("R/2026-10-16T00:00:00Z/PT0S"),
+			},
+			expectedDiags: diag.Diagnostics{
+				diag.NewAttributeErrorDiagnostic(
+					path.Root("test"),
+					"Invalid ISO 8601 Repeating Interval String Value",
+					"A string value was provided that is not valid ISO 8601 repeating interval string format. "+
+						`An ISO 8601 repeating interval has the format "Rn/start/duration" or "Rn/start/end", where n is optional, such as "R5/2026-10-16T00:00:00Z/PT1H".`+"\n\n"+
+						"Given Value: R/2026-10-16T00:00:00Z/PT0S\n"+
+						"Error: repetition period must be greater than zero",
+				),
+			},
+		},
+	}
+	for name, testCase := range testCases {
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			resp := xattr.ValidateAttributeResponse{}
+
+			testCase.repeatingInterval.ValidateAttribute(
+				context.Background(),
+				xattr.ValidateAttributeRequest{
+					Path: path.Root("test"),
+				},
+				&resp,
+			)
+
+			if diff := cmp.Diff(resp.Diagnostics, testCase.expectedDiags); diff != "" {
+				t.Errorf("Unexpected diagnostics (-got, +expected): %s", diff)
+			}
+		})
+	}
+}
+
+func TestISO8601RepeatingIntervalValidateParameter(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		repeatingInterval timetypes.ISO8601RepeatingInterval
+		expectedFuncErr   *function.FuncError
+	}{
+		"empty-struct": {
+			repeatingInterval: timetypes.ISO8601RepeatingInterval{},
+		},
+		"null": {
+			repeatingInterval: timetypes.NewISO8601RepeatingIntervalNull(),
+		},
+		"unknown": {
+			repeatingInterval: timetypes.NewISO8601RepeatingIntervalUnknown(),
+		},
+		"valid ISO 8601 repeating interval": {
+			repeatingInterval: timetypes.NewISO8601RepeatingIntervalValueMust("R5/2026-10-16T00:00:00Z/PT1H"),
+		},
+		"invalid ISO 8601 repeating interval": {
+			repeatingInterval: timetypes.ISO8601RepeatingInterval{
+				StringValue: basetypes.NewStringValue("R5/2026-10-16T00:00:00Z/2026-10-15T00:00:00Z"),
+			},
+			expectedFuncErr: function.NewArgumentFuncError(
+				0,
+				"Invalid ISO 8601 Repeating Interval String Value: "+
+					"A string value was provided that is not valid ISO 8601 repeating interval string format. "+
+					`An ISO 8601 repeating interval has the format "Rn/start/duration" or "Rn/start/end", where n is optional, such as "R5/2026-10-16T00:00:00Z/PT1H".`+"\n\n"+
+					"Given Value: R5/2026-10-16T00:00:00Z/2026-10-15T00:00:00Z\n"+
+					"Error: interval end 2026-10-15T00:00:00Z is before start 2026-10-16T00:00:00Z",
+			),
+		},
+	}
+	for name, testCase := range testCases {
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			resp := function.ValidateParameterResponse{}
+
+			testCase.repeatingInterval.ValidateParameter(
+				context.Background(),
+				function.ValidateParameterRequest{
+					Position: int64(0),
+				},
+				&resp,
+			)
+
+			if diff := cmp.Diff(resp.Error, testCase.expectedFuncErr); diff != "" {
+				t.Errorf("Unexpected diagnostics (-got, +expected): %s", diff)
+			}
+		})
+	}
+}
+
+func TestISO8601RepeatingInterval_ValueRepetitions(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		repeatingInterval   timetypes.ISO8601RepeatingInterval
+		expectedRepetitions int64
+		expectedDiags       diag.Diagnostics
+	}{
+		"ISO 8601 repeating interval string value is null": {
+			repeatingInterval: timetypes.NewISO8601RepeatingIntervalNull(),
+			expectedDiags: diag.Diagnostics{
+				diag.NewErrorDiagnostic("ISO8601RepeatingInterval ValueRepetitions Error", "ISO 8601 repeating interval string value is null"),
+			},
+		},
+		"ISO 8601 repeating interval string value is unknown": {
+			repeatingInterval: timetypes.NewISO8601RepeatingIntervalUnknown(),
+			expectedDiags: diag.Diagnostics{
+				diag.NewErrorDiagnostic("ISO8601RepeatingInterval ValueRepetitions Error", "ISO 8601 repeating interval string value is unknown"),
+			},
+		},
+		"bounded": {
+			repeatingInterval:   timetypes.NewISO8601RepeatingIntervalValueMust("R5/2026-10-16T00:00:00Z/PT1H"),
+			expectedRepetitions: 5,
+		},
+		"unbounded": {
+			repeatingInterval:   timetypes.NewISO8601RepeatingIntervalValueMust("R/2026-10-16T00:00:00Z/PT1H"),
+			expectedRepetitions: -1,
+		},
+	}
+	for name, testCase := range testCases {
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			repetitions, diags := testCase.repeatingInterval.ValueRepetitions()
+
+			if repetitions != testCase.expectedRepetitions {
+				t.Errorf("Unexpected repetitions, got: %d, expected: %d", repetitions, testCase.expectedRepetitions)
+			}
+
+			if diff := cmp.Diff(diags, testCase.expectedDiags); diff != "" {
+				t.Errorf("Unexpected diagnostics (-got, +expected): %s", diff)
+			}
+		})
+	}
+}
+
+func TestISO8601RepeatingInterval_ValueStartTime_ValuePeriod(t *testing.T) {
+	t.Parallel()
+
+	repeatingInterval := timetypes.NewISO8601RepeatingIntervalValueMust("R/2026-10-16T00:00:00+02:00/2026-10-16T01:30:00+02:00")
+
+	start, diags := repeatingInterval.ValueStartTime()
+	if diags.HasError() {
+		t.Fatalf("Unexpected diagnostics: %v", diags)
+	}
+
+	if expected := time.Date(2026, time.October, 15, 22, 0, 0, 0, time.UTC); !start.Equal(expected) {
+		t.Errorf("Unexpected start time, got: %s, expected: %s", start, expected)
+	}
+
+	period, diags := repeatingInterval.ValuePeriod()
+	if diags.HasError() {
+		t.Fatalf("Unexpected diagnostics: %v", diags)
+	}
+
+	if diff := cmp.Diff(period, timetypes.ISO8601DurationComponents{Duration: 90 * time.Minute}); diff != "" {
+		t.Errorf("Unexpected period (-got, +expected): %s", diff)
+	}
+}
+
+func TestISO8601RepeatingInterval_ValueOccurrences(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		repeatingInterval   timetypes.ISO8601RepeatingInterval
+		n                   int
+		expectedOccurrences []time.Time
+		expectedDiags       diag.Diagnostics
+	}{
+		"ISO 8601 repeating interval string value is null": {
+			repeatingInterval: timetypes.NewISO8601RepeatingIntervalNull(),
+			n:                 3,
+			expectedDiags: diag.Diagnostics{
+				diag.NewErrorDiagnostic("ISO8601RepeatingInterval ValueOccurrences Error", "ISO 8601 repeating interval string value is null"),
+			},
+		},
+		"negative n": {
+			repeatingInterval: timetypes.NewISO8601RepeatingIntervalValueMust("R/2026-10-16T00:00:00Z/PT1H"),
+			n:                 -1,
+			expectedDiags: diag.Diagnostics{
+				diag.NewErrorDiagnostic("ISO8601RepeatingInterval ValueOccurrences Error", "number of occurrences -1 must not be negative"),
+			},
+		},
+		"too many occurrences": {
+			repeatingInterval: timetypes.NewISO8601RepeatingIntervalValueMust("R/2026-10-16T00:00:00Z/PT1H"),
+			n:                 math.MaxInt,
+			expectedDiags: diag.Diagnostics{
+				diag.NewErrorDiagnostic("ISO8601RepeatingInterval ValueOccurrences Error", fmt.Sprintf("number of occurrences %d exceeds the maximum of 100000", math.MaxInt)),
+			},
+		},
+		"too many occurrences - bounded": {
+			repeatingInterval: timetypes.NewISO8601RepeatingIntervalValueMust("R1000000/2026-10-16T00:00:00Z/PT1H"),
+			n:                 1000000,
+			expectedDiags: diag.Diagnostics{
+				diag.NewErrorDiagnostic("ISO8601RepeatingInterval ValueOccurrences Error", "number of occurrences 1000000 exceeds the maximum of 100000"),
+			},
+		},
+		"period overflows": {
+			repeatingInterval: timetypes.NewISO8601RepeatingIntervalValueMust("R/2026-10-16T00:00:00Z/PT2000000H"),
+			n:                 10000,
+			expectedDiags: diag.Diagnostics{
+				diag.NewErrorDiagnostic("ISO8601RepeatingInterval ValueOccurrences Error", "period PT2000000H multiplied by 9999 overflows"),
+			},
+		},
+		"unbounded": {
+			repeatingInterval: timetypes.NewISO8601RepeatingIntervalValueMust("R/2026-10-16T00:00:00Z/PT1H"),
+			n:                 3,
+			expectedOccurrences: []time.Time{
+				time.Date(2026, time.October, 16, 0, 0, 0, 0, time.UTC),
+				time.Date(2026, time.October, 16, 1, 0, 0, 0, time.UTC),
+				time.Date(2026, time.October, 16, 2, 0, 0, 0, time.UTC),
+			},
+		},
+		"bounded by repetitions": {
+			repeatingInterval: timetypes.NewISO8601RepeatingIntervalValueMust("R2/2026-10-16T00:00:00Z/P1W"),
+			n:                 3,
+			expectedOccurrences: []time.Time{
+				time.Date(2026, time.October, 16, 0, 0, 0, 0, time.UTC),
+				time.Date(2026, time.October, 23, 0, 0, 0, 0, time.UTC),
+			},
+		},
+		"calendar months do not drift": {
+			repeatingInterval: timetypes.NewISO8601RepeatingIntervalValueMust("R/2026-01-31T00:00:00Z/P1M"),
+			n:                 3,
+			expectedOccurrences: []time.Time{
+				time.Date(2026, time.January, 31, 0, 0, 0, 0, time.UTC),
+				time.Date(2026, time.March, 3, 0, 0, 0, 0, time.UTC),
+				time.Date(2026, time.March, 31, 0, 0, 0, 0, time.UTC),
+			},
+		},
+		"zero repetitions": {
+			repeatingInterval:   timetypes.NewISO8601RepeatingIntervalValueMust("R0/2026-10-16T00:00:00Z/PT1H"),
+			n:                   3,
+			expectedOccurrences: []time.Time{},
+		},
+	}
+	for name, testCase := range testCases {
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			occurrences, diags := testCase.repeatingInterval.ValueOccurrences(testCase.n)
+
+			if diff := cmp.Diff(occurrences, testCase.expectedOccurrences); diff != "" {
+				t.Errorf("Unexpected occurrences (-got, +expected): %s", diff)
+			}
+
+			if diff := cmp.Diff(diags, testCase.expectedDiags); diff != "" {
+				t.Errorf("Unexpected diagnostics (-got, +expected): %s", diff)
+			}
+		})
+	}
+}
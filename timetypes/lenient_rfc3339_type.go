@@ -0,0 +1,77 @@
+// Copyright IBM Corp. 2023, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package timetypes
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+var (
+	_ basetypes.StringTypable = (*LenientRFC3339Type)(nil)
+)
+
+// LenientRFC3339Type is an attribute type that represents an RFC 3339 string or one of a set of common ISO 8601
+// spellings of it, such as `2026-10-16 12:00Z`. Semantic equality logic is defined for LenientRFC3339Type such that
+// values representing the same instant with the same UTC offset are considered equal.
+type LenientRFC3339Type struct {
+	basetypes.StringType
+}
+
+// String returns a human-readable string of the type name.
+func (t LenientRFC3339Type) String() string {
+	return "timetypes.LenientRFC3339Type"
+}
+
+// ValueType returns the Value type.
+func (t LenientRFC3339Type) ValueType(ctx context.Context) attr.Value {
+	return LenientRFC3339{}
+}
+
+// Equal returns true if the given type is equivalent.
+func (t LenientRFC3339Type) Equal(o attr.Type) bool {
+	other, ok := o.(LenientRFC3339Type)
+
+	if !ok {
+		return false
+	}
+
+	return t.StringType.Equal(other.StringType)
+}
+
+// ValueFromString returns a StringValuable type given a StringValue.
+func (t LenientRFC3339Type) ValueFromString(ctx context.Context, in basetypes.StringValue) (basetypes.StringValuable, diag.Diagnostics) {
+	return LenientRFC3339{
+		StringValue: in,
+	}, nil
+}
+
+// ValueFromTerraform returns a Value given a tftypes.Value.  This is meant to convert the tftypes.Value into a more convenient Go type
+// for the provider to consume the data with.
+func (t LenientRFC3339Type) ValueFromTerraform(ctx context.Context, in tftypes.Value) (attr.Value, error) {
+	attrValue, err := t.StringType.ValueFromTerraform(ctx, in)
+
+	if err != nil {
+		return nil, err
+	}
+
+	stringValue, ok := attrValue.(basetypes.StringValue)
+
+	if !ok {
+		return nil, fmt.Errorf("unexpected value type of %T", attrValue)
+	}
+
+	stringValuable, diags := t.ValueFromString(ctx, stringValue)
+
+	if diags.HasError() {
+		return nil, fmt.Errorf("unexpected error converting StringValue to StringValuable: %v", diags)
+	}
+
+	return stringValuable, nil
+}
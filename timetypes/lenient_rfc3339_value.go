@@ -0,0 +1,443 @@
+// Copyright IBM Corp. 2023, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package timetypes
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/attr/xattr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+)
+
+var (
+	_ basetypes.StringValuableWithSemanticEquals = (*LenientRFC3339)(nil)
+	_ xattr.ValidateableAttribute                = (*LenientRFC3339)(nil)
+	_ function.ValidateableParameter             = (*LenientRFC3339)(nil)
+)
+
+// LenientRFC3339 represents an RFC 3339 string, such as `2026-10-16T12:00:00Z`, or one of the following common ISO 8601
+// spellings of it, which are normalized to the canonical RFC 3339 string:
+//   - A lowercase `t` or a space as the date and time separator, such as `2026-10-16 12:00:00Z`
+//   - Omitted seconds, such as `2026-10-16T12:00Z`
+//   - A comma as the decimal separator of fractional seconds, such as `2026-10-16T12:00:00,5Z`
+//   - A lowercase `z` UTC offset, such as `2026-10-16T12:00:00z`
+//   - A UTC offset without a colon or minutes, such as `2026-10-16T14:00:00+0200` or `2026-10-16T14:00:00+02`
+//
+// A UTC offset is always required, as the value must represent an instant. Values which are not canonical raise a
+// warning diagnostic describing the normalization during attribute validation. Semantic equality logic is defined for
+// LenientRFC3339 such that a value is equal to its canonical RFC 3339 string, which remote systems typically return.
+type LenientRFC3339 struct {
+	basetypes.StringValue
+}
+
+// Type returns a LenientRFC3339Type.
+func (v LenientRFC3339) Type(_ context.Context) attr.Type {
+	return LenientRFC3339Type{}
+}
+
+// Equal returns true if the given value is equivalent.
+func (v LenientRFC3339) Equal(o attr.Value) bool {
+	other, ok := o.(LenientRFC3339)
+
+	if !ok {
+		return false
+	}
+
+	return v.StringValue.Equal(other.StringValue)
+}
+
+// StringSemanticEquals returns true if the given LenientRFC3339 or RFC3339 string value is semantically equal to the
+// current LenientRFC3339 string value, which is when both values represent the same instant with the same UTC offset.
+// As with RFC3339, the `Z` suffix is considered equal to a `00:00` UTC offset.
+//
+// Examples:
+//   - `2026-10-16 12:00Z` is semantically equal to `2026-10-16T12:00:00Z`
+//   - `2026-10-16T14:00:00,5+0200` is semantically equal to `2026-10-16T14:00:00.500+02:00`
+//
+// Counterexamples:
+//   - `2026-10-16 14:00+02` expresses the same time as `2026-10-16T12:00:00Z` but is NOT considered to be
+//     semantically equal.
+func (v LenientRFC3339) StringSemanticEquals(_ context.Context, newValuable basetypes.StringValuable) (bool, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	var newTime time.Time
+
+	// Lenient RFC3339 and RFC3339 strings are already validated at this point, ignoring errors
+	switch newValue := newValuable.(type) {
+	case LenientRFC3339:
+		newLenient, _ := parseLenientRFC3339(newValue.ValueString())
+		newTime = newLenient.time
+	case RFC3339:
+		newTime, _ = time.Parse(time.RFC3339, newValue.ValueString())
+	default:
+		diags.AddError(
+			"Semantic Equality Check Error",
+			"An unexpected value type was received while performing semantic equality checks. "+
+				"Please report this to the provider developers.\n\n"+
+				"Expected Value Type: "+fmt.Sprintf("%T", v)+"\n"+
+				"Got Value Type: "+fmt.Sprintf("%T", newValuable),
+		)
+
+		return false, diags
+	}
+
+	current, _ := parseLenientRFC3339(v.ValueString())
+
+	_, currentOffset := current.time.Zone()
+	_, newOffset := newTime.Zone()
+
+	return current.time.Equal(newTime) && currentOffset == newOffset, diags
+}
+
+// ValidateAttribute implements attribute value validation. This type requires the value to be a String value that
+// is valid RFC 3339 format or one of the accepted ISO 8601 spellings. If the value is not in canonical RFC 3339
+// format, a warning diagnostic with the canonical value and a description of the normalization is raised.
+func (v LenientRFC3339) ValidateAttribute(ctx context.Context, req xattr.ValidateAttributeRequest, resp *xattr.ValidateAttributeResponse) {
+	if v.IsUnknown() || v.IsNull() {
+		return
+	}
+
+	lenient, err := parseLenientRFC3339(v.ValueString())
+	if err != nil {
+		resp.Diagnostics.Append(diag.WithPath(req.Path, lenientRFC3339InvalidStringDiagnostic(v.ValueString(), err)))
+
+		return
+	}
+
+	if len(lenient.normalizations) > 0 {
+		resp.Diagnostics.Append(diag.WithPath(req.Path, lenientRFC3339NormalizedDiagnostic(v.ValueString(), lenient.canonical, lenient.normalizations)))
+	}
+}
+
+// ValidateParameter implements provider-defined function parameter value validation. This type requires the value to
+// be a String value that is valid RFC 3339 format or one of the accepted ISO 8601 spellings.
+func (v LenientRFC3339) ValidateParameter(ctx context.Context, req function.ValidateParameterRequest, resp *function.ValidateParameterResponse) {
+	if v.IsUnknown() || v.IsNull() {
+		return
+	}
+
+	if _, err := parseLenientRFC3339(v.ValueString()); err != nil {
+		resp.Error = function.NewArgumentFuncError(
+			req.Position,
+			"Invalid Lenient RFC3339 String Value: "+
+				"A string value was provided that is not valid RFC 3339 string format or an accepted ISO 8601 spelling, such as \"2026-10-16T12:00:00Z\" or \"2026-10-16 12:00Z\".\n\n"+
+				"Given Value: "+v.ValueString()+"\n"+
+				"Error: "+err.Error(),
+		)
+
+		return
+	}
+}
+
+// ValueRFC3339Time creates a new time.Time instance with the LenientRFC3339 StringValue. A null or unknown value will
+// produce an error diagnostic.
+func (v LenientRFC3339) ValueRFC3339Time() (time.Time, diag.Diagnostics) {
+	lenient, diags := v.valueLenientRFC3339("ValueRFC3339Time")
+	if diags.HasError() {
+		return time.Time{}, diags
+	}
+
+	return lenient.time, nil
+}
+
+// ToRFC3339Value converts the LenientRFC3339 to an RFC3339 value with the canonical RFC 3339 string, such as
+// `2026-10-16T12:00:00Z` for `2026-10-16 12:00z`. Null and unknown values are converted to null and unknown RFC3339
+// values respectively.
+func (v LenientRFC3339) ToRFC3339Value() (RFC3339, diag.Diagnostics) {
+	if v.IsNull() {
+		return NewRFC3339Null(), nil
+	}
+
+	if v.IsUnknown() {
+		return NewRFC3339Unknown(), nil
+	}
+
+	lenient, diags := v.valueLenientRFC3339("ToRFC3339Value")
+	if diags.HasError() {
+		return NewRFC3339Unknown(), diags
+	}
+
+	return RFC3339{
+		StringValue: basetypes.NewStringValue(lenient.canonical),
+	}, nil
+}
+
+// valueLenientRFC3339 returns the parsed LenientRFC3339, with error diagnostics summarized by the given accessor
+// method name.
+func (v LenientRFC3339) valueLenientRFC3339(accessor string) (lenientRFC3339, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	summary := "LenientRFC3339 " + accessor + " Error"
+
+	if v.IsNull() {
+		diags.Append(diag.NewErrorDiagnostic(summary, "Lenient RFC3339 string value is null"))
+		return lenientRFC3339{}, diags
+	}
+
+	if v.IsUnknown() {
+		diags.Append(diag.NewErrorDiagnostic(summary, "Lenient RFC3339 string value is unknown"))
+		return lenientRFC3339{}, diags
+	}
+
+	lenient, err := parseLenientRFC3339(v.ValueString())
+	if err != nil {
+		diags.Append(diag.NewErrorDiagnostic(summary, err.Error()))
+		return lenientRFC3339{}, diags
+	}
+
+	return lenient, nil
+}
+
+// NewLenientRFC3339Null creates a LenientRFC3339 with a null value. Determine whether the value is null via IsNull method.
+func NewLenientRFC3339Null() LenientRFC3339 {
+	return LenientRFC3339{
+		StringValue: basetypes.NewStringNull(),
+	}
+}
+
+// NewLenientRFC3339Unknown creates a LenientRFC3339 with an unknown value. Determine whether the value is unknown via IsUnknown method.
+func NewLenientRFC3339Unknown() LenientRFC3339 {
+	return LenientRFC3339{
+		StringValue: basetypes.NewStringUnknown(),
+	}
+}
+
+// NewLenientRFC3339TimeValue creates a LenientRFC3339 with a known value in canonical RFC 3339 format.
+func NewLenientRFC3339TimeValue(value time.Time) LenientRFC3339 {
+	return LenientRFC3339{
+		StringValue: basetypes.NewStringValue(value.Format(time.RFC3339)),
+	}
+}
+
+// NewLenientRFC3339TimePointerValue creates a LenientRFC3339 with a null value if nil or
+// a known value in canonical RFC 3339 format.
+func NewLenientRFC3339TimePointerValue(value *time.Time) LenientRFC3339 {
+	if value == nil {
+		return NewLenientRFC3339Null()
+	}
+
+	return NewLenientRFC3339TimeValue(*value)
+}
+
+// NewLenientRFC3339Value creates a LenientRFC3339 with a known value or raises an error
+// diagnostic if the string is not RFC 3339 format or an accepted ISO 8601 spelling.
+func NewLenientRFC3339Value(value string) (LenientRFC3339, diag.Diagnostics) {
+	_, err := parseLenientRFC3339(value)
+
+	if err != nil {
+		// Returning an unknown value will guarantee that, as a last resort,
+		// Terraform will return an error if attempting to store into state.
+		return NewLenientRFC3339Unknown(), diag.Diagnostics{lenientRFC3339InvalidStringDiagnostic(value, err)}
+	}
+
+	return LenientRFC3339{
+		StringValue: basetypes.NewStringValue(value),
+	}, nil
+}
+
+// NewLenientRFC3339ValueMust creates a LenientRFC3339 with a known value or raises a panic
+// if the string is not RFC 3339 format or an accepted ISO 8601 spelling.
+//
+// This creation function is only recommended to create LenientRFC3339 values which
+// either will not potentially affect practitioners, such as testing, or within
+// exhaustively tested provider logic.
+func NewLenientRFC3339ValueMust(value string) LenientRFC3339 {
+	_, err := parseLenientRFC3339(value)
+
+	if err != nil {
+		panic(fmt.Sprintf("Invalid Lenient RFC3339 String Value (%s): %s", value, err))
+	}
+
+	return LenientRFC3339{
+		StringValue: basetypes.NewStringValue(value),
+	}
+}
+
+// NewLenientRFC3339PointerValue creates a LenientRFC3339 with a null value if nil, a known
+// value, or raises an error diagnostic if the string is not RFC 3339 format or an
+// accepted ISO 8601 spelling.
+func NewLenientRFC3339PointerValue(value *string) (LenientRFC3339, diag.Diagnostics) {
+	if value == nil {
+		return NewLenientRFC3339Null(), nil
+	}
+
+	return NewLenientRFC3339Value(*value)
+}
+
+// NewLenientRFC3339PointerValueMust creates a LenientRFC3339 with a null value if nil, a
+// known value, or raises a panic if the string is not RFC 3339 format or an accepted
+// ISO 8601 spelling.
+//
+// This creation function is only recommended to create LenientRFC3339 values which
+// either will not potentially affect practitioners, such as testing, or within
+// exhaustively tested provider logic.
+func NewLenientRFC3339PointerValueMust(value *string) LenientRFC3339 {
+	if value == nil {
+		return NewLenientRFC3339Null()
+	}
+
+	return NewLenientRFC3339ValueMust(*value)
+}
+
+// lenientRFC3339 is a parsed LenientRFC3339 with its canonical RFC 3339 string.
+type lenientRFC3339 struct {
+	// time is the instant in its UTC offset.
+	time time.Time
+
+	// canonical is the canonical RFC 3339 string.
+	canonical string
+
+	// normalizations describes each change made to the string to produce the canonical string, if any.
+	normalizations []string
+}
+
+// parseLenientRFC3339 parses an RFC 3339 string or one of the accepted ISO 8601 spellings, returning the canonical
+// RFC 3339 string and the normalizations made to produce it.
+func parseLenientRFC3339(value string) (lenientRFC3339, error) {
+	var result lenientRFC3339
+	var canonical strings.Builder
+
+	normalize := func(normalization string) {
+		result.normalizations = append(result.normalizations, normalization)
+	}
+
+	_, _, _, rest, err := parseCalendarDate(value)
+	if err != nil {
+		return result, err
+	}
+
+	canonical.WriteString(value[:len(value)-len(rest)])
+
+	switch {
+	case strings.HasPrefix(rest, "T"):
+	case strings.HasPrefix(rest, "t"):
+		normalize(`replaced the lowercase "t" date and time separator with "T"`)
+	case strings.HasPrefix(rest, " "):
+		normalize(`replaced the space date and time separator with "T"`)
+	default:
+		return result, fmt.Errorf("expected 'T' or ' ' after day, got %q", rest)
+	}
+
+	canonical.WriteByte('T')
+	rest = rest[1:]
+
+	clock := rest
+
+	if _, rest, err = parseFixedDigits(rest, 2, "hour", 0, 23); err != nil {
+		return result, err
+	}
+
+	if rest, err = parseSeparator(rest, ':', "hour"); err != nil {
+		return result, err
+	}
+
+	if _, rest, err = parseFixedDigits(rest, 2, "minute", 0, 59); err != nil {
+		return result, err
+	}
+
+	if !strings.HasPrefix(rest, ":") {
+		normalize(`added the omitted seconds`)
+		canonical.WriteString(clock[:len(clock)-len(rest)] + ":00")
+	} else {
+		if _, rest, err = parseFixedDigits(rest[1:], 2, "second", 0, 59); err != nil {
+			return result, err
+		}
+
+		canonical.WriteString(clock[:len(clock)-len(rest)])
+
+		if rest != "" && (rest[0] == '.' || rest[0] == ',') {
+			i := 1
+
+			for i < len(rest) && rest[i] >= '0' && rest[i] <= '9' {
+				i++
+			}
+
+			switch {
+			case i == 1:
+				return result, fmt.Errorf("expected digits after decimal separator in fractional seconds, got %q", rest[1:])
+			case i > 10:
+				return result, fmt.Errorf("fractional seconds must not have more than 9 digits, got %q", rest[1:i])
+			}
+
+			if rest[0] == ',' {
+				normalize(`replaced the comma decimal separator with "."`)
+			}
+
+			canonical.WriteString("." + rest[1:i])
+			rest = rest[i:]
+		}
+	}
+
+	switch {
+	case rest == "":
+		return result, fmt.Errorf(`expected a "Z" or "+hh:mm" UTC offset after time, got %q`, rest)
+	case rest == "Z":
+		canonical.WriteString("Z")
+	case rest == "z":
+		normalize(`replaced the lowercase "z" UTC offset with "Z"`)
+		canonical.WriteString("Z")
+	case rest[0] == '+' || rest[0] == '-':
+		offset, offsetNormalization, err := parseLenientUTCOffset(rest)
+		if err != nil {
+			return result, err
+		}
+
+		if offsetNormalization != "" {
+			normalize(offsetNormalization)
+		}
+
+		canonical.WriteString(offset)
+	default:
+		return result, fmt.Errorf(`expected a "Z" or "+hh:mm" UTC offset after time, got %q`, rest)
+	}
+
+	result.canonical = canonical.String()
+
+	// The canonical string is built from validated components, so this only guards against overflow of the
+	// time.Time range and any remaining RFC 3339 requirements of time.Parse.
+	if result.time, err = time.Parse(time.RFC3339, result.canonical); err != nil {
+		return result, err
+	}
+
+	return result, nil
+}
+
+// parseLenientUTCOffset parses a UTC offset of the format `+hh:mm`, `+hhmm` or `+hh`, returning the offset in the
+// `+hh:mm` format and a description of the normalization made, if any.
+func parseLenientUTCOffset(value string) (string, string, error) {
+	hours, rest, err := parseFixedDigits(value[1:], 2, "offset hour", 0, 23)
+	if err != nil {
+		return "", "", err
+	}
+
+	var minutes int
+	var normalization string
+
+	switch {
+	case rest == "":
+		normalization = `added the omitted UTC offset minutes`
+	case rest[0] == ':':
+		if minutes, rest, err = parseFixedDigits(rest[1:], 2, "offset minute", 0, 59); err != nil {
+			return "", "", err
+		}
+	default:
+		if minutes, rest, err = parseFixedDigits(rest, 2, "offset minute", 0, 59); err != nil {
+			return "", "", err
+		}
+
+		normalization = `added the omitted colon to the UTC offset`
+	}
+
+	if rest != "" {
+		return "", "", fmt.Errorf("unexpected text after UTC offset: %q", rest)
+	}
+
+	return fmt.Sprintf("%c%02d:%02d", value[0], hours, minutes), normalization, nil
+}
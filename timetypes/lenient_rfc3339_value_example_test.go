@@ -0,0 +1,33 @@
+// Copyright IBM Corp. 2023, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package timetypes_test
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework-timetypes/timetypes"
+)
+
+type DeploymentResourceModel struct {
+	StartTime timetypes.LenientRFC3339 `tfsdk:"start_time"`
+}
+
+func ExampleLenientRFC3339_ToRFC3339Value() {
+	// For example purposes, typically the data model would be populated automatically by Plugin Framework via Config, Plan or State.
+	// https://developer.hashicorp.com/terraform/plugin/framework/handling-data/accessing-values
+	data := DeploymentResourceModel{
+		StartTime: timetypes.NewLenientRFC3339ValueMust("2026-10-16 14:00+0200"),
+	}
+
+	// Check that the lenient RFC3339 data is known and able to be converted to RFC3339
+	if !data.StartTime.IsNull() && !data.StartTime.IsUnknown() {
+		rfc3339, diags := data.StartTime.ToRFC3339Value()
+		if diags.HasError() {
+			return
+		}
+
+		// Output: 2026-10-16T14:00:00+02:00
+		fmt.Println(rfc3339.ValueString())
+	}
+}
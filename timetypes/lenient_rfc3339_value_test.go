@@ -0,0 +1,435 @@
+// Copyright IBM Corp. 2023, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package timetypes_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-framework/attr/xattr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+
+	"github.com/hashicorp/terraform-plugin-framework-timetypes/timetypes"
+)
+
+func TestLenientRFC3339_StringSemanticEquals(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		currentLenientRFC3339 timetypes.LenientRFC3339
+		givenLenientRFC3339   basetypes.StringValuable
+		expectedMatch         bool
+		expectedDiags         diag.Diagnostics
+	}{
+		"not equal - different time": {
+			currentLenientRFC3339: timetypes.NewLenientRFC3339ValueMust("2026-10-16 12:00Z"),
+			givenLenientRFC3339:   timetypes.NewLenientRFC3339ValueMust("2026-10-16 12:01Z"),
+			expectedMatch:         false,
+		},
+		"not equal - same instant with different offset": {
+			currentLenientRFC3339: timetypes.NewLenientRFC3339ValueMust("2026-10-16 14:00+02"),
+			givenLenientRFC3339:   timetypes.NewLenientRFC3339ValueMust("2026-10-16T12:00:00Z"),
+			expectedMatch:         false,
+		},
+		"not equal - RFC3339 with different fractional seconds": {
+			currentLenientRFC3339: timetypes.NewLenientRFC3339ValueMust("2026-10-16T12:00:00,5Z"),
+			givenLenientRFC3339:   timetypes.NewRFC3339ValueMust("2026-10-16T12:00:00.25Z"),
+			expectedMatch:         false,
+		},
+		"semantically equal - byte for byte match": {
+			currentLenientRFC3339: timetypes.NewLenientRFC3339ValueMust("2026-10-16 12:00Z"),
+			givenLenientRFC3339:   timetypes.NewLenientRFC3339ValueMust("2026-10-16 12:00Z"),
+			expectedMatch:         true,
+		},
+		"semantically equal - different spellings": {
+			currentLenientRFC3339: timetypes.NewLenientRFC3339ValueMust("2026-10-16t14:00+0200"),
+			givenLenientRFC3339:   timetypes.NewLenientRFC3339ValueMust("2026-10-16 14:00:00,000+02"),
+			expectedMatch:         true,
+		},
+		"semantically equal - RFC3339": {
+			currentLenientRFC3339: timetypes.NewLenientRFC3339ValueMust("2026-10-16 12:00z"),
+			givenLenientRFC3339:   timetypes.NewRFC3339ValueMust("2026-10-16T12:00:00Z"),
+			expectedMatch:         true,
+		},
+		"semantically equal - RFC3339 with fractional seconds and offset": {
+			currentLenientRFC3339: timetypes.NewLenientRFC3339ValueMust("2026-10-16T14:00:00,5+0200"),
+			givenLenientRFC3339:   timetypes.NewRFC3339ValueMust("2026-10-16T14:00:00.500+02:00"),
+			expectedMatch:         true,
+		},
+		"semantically equal - Z and +00:00 offsets": {
+			currentLenientRFC3339: timetypes.NewLenientRFC3339ValueMust("2026-10-16T12:00:00+00"),
+			givenLenientRFC3339:   timetypes.NewRFC3339ValueMust("2026-10-16T12:00:00Z"),
+			expectedMatch:         true,
+		},
+		"error - not given LenientRFC3339 or RFC3339 value": {
+			currentLenientRFC3339: timetypes.NewLenientRFC3339ValueMust("2026-10-16 12:00Z"),
+			givenLenientRFC3339:   basetypes.NewStringValue("2026-10-16 12:00Z"),
+			expectedMatch:         false,
+			expectedDiags: diag.Diagnostics{
+				diag.NewErrorDiagnostic(
+					"Semantic Equality Check Error",
+					"An unexpected value type was received while performing semantic equality checks. "+
+						"Please report this to the provider developers.\n\n"+
+						"Expected Value Type: timetypes.LenientRFC3339\n"+
+						"Got Value Type: basetypes.StringValue",
+				),
+			},
+		},
+	}
+	for name, testCase := range testCases {
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			match, diags := testCase.currentLenientRFC3339.StringSemanticEquals(context.Background(), testCase.givenLenientRFC3339)
+
+			if testCase.expectedMatch != match {
+				t.Errorf("Expected StringSemanticEquals to return: %t, but got: %t", testCase.expectedMatch, match)
+			}
+
+			if diff := cmp.Diff(diags, testCase.expectedDiags); diff != "" {
+				t.Errorf("Unexpected diagnostics (-got, +expected): %s", diff)
+			}
+		})
+	}
+}
+
+func TestLenientRFC3339ValidateAttribute(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		lenientRFC3339 timetypes.LenientRFC3339
+		expectedDiags  diag.Diagnostics
+	}{
+		"empty-struct": {
+			lenientRFC3339: timetypes.LenientRFC3339{},
+		},
+		"null": {
+			lenientRFC3339: timetypes.NewLenientRFC3339Null(),
+		},
+		"unknown": {
+			lenientRFC3339: timetypes.NewLenientRFC3339Unknown(),
+		},
+		"valid - canonical": {
+			lenientRFC3339: timetypes.NewLenientRFC3339ValueMust("2026-10-16T12:00:00Z"),
+		},
+		"valid - canonical with fractional seconds and offset": {
+			lenientRFC3339: timetypes.NewLenientRFC3339ValueMust("2026-10-16T14:00:00.123456789+02:00"),
+		},
+		"warning - space separator and omitted seconds": {
+			lenientRFC3339: timetypes.NewLenientRFC3339ValueMust("2026-10-16 12:00Z"),
+			expectedDiags: diag.Diagnostics{
+				diag.NewAttributeWarningDiagnostic(
+					path.Root("test"),
+					"Non-Canonical RFC3339 String Value",
+					"A string value was provided that is not in canonical RFC 3339 format and was normalized. "+
+						"Remote systems may report the canonical value instead.\n\n"+
+						"Given Value: 2026-10-16 12:00Z\n"+
+						"Canonical Value: 2026-10-16T12:00:00Z\n"+
+						"Normalization: replaced the space date and time separator with \"T\", added the omitted seconds",
+				),
+			},
+		},
+		"warning - lowercase separator and UTC offset": {
+			lenientRFC3339: timetypes.NewLenientRFC3339ValueMust("2026-10-16t12:00:00z"),
+			expectedDiags: diag.Diagnostics{
+				diag.NewAttributeWarningDiagnostic(
+					path.Root("test"),
+					"Non-Canonical RFC3339 String Value",
+					"A string value was provided that is not in canonical RFC 3339 format and was normalized. "+
+						"Remote systems may report the canonical value instead.\n\n"+
+						"Given Value: 2026-10-16t12:00:00z\n"+
+						"Canonical Value: 2026-10-16T12:00:00Z\n"+
+						"Normalization: replaced the lowercase \"t\" date and time separator with \"T\", replaced the lowercase \"z\" UTC offset with \"Z\"",
+				),
+			},
+		},
+		"warning - comma decimal separator and UTC offset without colon": {
+			lenientRFC3339: timetypes.NewLenientRFC3339ValueMust("2026-10-16T14:00:00,5+0200"),
+			expectedDiags: diag.Diagnostics{
+				diag.NewAttributeWarningDiagnostic(
+					path.Root("test"),
+					"Non-Canonical RFC3339 String Value",
+					"A string value was provided that is not in canonical RFC 3339 format and was normalized. "+
+						"Remote systems may report the canonical value instead.\n\n"+
+						"Given Value: 2026-10-16T14:00:00,5+0200\n"+
+						"Canonical Value: 2026-10-16T14:00:00.5+02:00\n"+
+						"Normalization: replaced the comma decimal separator with \".\", added the omitted colon to the UTC offset",
+				),
+			},
+		},
+		"warning - UTC offset without minutes": {
+			lenientRFC3339: timetypes.NewLenientRFC3339ValueMust("2026-10-16T06:30:00-05"),
+			expectedDiags: diag.Diagnostics{
+				diag.NewAttributeWarningDiagnostic(
+					path.Root("test"),
+					"Non-Canonical RFC3339 String Value",
+					"A string value was provided that is not in canonical RFC 3339 format and was normalized. "+
+						"Remote systems may report the canonical value instead.\n\n"+
+						"Given Value: 2026-10-16T06:30:00-05\n"+
+						"Canonical Value: 2026-10-16T06:30:00-05:00\n"+
+						"Normalization: added the omitted UTC offset minutes",
+				),
+			},
+		},
+		"invalid - missing UTC offset": {
+			lenientRFC3339: timetypes.LenientRFC3339{
+				StringValue: basetypes.NewStringValue("2026-10-16 12:00"),
+			},
+			expectedDiags: diag.Diagnostics{
+				diag.NewAttributeErrorDiagnostic(
+					path.Root("test"),
+					"Invalid Lenient RFC3339 String Value",
+					"A string value was provided that is not valid RFC 3339 string format or an accepted ISO 8601 spelling, such as \"2026-10-16T12:00:00Z\" or \"2026-10-16 12:00Z\".\n\n"+
+						"Given Value: 2026-10-16 12:00\n"+
+						"Error: expected a \"Z\" or \"+hh:mm\" UTC offset after time, got \"\"",
+				),
+			},
+		},
+		"invalid - basic format": {
+			lenientRFC3339: timetypes.LenientRFC3339{
+				StringValue: basetypes.NewStringValue("20261016T120000Z"),
+			},
+			expectedDiags: diag.Diagnostics{
+				diag.NewAttributeErrorDiagnostic(
+					path.Root("test"),
+					"Invalid Lenient RFC3339 String Value",
+					"A string value was provided that is not valid RFC 3339 string format or an accepted ISO 8601 spelling, such as \"2026-10-16T12:00:00Z\" or \"2026-10-16 12:00Z\".\n\n"+
+						"Given Value: 20261016T120000Z\n"+
+						"Error: expected '-' after year, got \"1016T120000Z\"",
+				),
+			},
+		},
+		"invalid - fractional seconds without seconds": {
+			lenientRFC3339: timetypes.LenientRFC3339{
+				StringValue: basetypes.NewStringValue("2026-10-16T12:00.5Z"),
+			},
+			expectedDiags: diag.Diagnostics{
+				diag.NewAttributeErrorDiagnostic(
+					path.Root("test"),
+					"Invalid Lenient RFC3339 String Value",
+					"A string value was provided that is not valid RFC 3339 string format or an accepted ISO 8601 spelling, such as \"2026-10-16T12:00:00Z\" or \"2026-10-16 12:00Z\".\n\n"+
+						"Given Value: 2026-10-16T12:00.5Z\n"+
+						"Error: expected a \"Z\" or \"+hh:mm\" UTC offset after time, got \".5Z\"",
+				),
+			},
+		},
+		"invalid - offset minute": {
+			lenientRFC3339: timetypes.LenientRFC3339{
+				StringValue: basetypes.NewStringValue("2026-10-16T12:00:00+0260"),
+			},
+			expectedDiags: diag.Diagnostics{
+				diag.NewAttributeErrorDiagnostic(
+					path.Root("test"),
+					"Invalid Lenient RFC3339 String Value",
+					"A string value was provided that is not valid RFC 3339 string format or an accepted ISO 8601 spelling, such as \"2026-10-16T12:00:00Z\" or \"2026-10-16 12:00Z\".\n\n"+
+						"Given Value: 2026-10-16T12:00:00+0260\n"+
+						"Error: offset minute \"60\" is out of range [0, 59]",
+				),
+			},
+		},
+	}
+	for name, testCase := range testCases {
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			resp := xattr.ValidateAttributeResponse{}
+
+			testCase.lenientRFC3339.ValidateAttribute(
+				context.Background(),
+				xattr.ValidateAttributeRequest{
+					Path: path.Root("test"),
+				},
+				&resp,
+			)
+
+			if diff := cmp.Diff(resp.Diagnostics, testCase.expectedDiags); diff != "" {
+				t.Errorf("Unexpected diagnostics (-got, +expected): %s", diff)
+			}
+		})
+	}
+}
+
+func TestLenientRFC3339ValidateParameter(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		lenientRFC3339  timetypes.LenientRFC3339
+		expectedFuncErr *function.FuncError
+	}{
+		"empty-struct": {
+			lenientRFC3339: timetypes.LenientRFC3339{},
+		},
+		"null": {
+			lenientRFC3339: timetypes.NewLenientRFC3339Null(),
+		},
+		"unknown": {
+			lenientRFC3339: timetypes.NewLenientRFC3339Unknown(),
+		},
+		"valid - canonical": {
+			lenientRFC3339: timetypes.NewLenientRFC3339ValueMust("2026-10-16T12:00:00Z"),
+		},
+		"valid - normalized": {
+			lenientRFC3339: timetypes.NewLenientRFC3339ValueMust("2026-10-16 12:00Z"),
+		},
+		"invalid": {
+			lenientRFC3339: timetypes.LenientRFC3339{
+				StringValue: basetypes.NewStringValue("2026-10-16 12:00"),
+			},
+			expectedFuncErr: function.NewArgumentFuncError(
+				0,
+				"Invalid Lenient RFC3339 String Value: "+
+					"A string value was provided that is not valid RFC 3339 string format or an accepted ISO 8601 spelling, such as \"2026-10-16T12:00:00Z\" or \"2026-10-16 12:00Z\".\n\n"+
+					"Given Value: 2026-10-16 12:00\n"+
+					"Error: expected a \"Z\" or \"+hh:mm\" UTC offset after time, got \"\"",
+			),
+		},
+	}
+	for name, testCase := range testCases {
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			resp := function.ValidateParameterResponse{}
+
+			testCase.lenientRFC3339.ValidateParameter(
+				context.Background(),
+				function.ValidateParameterRequest{
+					Position: int64(0),
+				},
+				&resp,
+			)
+
+			if diff := cmp.Diff(resp.Error, testCase.expectedFuncErr); diff != "" {
+				t.Errorf("Unexpected diagnostics (-got, +expected): %s", diff)
+			}
+		})
+	}
+}
+
+func TestLenientRFC3339_ValueRFC3339Time(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		lenientRFC3339 timetypes.LenientRFC3339
+		expectedTime   time.Time
+		expectedDiags  diag.Diagnostics
+	}{
+		"Lenient RFC3339 string value is null": {
+			lenientRFC3339: timetypes.NewLenientRFC3339Null(),
+			expectedDiags: diag.Diagnostics{
+				diag.NewErrorDiagnostic("LenientRFC3339 ValueRFC3339Time Error", "Lenient RFC3339 string value is null"),
+			},
+		},
+		"Lenient RFC3339 string value is unknown": {
+			lenientRFC3339: timetypes.NewLenientRFC3339Unknown(),
+			expectedDiags: diag.Diagnostics{
+				diag.NewErrorDiagnostic("LenientRFC3339 ValueRFC3339Time Error", "Lenient RFC3339 string value is unknown"),
+			},
+		},
+		"canonical": {
+			lenientRFC3339: timetypes.NewLenientRFC3339ValueMust("2026-10-16T12:00:00Z"),
+			expectedTime:   time.Date(2026, time.October, 16, 12, 0, 0, 0, time.UTC),
+		},
+		"normalized": {
+			lenientRFC3339: timetypes.NewLenientRFC3339ValueMust("2026-10-16 14:00:00,25+02"),
+			expectedTime:   time.Date(2026, time.October, 16, 14, 0, 0, 250000000, time.FixedZone("", 2*60*60)),
+		},
+	}
+	for name, testCase := range testCases {
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got, diags := testCase.lenientRFC3339.ValueRFC3339Time()
+
+			if !got.Equal(testCase.expectedTime) {
+				t.Errorf("Unexpected difference in time.Time, got: %s, expected: %s", got, testCase.expectedTime)
+			}
+
+			if diff := cmp.Diff(diags, testCase.expectedDiags); diff != "" {
+				t.Errorf("Unexpected diagnostics (-got, +expected): %s", diff)
+			}
+		})
+	}
+}
+
+func TestLenientRFC3339_ToRFC3339Value(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		lenientRFC3339 timetypes.LenientRFC3339
+		expected       timetypes.RFC3339
+		expectedDiags  diag.Diagnostics
+	}{
+		"null": {
+			lenientRFC3339: timetypes.NewLenientRFC3339Null(),
+			expected:       timetypes.NewRFC3339Null(),
+		},
+		"unknown": {
+			lenientRFC3339: timetypes.NewLenientRFC3339Unknown(),
+			expected:       timetypes.NewRFC3339Unknown(),
+		},
+		"canonical": {
+			lenientRFC3339: timetypes.NewLenientRFC3339ValueMust("2026-10-16T12:00:00.5Z"),
+			expected:       timetypes.NewRFC3339ValueMust("2026-10-16T12:00:00.5Z"),
+		},
+		"normalized": {
+			lenientRFC3339: timetypes.NewLenientRFC3339ValueMust("2026-10-16t14:00+0200"),
+			expected:       timetypes.NewRFC3339ValueMust("2026-10-16T14:00:00+02:00"),
+		},
+	}
+	for name, testCase := range testCases {
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got, diags := testCase.lenientRFC3339.ToRFC3339Value()
+
+			if !got.Equal(testCase.expected) {
+				t.Errorf("Expected %s, got %s", testCase.expected, got)
+			}
+
+			if diff := cmp.Diff(diags, testCase.expectedDiags); diff != "" {
+				t.Errorf("Unexpected diagnostics (-got, +expected): %s", diff)
+			}
+		})
+	}
+}
+
+func TestNewLenientRFC3339TimeValue(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		time     time.Time
+		expected timetypes.LenientRFC3339
+	}{
+		"UTC": {
+			time:     time.Date(2026, time.October, 16, 12, 0, 0, 0, time.UTC),
+			expected: timetypes.NewLenientRFC3339ValueMust("2026-10-16T12:00:00Z"),
+		},
+		"fixed zone": {
+			time:     time.Date(2026, time.October, 16, 14, 0, 0, 0, time.FixedZone("", 2*60*60)),
+			expected: timetypes.NewLenientRFC3339ValueMust("2026-10-16T14:00:00+02:00"),
+		},
+	}
+	for name, testCase := range testCases {
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got := timetypes.NewLenientRFC3339TimeValue(testCase.time)
+
+			if !got.Equal(testCase.expected) {
+				t.Errorf("Expected %s, got %s", testCase.expected, got)
+			}
+		})
+	}
+}
@@ -0,0 +1,77 @@
+// Copyright IBM Corp. 2023, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package timetypes
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+var (
+	_ basetypes.StringTypable = (*LocalDateTimeType)(nil)
+)
+
+// LocalDateTimeType is an attribute type that represents a valid ISO 8601 date and time string without a UTC offset,
+// such as `2026-10-16T09:00:00`. Semantic equality logic is defined for LocalDateTimeType such that omitted seconds and
+// fractional seconds are considered equal to zero.
+type LocalDateTimeType struct {
+	basetypes.StringType
+}
+
+// String returns a human-readable string of the type name.
+func (t LocalDateTimeType) String() string {
+	return "timetypes.LocalDateTimeType"
+}
+
+// ValueType returns the Value type.
+func (t LocalDateTimeType) ValueType(ctx context.Context) attr.Value {
+	return LocalDateTime{}
+}
+
+// Equal returns true if the given type is equivalent.
+func (t LocalDateTimeType) Equal(o attr.Type) bool {
+	other, ok := o.(LocalDateTimeType)
+
+	if !ok {
+		return false
+	}
+
+	return t.StringType.Equal(other.StringType)
+}
+
+// ValueFromString returns a StringValuable type given a StringValue.
+func (t LocalDateTimeType) ValueFromString(ctx context.Context, in basetypes.StringValue) (basetypes.StringValuable, diag.Diagnostics) {
+	return LocalDateTime{
+		StringValue: in,
+	}, nil
+}
+
+// ValueFromTerraform returns a Value given a tftypes.Value.  This is meant to convert the tftypes.Value into a more convenient Go type
+// for the provider to consume the data with.
+func (t LocalDateTimeType) ValueFromTerraform(ctx context.Context, in tftypes.Value) (attr.Value, error) {
+	attrValue, err := t.StringType.ValueFromTerraform(ctx, in)
+
+	if err != nil {
+		return nil, err
+	}
+
+	stringValue, ok := attrValue.(basetypes.StringValue)
+
+	if !ok {
+		return nil, fmt.Errorf("unexpected value type of %T", attrValue)
+	}
+
+	stringValuable, diags := t.ValueFromString(ctx, stringValue)
+
+	if diags.HasError() {
+		return nil, fmt.Errorf("unexpected error converting StringValue to StringValuable: %v", diags)
+	}
+
+	return stringValuable, nil
+}
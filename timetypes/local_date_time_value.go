@@ -0,0 +1,316 @@
+// Copyright IBM Corp. 2023, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package timetypes
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/attr/xattr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+)
+
+var (
+	_ basetypes.StringValuableWithSemanticEquals = (*LocalDateTime)(nil)
+	_ xattr.ValidateableAttribute                = (*LocalDateTime)(nil)
+	_ function.ValidateableParameter             = (*LocalDateTime)(nil)
+)
+
+// LocalDateTime represents a valid ISO 8601 date and time string without a UTC offset, such as `2026-10-16T09:00:00`.
+// The time may be `hh:mm`, `hh:mm:ss` or `hh:mm:ss.fff`, where the fractional seconds may contain up to 9 digits. A
+// local date-time is a wall clock time, which is only resolved into an instant given a time.Location.
+//
+// Semantic equality logic is defined for LocalDateTime such that omitted seconds and fractional seconds are considered
+// equal to zero.
+//
+// See https://en.wikipedia.org/wiki/ISO_8601#Local_time_(unqualified) for more details on the string format.
+type LocalDateTime struct {
+	basetypes.StringValue
+}
+
+// Type returns a LocalDateTimeType.
+func (v LocalDateTime) Type(_ context.Context) attr.Type {
+	return LocalDateTimeType{}
+}
+
+// Equal returns true if the given value is equivalent.
+func (v LocalDateTime) Equal(o attr.Value) bool {
+	other, ok := o.(LocalDateTime)
+
+	if !ok {
+		return false
+	}
+
+	return v.StringValue.Equal(other.StringValue)
+}
+
+// StringSemanticEquals returns true if the given local date-time string value is semantically equal to the current
+// local date-time string value, which is when both values have the same wall clock date and time.
+//
+// Examples:
+//   - `2026-10-16T09:00` is semantically equal to `2026-10-16T09:00:00`
+//   - `2026-10-16T09:00:00.500` is semantically equal to `2026-10-16T09:00:00.5`
+func (v LocalDateTime) StringSemanticEquals(_ context.Context, newValuable basetypes.StringValuable) (bool, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	newValue, ok := newValuable.(LocalDateTime)
+	if !ok {
+		diags.AddError(
+			"Semantic Equality Check Error",
+			"An unexpected value type was received while performing semantic equality checks. "+
+				"Please report this to the provider developers.\n\n"+
+				"Expected Value Type: "+fmt.Sprintf("%T", v)+"\n"+
+				"Got Value Type: "+fmt.Sprintf("%T", newValuable),
+		)
+
+		return false, diags
+	}
+
+	// Local date-time strings are already validated at this point, ignoring errors
+	currentWallClock, _ := parseLocalDateTime(v.ValueString())
+	newWallClock, _ := parseLocalDateTime(newValue.ValueString())
+
+	return currentWallClock.Equal(newWallClock), diags
+}
+
+// ValidateAttribute implements attribute value validation. This type requires the value to be a String value that
+// is a valid ISO 8601 date and time without a UTC offset.
+func (v LocalDateTime) ValidateAttribute(ctx context.Context, req xattr.ValidateAttributeRequest, resp *xattr.ValidateAttributeResponse) {
+	if v.IsUnknown() || v.IsNull() {
+		return
+	}
+
+	if _, err := parseLocalDateTime(v.ValueString()); err != nil {
+		resp.Diagnostics.Append(diag.WithPath(req.Path, localDateTimeInvalidStringDiagnostic(v.ValueString(), err)))
+
+		return
+	}
+}
+
+// ValidateParameter implements provider-defined function parameter value validation. This type requires the value to
+// be a String value that is a valid ISO 8601 date and time without a UTC offset.
+func (v LocalDateTime) ValidateParameter(ctx context.Context, req function.ValidateParameterRequest, resp *function.ValidateParameterResponse) {
+	if v.IsUnknown() || v.IsNull() {
+		return
+	}
+
+	if _, err := parseLocalDateTime(v.ValueString()); err != nil {
+		resp.Error = function.NewArgumentFuncError(
+			req.Position,
+			"Invalid Local Date Time String Value: "+
+				"A string value was provided that is not valid ISO 8601 local date and time string format, such as \"2026-10-16T09:00:00\".\n\n"+
+				"Given Value: "+v.ValueString()+"\n"+
+				"Error: "+err.Error(),
+		)
+
+		return
+	}
+}
+
+// ValueInstant resolves the LocalDateTime StringValue into a time.Time instance with the same wall clock in the given
+// location. A nil location is treated as UTC. Wall clock times that do not exist in the location, as they are skipped by
+// a daylight saving time transition, are moved later by the length of the transition, such that `02:30` on a day where
+// clocks move forward from `02:00` to `03:00` resolves to `03:30`. Wall clock times that occur twice in the location, as
+// they are repeated by a daylight saving time transition, resolve to the earlier instant. A null or unknown value will
+// produce an error diagnostic.
+func (v LocalDateTime) ValueInstant(loc *time.Location) (time.Time, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	if v.IsNull() {
+		diags.Append(diag.NewErrorDiagnostic("LocalDateTime ValueInstant Error", "Local date time string value is null"))
+		return time.Time{}, diags
+	}
+
+	if v.IsUnknown() {
+		diags.Append(diag.NewErrorDiagnostic("LocalDateTime ValueInstant Error", "Local date time string value is unknown"))
+		return time.Time{}, diags
+	}
+
+	wallClock, err := parseLocalDateTime(v.ValueString())
+	if err != nil {
+		diags.Append(diag.NewErrorDiagnostic("LocalDateTime ValueInstant Error", err.Error()))
+		return time.Time{}, diags
+	}
+
+	if loc == nil {
+		loc = time.UTC
+	}
+
+	return resolveWallClock(wallClock, loc), nil
+}
+
+// ToRFC3339Value converts the LocalDateTime to an RFC3339 value of the instant described by ValueInstant, such as
+// `2026-10-16T09:00:00+02:00` for `2026-10-16T09:00:00` in Europe/Berlin. A nil location is treated as UTC. Fractional
+// seconds are preserved. Null and unknown values are converted to null and unknown RFC3339 values respectively.
+func (v LocalDateTime) ToRFC3339Value(loc *time.Location) (RFC3339, diag.Diagnostics) {
+	if v.IsNull() {
+		return NewRFC3339Null(), nil
+	}
+
+	if v.IsUnknown() {
+		return NewRFC3339Unknown(), nil
+	}
+
+	instant, diags := v.ValueInstant(loc)
+	if diags.HasError() {
+		return NewRFC3339Unknown(), diags
+	}
+
+	return newRFC3339TimeNanoValue(instant), nil
+}
+
+// NewLocalDateTimeNull creates a LocalDateTime with a null value. Determine whether the value is null via IsNull method.
+func NewLocalDateTimeNull() LocalDateTime {
+	return LocalDateTime{
+		StringValue: basetypes.NewStringNull(),
+	}
+}
+
+// NewLocalDateTimeUnknown creates a LocalDateTime with an unknown value. Determine whether the value is unknown via IsUnknown method.
+func NewLocalDateTimeUnknown() LocalDateTime {
+	return LocalDateTime{
+		StringValue: basetypes.NewStringUnknown(),
+	}
+}
+
+// NewLocalDateTimeTimeValue creates a LocalDateTime with a known value. The wall clock date and time are taken from the
+// time.Time in its own location, and fractional seconds are only included when non-zero.
+func NewLocalDateTimeTimeValue(value time.Time) LocalDateTime {
+	return LocalDateTime{
+		StringValue: basetypes.NewStringValue(value.Format("2006-01-02T15:04:05.999999999")),
+	}
+}
+
+// NewLocalDateTimeTimePointerValue creates a LocalDateTime with a null value if nil or
+// a known value. The wall clock date and time are taken from the time.Time in its
+// own location, and fractional seconds are only included when non-zero.
+func NewLocalDateTimeTimePointerValue(value *time.Time) LocalDateTime {
+	if value == nil {
+		return NewLocalDateTimeNull()
+	}
+
+	return NewLocalDateTimeTimeValue(*value)
+}
+
+// NewLocalDateTimeValue creates a LocalDateTime with a known value or raises an error
+// diagnostic if the string is not ISO 8601 local date and time format.
+func NewLocalDateTimeValue(value string) (LocalDateTime, diag.Diagnostics) {
+	_, err := parseLocalDateTime(value)
+
+	if err != nil {
+		// Returning an unknown value will guarantee that, as a last resort,
+		// Terraform will return an error if attempting to store into state.
+		return NewLocalDateTimeUnknown(), diag.Diagnostics{localDateTimeInvalidStringDiagnostic(value, err)}
+	}
+
+	return LocalDateTime{
+		StringValue: basetypes.NewStringValue(value),
+	}, nil
+}
+
+// NewLocalDateTimeValueMust creates a LocalDateTime with a known value or raises a panic
+// if the string is not ISO 8601 local date and time format.
+//
+// This creation function is only recommended to create LocalDateTime values which
+// either will not potentially affect practitioners, such as testing, or within
+// exhaustively tested provider logic.
+func NewLocalDateTimeValueMust(value string) LocalDateTime {
+	_, err := parseLocalDateTime(value)
+
+	if err != nil {
+		panic(fmt.Sprintf("Invalid Local Date Time String Value (%s): %s", value, err))
+	}
+
+	return LocalDateTime{
+		StringValue: basetypes.NewStringValue(value),
+	}
+}
+
+// NewLocalDateTimePointerValue creates a LocalDateTime with a null value if nil, a known
+// value, or raises an error diagnostic if the string is not ISO 8601 local date and time format.
+func NewLocalDateTimePointerValue(value *string) (LocalDateTime, diag.Diagnostics) {
+	if value == nil {
+		return NewLocalDateTimeNull(), nil
+	}
+
+	return NewLocalDateTimeValue(*value)
+}
+
+// NewLocalDateTimePointerValueMust creates a LocalDateTime with a null value if nil, a
+// known value, or raises a panic if the string is not ISO 8601 local date and time format.
+//
+// This creation function is only recommended to create LocalDateTime values which
+// either will not potentially affect practitioners, such as testing, or within
+// exhaustively tested provider logic.
+func NewLocalDateTimePointerValueMust(value *string) LocalDateTime {
+	if value == nil {
+		return NewLocalDateTimeNull()
+	}
+
+	return NewLocalDateTimeValueMust(*value)
+}
+
+// parseLocalDateTime parses an ISO 8601 date and time without a UTC offset of the format
+// `YYYY-MM-DDThh:mm[:ss[.fff]]`, returning the wall clock date and time in UTC.
+func parseLocalDateTime(value string) (time.Time, error) {
+	year, month, day, rest, err := parseCalendarDate(value)
+	if err != nil {
+		return time.Time{}, err
+	}
+
+	if rest, err = parseSeparator(rest, 'T', "day"); err != nil {
+		return time.Time{}, err
+	}
+
+	clock, rest, err := parseClock(rest)
+	if err != nil {
+		return time.Time{}, err
+	}
+
+	switch {
+	case rest == "":
+	case rest[0] == 'Z' || rest[0] == '+' || rest[0] == '-':
+		return time.Time{}, fmt.Errorf("unexpected UTC offset %q, a local date and time must not include an offset", rest)
+	default:
+		return time.Time{}, fmt.Errorf("unexpected text after time: %q", rest)
+	}
+
+	return time.Date(year, month, day, clock.Hour, clock.Minute, clock.Second, clock.Nanosecond, time.UTC), nil
+}
+
+// resolveWallClock returns the instant with the same wall clock as the given UTC time in the given location. Wall
+// clock times skipped by a transition in the location are moved later by the length of the transition and wall clock
+// times repeated by a transition resolve to the earlier instant. This is deterministic, unlike time.Date, which does
+// not guarantee the result in either case.
+func resolveWallClock(wallClock time.Time, loc *time.Location) time.Time {
+	// Transitions are assumed to be at least a day apart, so the offsets either side of the wall clock are those in
+	// effect a day before and a day after it.
+	_, offsetBefore := wallClock.Add(-24 * time.Hour).In(loc).Zone()
+	_, offsetAfter := wallClock.Add(24 * time.Hour).In(loc).Zone()
+
+	before := wallClock.Add(-time.Duration(offsetBefore) * time.Second).In(loc)
+	after := wallClock.Add(-time.Duration(offsetAfter) * time.Second).In(loc)
+
+	_, beforeOffset := before.Zone()
+	_, afterOffset := after.Zone()
+
+	switch {
+	case beforeOffset == offsetBefore && afterOffset == offsetAfter:
+		if after.Before(before) {
+			return after
+		}
+
+		return before
+	case afterOffset == offsetAfter:
+		return after
+	default:
+		// Either the wall clock is only valid with the offset before the transition, or it was skipped by the
+		// transition, in which case the offset before the transition moves it later.
+		return before
+	}
+}
@@ -0,0 +1,41 @@
+// Copyright IBM Corp. 2023, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package timetypes_test
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework-timetypes/timetypes"
+)
+
+type MeetingResourceModel struct {
+	StartTime timetypes.LocalDateTime `tfsdk:"start_time"`
+	TimeZone  timetypes.TimeZone      `tfsdk:"time_zone"`
+}
+
+func ExampleLocalDateTime_ValueInstant() {
+	// For example purposes, typically the data model would be populated automatically by Plugin Framework via Config, Plan or State.
+	// https://developer.hashicorp.com/terraform/plugin/framework/handling-data/accessing-values
+	data := MeetingResourceModel{
+		StartTime: timetypes.NewLocalDateTimeValueMust("2026-10-16T09:00:00"),
+		TimeZone:  timetypes.NewTimeZoneValueMust("Europe/Berlin"),
+	}
+
+	// Check that the local date time and time zone data are known and able to be converted to time.Time
+	if !data.StartTime.IsNull() && !data.StartTime.IsUnknown() && !data.TimeZone.IsNull() && !data.TimeZone.IsUnknown() {
+		loc, diags := data.TimeZone.ValueLocation()
+		if diags.HasError() {
+			return
+		}
+
+		t, diags := data.StartTime.ValueInstant(loc)
+		if diags.HasError() {
+			return
+		}
+
+		// Output: 2026-10-16T09:00:00+02:00
+		fmt.Println(t.Format(time.RFC3339))
+	}
+}
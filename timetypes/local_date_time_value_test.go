@@ -0,0 +1,407 @@
+// Copyright IBM Corp. 2023, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package timetypes_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-framework/attr/xattr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+
+	"github.com/hashicorp/terraform-plugin-framework-timetypes/timetypes"
+)
+
+func TestLocalDateTime_StringSemanticEquals(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		currentLocalDateTime timetypes.LocalDateTime
+		givenLocalDateTime   basetypes.StringValuable
+		expectedMatch        bool
+		expectedDiags        diag.Diagnostics
+	}{
+		"not equal - different time": {
+			currentLocalDateTime: timetypes.NewLocalDateTimeValueMust("2026-10-16T09:00:00"),
+			givenLocalDateTime:   timetypes.NewLocalDateTimeValueMust("2026-10-16T09:00:01"),
+			expectedMatch:        false,
+		},
+		"not equal - different date": {
+			currentLocalDateTime: timetypes.NewLocalDateTimeValueMust("2026-10-16T09:00:00"),
+			givenLocalDateTime:   timetypes.NewLocalDateTimeValueMust("2026-10-17T09:00:00"),
+			expectedMatch:        false,
+		},
+		"semantically equal - byte for byte match": {
+			currentLocalDateTime: timetypes.NewLocalDateTimeValueMust("2026-10-16T09:00:00"),
+			givenLocalDateTime:   timetypes.NewLocalDateTimeValueMust("2026-10-16T09:00:00"),
+			expectedMatch:        true,
+		},
+		"semantically equal - omitted seconds": {
+			currentLocalDateTime: timetypes.NewLocalDateTimeValueMust("2026-10-16T09:00:00"),
+			givenLocalDateTime:   timetypes.NewLocalDateTimeValueMust("2026-10-16T09:00"),
+			expectedMatch:        true,
+		},
+		"semantically equal - fractional seconds trailing zeros": {
+			currentLocalDateTime: timetypes.NewLocalDateTimeValueMust("2026-10-16T09:00:00.500"),
+			givenLocalDateTime:   timetypes.NewLocalDateTimeValueMust("2026-10-16T09:00:00.5"),
+			expectedMatch:        true,
+		},
+		"error - not given LocalDateTime value": {
+			currentLocalDateTime: timetypes.NewLocalDateTimeValueMust("2026-10-16T09:00:00"),
+			givenLocalDateTime:   basetypes.NewStringValue("2026-10-16T09:00:00"),
+			expectedMatch:        false,
+			expectedDiags: diag.Diagnostics{
+				diag.NewErrorDiagnostic(
+					"Semantic Equality Check Error",
+					"An unexpected value type was received while performing semantic equality checks. "+
+						"Please report this to the provider developers.\n\n"+
+						"Expected Value Type: timetypes.LocalDateTime\n"+
+						"Got Value Type: basetypes.StringValue",
+				),
+			},
+		},
+	}
+	for name, testCase := range testCases {
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			match, diags := testCase.currentLocalDateTime.StringSemanticEquals(context.Background(), testCase.givenLocalDateTime)
+
+			if testCase.expectedMatch != match {
+				t.Errorf("Expected StringSemanticEquals to return: %t, but got: %t", testCase.expectedMatch, match)
+			}
+
+			if diff := cmp.Diff(diags, testCase.expectedDiags); diff != "" {
+				t.Errorf("Unexpected diagnostics (-got, +expected): %s", diff)
+			}
+		})
+	}
+}
+
+func TestLocalDateTimeValidateAttribute(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		localDateTime timetypes.LocalDateTime
+		expectedDiags diag.Diagnostics
+	}{
+		"empty-struct": {
+			localDateTime: timetypes.LocalDateTime{},
+		},
+		"null": {
+			localDateTime: timetypes.NewLocalDateTimeNull(),
+		},
+		"unknown": {
+			localDateTime: timetypes.NewLocalDateTimeUnknown(),
+		},
+		"valid local date time": {
+			localDateTime: timetypes.NewLocalDateTimeValueMust("2026-10-16T09:00:00"),
+		},
+		"valid local date time - omitted seconds": {
+			localDateTime: timetypes.NewLocalDateTimeValueMust("2026-10-16T09:00"),
+		},
+		"valid local date time - fractional seconds": {
+			localDateTime: timetypes.NewLocalDateTimeValueMust("2026-10-16T09:00:00.123456789"),
+		},
+		"invalid local date time - offset": {
+			localDateTime: timetypes.LocalDateTime{
+				StringValue: basetypes.NewStringValue("2026-10-16T09:00:00+02:00"),
+			},
+			expectedDiags: diag.Diagnostics{
+				diag.NewAttributeErrorDiagnostic(
+					path.Root("test"),
+					"Invalid Local Date Time String Value",
+					"A string value was provided that is not valid ISO 8601 local date and time string format, such as \"2026-10-16T09:00:00\".\n\n"+
+						"Given Value: 2026-10-16T09:00:00+02:00\n"+
+						"Error: unexpected UTC offset \"+02:00\", a local date and time must not include an offset",
+				),
+			},
+		},
+		"invalid local date time - Z suffix": {
+			localDateTime: timetypes.LocalDateTime{
+				StringValue: basetypes.NewStringValue("2026-10-16T09:00:00Z"),
+			},
+			expectedDiags: diag.Diagnostics{
+				diag.NewAttributeErrorDiagnostic(
+					path.Root("test"),
+					"Invalid Local Date Time String Value",
+					"A string value was provided that is not valid ISO 8601 local date and time string format, such as \"2026-10-16T09:00:00\".\n\n"+
+						"Given Value: 2026-10-16T09:00:00Z\n"+
+						"Error: unexpected UTC offset \"Z\", a local date and time must not include an offset",
+				),
+			},
+		},
+		"invalid local date time - day out of range for month": {
+			localDateTime: timetypes.LocalDateTime{
+				StringValue: basetypes.NewStringValue("2026-09-31T09:00:00"),
+			},
+			expectedDiags: diag.Diagnostics{
+				diag.NewAttributeErrorDiagnostic(
+					path.Root("test"),
+					"Invalid Local Date Time String Value",
+					"A string value was provided that is not valid ISO 8601 local date and time string format, such as \"2026-10-16T09:00:00\".\n\n"+
+						"Given Value: 2026-09-31T09:00:00\n"+
+						"Error: day 31 is out of range for 2026-09, which has 30 days",
+				),
+			},
+		},
+		"invalid local date time - date only": {
+			localDateTime: timetypes.LocalDateTime{
+				StringValue: basetypes.NewStringValue("2026-10-16"),
+			},
+			expectedDiags: diag.Diagnostics{
+				diag.NewAttributeErrorDiagnostic(
+					path.Root("test"),
+					"Invalid Local Date Time String Value",
+					"A string value was provided that is not valid ISO 8601 local date and time string format, such as \"2026-10-16T09:00:00\".\n\n"+
+						"Given Value: 2026-10-16\n"+
+						"Error: expected 'T' after day, got \"\"",
+				),
+			},
+		},
+		"invalid local date time - trailing text": {
+			localDateTime: timetypes.LocalDateTime{
+				StringValue: basetypes.NewStringValue("2026-10-16T09:00:00 Europe/Berlin"),
+			},
+			expectedDiags: diag.Diagnostics{
+				diag.NewAttributeErrorDiagnostic(
+					path.Root("test"),
+					"Invalid Local Date Time String Value",
+					"A string value was provided that is not valid ISO 8601 local date and time string format, such as \"2026-10-16T09:00:00\".\n\n"+
+						"Given Value: 2026-10-16T09:00:00 Europe/Berlin\n"+
+						"Error: unexpected text after time: \" Europe/Berlin\"",
+				),
+			},
+		},
+	}
+	for name, testCase := range testCases {
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			resp := xattr.ValidateAttributeResponse{}
+
+			testCase.localDateTime.ValidateAttribute(
+				context.Background(),
+				xattr.ValidateAttributeRequest{
+					Path: path.Root("test"),
+				},
+				&resp,
+			)
+
+			if diff := cmp.Diff(resp.Diagnostics, testCase.expectedDiags); diff != "" {
+				t.Errorf("Unexpected diagnostics (-got, +expected): %s", diff)
+			}
+		})
+	}
+}
+
+func TestLocalDateTimeValidateParameter(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		localDateTime   timetypes.LocalDateTime
+		expectedFuncErr *function.FuncError
+	}{
+		"empty-struct": {
+			localDateTime: timetypes.LocalDateTime{},
+		},
+		"null": {
+			localDateTime: timetypes.NewLocalDateTimeNull(),
+		},
+		"unknown": {
+			localDateTime: timetypes.NewLocalDateTimeUnknown(),
+		},
+		"valid local date time": {
+			localDateTime: timetypes.NewLocalDateTimeValueMust("2026-10-16T09:00:00"),
+		},
+		"invalid local date time": {
+			localDateTime: timetypes.LocalDateTime{
+				StringValue: basetypes.NewStringValue("2026-10-16 09:00:00"),
+			},
+			expectedFuncErr: function.NewArgumentFuncError(
+				0,
+				"Invalid Local Date Time String Value: "+
+					"A string value was provided that is not valid ISO 8601 local date and time string format, such as \"2026-10-16T09:00:00\".\n\n"+
+					"Given Value: 2026-10-16 09:00:00\n"+
+					"Error: expected 'T' after day, got \" 09:00:00\"",
+			),
+		},
+	}
+	for name, testCase := range testCases {
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			resp := function.ValidateParameterResponse{}
+
+			testCase.localDateTime.ValidateParameter(
+				context.Background(),
+				function.ValidateParameterRequest{
+					Position: int64(0),
+				},
+				&resp,
+			)
+
+			if diff := cmp.Diff(resp.Error, testCase.expectedFuncErr); diff != "" {
+				t.Errorf("Unexpected diagnostics (-got, +expected): %s", diff)
+			}
+		})
+	}
+}
+
+func TestLocalDateTime_ValueInstant(t *testing.T) {
+	t.Parallel()
+
+	berlin, err := time.LoadLocation("Europe/Berlin")
+	if err != nil {
+		t.Fatalf("Unexpected error loading location: %s", err)
+	}
+
+	newYork, err := time.LoadLocation("America/New_York")
+	if err != nil {
+		t.Fatalf("Unexpected error loading location: %s", err)
+	}
+
+	testCases := map[string]struct {
+		localDateTime timetypes.LocalDateTime
+		location      *time.Location
+		expectedTime  time.Time
+		expectedDiags diag.Diagnostics
+	}{
+		"local date time string value is null": {
+			localDateTime: timetypes.NewLocalDateTimeNull(),
+			expectedDiags: diag.Diagnostics{
+				diag.NewErrorDiagnostic("LocalDateTime ValueInstant Error", "Local date time string value is null"),
+			},
+		},
+		"local date time string value is unknown": {
+			localDateTime: timetypes.NewLocalDateTimeUnknown(),
+			expectedDiags: diag.Diagnostics{
+				diag.NewErrorDiagnostic("LocalDateTime ValueInstant Error", "Local date time string value is unknown"),
+			},
+		},
+		"nil location": {
+			localDateTime: timetypes.NewLocalDateTimeValueMust("2026-10-16T09:00:00.5"),
+			expectedTime:  time.Date(2026, time.October, 16, 9, 0, 0, 500000000, time.UTC),
+		},
+		"location": {
+			localDateTime: timetypes.NewLocalDateTimeValueMust("2026-10-16T09:00"),
+			location:      berlin,
+			expectedTime:  time.Date(2026, time.October, 16, 7, 0, 0, 0, time.UTC),
+		},
+		"skipped wall clock - Europe/Berlin": {
+			localDateTime: timetypes.NewLocalDateTimeValueMust("2026-03-29T02:30:00"),
+			location:      berlin,
+			expectedTime:  time.Date(2026, time.March, 29, 1, 30, 0, 0, time.UTC),
+		},
+		"skipped wall clock - America/New_York": {
+			localDateTime: timetypes.NewLocalDateTimeValueMust("2026-03-08T02:30:00"),
+			location:      newYork,
+			expectedTime:  time.Date(2026, time.March, 8, 7, 30, 0, 0, time.UTC),
+		},
+		"repeated wall clock - Europe/Berlin": {
+			localDateTime: timetypes.NewLocalDateTimeValueMust("2026-10-25T02:30:00"),
+			location:      berlin,
+			expectedTime:  time.Date(2026, time.October, 25, 0, 30, 0, 0, time.UTC),
+		},
+		"repeated wall clock - America/New_York": {
+			localDateTime: timetypes.NewLocalDateTimeValueMust("2026-11-01T01:30:00"),
+			location:      newYork,
+			expectedTime:  time.Date(2026, time.November, 1, 5, 30, 0, 0, time.UTC),
+		},
+	}
+	for name, testCase := range testCases {
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got, diags := testCase.localDateTime.ValueInstant(testCase.location)
+
+			if !got.Equal(testCase.expectedTime) {
+				t.Errorf("Unexpected difference in time.Time, got: %s, expected: %s", got, testCase.expectedTime)
+			}
+
+			if testCase.location != nil && got.Location() != testCase.location {
+				t.Errorf("Unexpected location, got: %s, expected: %s", got.Location(), testCase.location)
+			}
+
+			if diff := cmp.Diff(diags, testCase.expectedDiags); diff != "" {
+				t.Errorf("Unexpected diagnostics (-got, +expected): %s", diff)
+			}
+		})
+	}
+}
+
+func TestLocalDateTime_ToRFC3339Value(t *testing.T) {
+	t.Parallel()
+
+	berlin, err := time.LoadLocation("Europe/Berlin")
+	if err != nil {
+		t.Fatalf("Unexpected error loading location: %s", err)
+	}
+
+	testCases := map[string]struct {
+		localDateTime timetypes.LocalDateTime
+		location      *time.Location
+		expected      timetypes.RFC3339
+		expectedDiags diag.Diagnostics
+	}{
+		"null": {
+			localDateTime: timetypes.NewLocalDateTimeNull(),
+			expected:      timetypes.NewRFC3339Null(),
+		},
+		"unknown": {
+			localDateTime: timetypes.NewLocalDateTimeUnknown(),
+			expected:      timetypes.NewRFC3339Unknown(),
+		},
+		"nil location": {
+			localDateTime: timetypes.NewLocalDateTimeValueMust("2026-10-16T09:00:00"),
+			expected:      timetypes.NewRFC3339ValueMust("2026-10-16T09:00:00Z"),
+		},
+		"location": {
+			localDateTime: timetypes.NewLocalDateTimeValueMust("2026-10-16T09:00:00"),
+			location:      berlin,
+			expected:      timetypes.NewRFC3339ValueMust("2026-10-16T09:00:00+02:00"),
+		},
+		"fractional seconds": {
+			localDateTime: timetypes.NewLocalDateTimeValueMust("2026-10-16T09:00:00.5"),
+			location:      berlin,
+			expected:      timetypes.NewRFC3339ValueMust("2026-10-16T09:00:00.5+02:00"),
+		},
+	}
+	for name, testCase := range testCases {
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got, diags := testCase.localDateTime.ToRFC3339Value(testCase.location)
+
+			if !got.Equal(testCase.expected) {
+				t.Errorf("Expected %s, got %s", testCase.expected, got)
+			}
+
+			if diff := cmp.Diff(diags, testCase.expectedDiags); diff != "" {
+				t.Errorf("Unexpected diagnostics (-got, +expected): %s", diff)
+			}
+		})
+	}
+}
+
+func TestNewLocalDateTimeTimeValue(t *testing.T) {
+	t.Parallel()
+
+	got := timetypes.NewLocalDateTimeTimeValue(time.Date(2026, time.October, 16, 9, 0, 0, 0, time.FixedZone("", -4*60*60)))
+	expected := timetypes.NewLocalDateTimeValueMust("2026-10-16T09:00:00")
+
+	if !got.Equal(expected) {
+		t.Errorf("Expected %s, got %s", expected, got)
+	}
+}
@@ -0,0 +1,306 @@
+// Copyright IBM Corp. 2023, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package timetypes
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/attr/xattr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+)
+
+var (
+	_ basetypes.StringValuable       = (*OrdinalDate)(nil)
+	_ xattr.ValidateableAttribute    = (*OrdinalDate)(nil)
+	_ function.ValidateableParameter = (*OrdinalDate)(nil)
+)
+
+// OrdinalDate represents a valid ISO 8601 ordinal date string, such as `2026-289`, which is a four digit year and a
+// three digit day of the year from 001 to 365, or 366 in leap years.
+//
+// See https://en.wikipedia.org/wiki/ISO_8601#Ordinal_dates for more details on the string format.
+type OrdinalDate struct {
+	basetypes.StringValue
+}
+
+// Type returns an OrdinalDateType.
+func (v OrdinalDate) Type(_ context.Context) attr.Type {
+	return OrdinalDateType{}
+}
+
+// Equal returns true if the given value is equivalent.
+func (v OrdinalDate) Equal(o attr.Value) bool {
+	other, ok := o.(OrdinalDate)
+
+	if !ok {
+		return false
+	}
+
+	return v.StringValue.Equal(other.StringValue)
+}
+
+// ValidateAttribute implements attribute value validation. This type requires the value to be a String value that
+// is a valid ISO 8601 ordinal date, including a valid day for the given year.
+func (v OrdinalDate) ValidateAttribute(ctx context.Context, req xattr.ValidateAttributeRequest, resp *xattr.ValidateAttributeResponse) {
+	if v.IsUnknown() || v.IsNull() {
+		return
+	}
+
+	if _, err := parseOrdinalDate(v.ValueString(), time.UTC); err != nil {
+		resp.Diagnostics.Append(diag.WithPath(req.Path, ordinalDateInvalidStringDiagnostic(v.ValueString(), err)))
+
+		return
+	}
+}
+
+// ValidateParameter implements provider-defined function parameter value validation. This type requires the value to
+// be a String value that is a valid ISO 8601 ordinal date, including a valid day for the given year.
+func (v OrdinalDate) ValidateParameter(ctx context.Context, req function.ValidateParameterRequest, resp *function.ValidateParameterResponse) {
+	if v.IsUnknown() || v.IsNull() {
+		return
+	}
+
+	if _, err := parseOrdinalDate(v.ValueString(), time.UTC); err != nil {
+		resp.Error = function.NewArgumentFuncError(
+			req.Position,
+			"Invalid Ordinal Date String Value: "+
+				"A string value was provided that is not valid ISO 8601 ordinal date string format, such as \"2026-289\".\n\n"+
+				"Given Value: "+v.ValueString()+"\n"+
+				"Error: "+err.Error(),
+		)
+
+		return
+	}
+}
+
+// ValueDate creates a new time.Time instance with the OrdinalDate StringValue at midnight in the given location. A nil
+// location is treated as UTC. A null or unknown value will produce an error diagnostic.
+func (v OrdinalDate) ValueDate(loc *time.Location) (time.Time, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	if v.IsNull() {
+		diags.Append(diag.NewErrorDiagnostic("OrdinalDate ValueDate Error", "Ordinal date string value is null"))
+		return time.Time{}, diags
+	}
+
+	if v.IsUnknown() {
+		diags.Append(diag.NewErrorDiagnostic("OrdinalDate ValueDate Error", "Ordinal date string value is unknown"))
+		return time.Time{}, diags
+	}
+
+	if loc == nil {
+		loc = time.UTC
+	}
+
+	date, err := parseOrdinalDate(v.ValueString(), loc)
+	if err != nil {
+		diags.Append(diag.NewErrorDiagnostic("OrdinalDate ValueDate Error", err.Error()))
+		return time.Time{}, diags
+	}
+
+	return date, nil
+}
+
+// ToDateValue converts the OrdinalDate to a Date value, such as `2026-10-16` for `2026-289`. Null and unknown values
+// are converted to null and unknown Date values respectively.
+func (v OrdinalDate) ToDateValue() (Date, diag.Diagnostics) {
+	if v.IsNull() {
+		return NewDateNull(), nil
+	}
+
+	if v.IsUnknown() {
+		return NewDateUnknown(), nil
+	}
+
+	date, diags := v.ValueDate(time.UTC)
+	if diags.HasError() {
+		return NewDateUnknown(), diags
+	}
+
+	return NewDateTimeValue(date), nil
+}
+
+// ToRFC3339Value converts the OrdinalDate to an RFC3339 value at midnight in the given location, such as
+// `2026-10-16T00:00:00+02:00` for `2026-289` in Europe/Berlin. A nil location is treated as UTC. Null and unknown
+// values are converted to null and unknown RFC3339 values respectively.
+func (v OrdinalDate) ToRFC3339Value(loc *time.Location) (RFC3339, diag.Diagnostics) {
+	if v.IsNull() {
+		return NewRFC3339Null(), nil
+	}
+
+	if v.IsUnknown() {
+		return NewRFC3339Unknown(), nil
+	}
+
+	date, diags := v.ValueDate(loc)
+	if diags.HasError() {
+		return NewRFC3339Unknown(), diags
+	}
+
+	return newRFC3339TimeNanoValue(date), nil
+}
+
+// NewOrdinalDateNull creates an OrdinalDate with a null value. Determine whether the value is null via IsNull method.
+func NewOrdinalDateNull() OrdinalDate {
+	return OrdinalDate{
+		StringValue: basetypes.NewStringNull(),
+	}
+}
+
+// NewOrdinalDateUnknown creates an OrdinalDate with an unknown value. Determine whether the value is unknown via IsUnknown method.
+func NewOrdinalDateUnknown() OrdinalDate {
+	return OrdinalDate{
+		StringValue: basetypes.NewStringUnknown(),
+	}
+}
+
+// NewOrdinalDateTimeValue creates an OrdinalDate with a known value. The date is taken from the time.Time in its own
+// location and any time of day is discarded.
+func NewOrdinalDateTimeValue(value time.Time) OrdinalDate {
+	return OrdinalDate{
+		StringValue: basetypes.NewStringValue(fmt.Sprintf("%04d-%03d", value.Year(), value.YearDay())),
+	}
+}
+
+// NewOrdinalDateTimePointerValue creates an OrdinalDate with a null value if nil or
+// a known value. The date is taken from the time.Time in its own location and
+// any time of day is discarded.
+func NewOrdinalDateTimePointerValue(value *time.Time) OrdinalDate {
+	if value == nil {
+		return NewOrdinalDateNull()
+	}
+
+	return NewOrdinalDateTimeValue(*value)
+}
+
+// NewOrdinalDateDateValue creates an OrdinalDate from a Date, such as `2026-289` for `2026-10-16`, or raises an error
+// diagnostic if the Date is invalid. Null and unknown Date values are converted to null and unknown OrdinalDate values
+// respectively.
+func NewOrdinalDateDateValue(value Date) (OrdinalDate, diag.Diagnostics) {
+	if value.IsNull() {
+		return NewOrdinalDateNull(), nil
+	}
+
+	if value.IsUnknown() {
+		return NewOrdinalDateUnknown(), nil
+	}
+
+	date, diags := value.ValueDate(time.UTC)
+	if diags.HasError() {
+		return NewOrdinalDateUnknown(), diags
+	}
+
+	return NewOrdinalDateTimeValue(date), nil
+}
+
+// NewOrdinalDateRFC3339Value creates an OrdinalDate from the date of an RFC3339 in its own offset, such as `2026-289`
+// for `2026-10-16T23:30:00-04:00`, or raises an error diagnostic if the RFC3339 is invalid. Null and unknown RFC3339
+// values are converted to null and unknown OrdinalDate values respectively.
+func NewOrdinalDateRFC3339Value(value RFC3339) (OrdinalDate, diag.Diagnostics) {
+	if value.IsNull() {
+		return NewOrdinalDateNull(), nil
+	}
+
+	if value.IsUnknown() {
+		return NewOrdinalDateUnknown(), nil
+	}
+
+	t, diags := value.ValueRFC3339Time()
+	if diags.HasError() {
+		return NewOrdinalDateUnknown(), diags
+	}
+
+	return NewOrdinalDateTimeValue(t), nil
+}
+
+// NewOrdinalDateValue creates an OrdinalDate with a known value or raises an error
+// diagnostic if the string is not ISO 8601 ordinal date format.
+func NewOrdinalDateValue(value string) (OrdinalDate, diag.Diagnostics) {
+	_, err := parseOrdinalDate(value, time.UTC)
+
+	if err != nil {
+		// Returning an unknown value will guarantee that, as a last resort,
+		// Terraform will return an error if attempting to store into state.
+		return NewOrdinalDateUnknown(), diag.Diagnostics{ordinalDateInvalidStringDiagnostic(value, err)}
+	}
+
+	return OrdinalDate{
+		StringValue: basetypes.NewStringValue(value),
+	}, nil
+}
+
+// NewOrdinalDateValueMust creates an OrdinalDate with a known value or raises a panic
+// if the string is not ISO 8601 ordinal date format.
+//
+// This creation function is only recommended to create OrdinalDate values which
+// either will not potentially affect practitioners, such as testing, or within
+// exhaustively tested provider logic.
+func NewOrdinalDateValueMust(value string) OrdinalDate {
+	_, err := parseOrdinalDate(value, time.UTC)
+
+	if err != nil {
+		panic(fmt.Sprintf("Invalid Ordinal Date String Value (%s): %s", value, err))
+	}
+
+	return OrdinalDate{
+		StringValue: basetypes.NewStringValue(value),
+	}
+}
+
+// NewOrdinalDatePointerValue creates an OrdinalDate with a null value if nil, a known
+// value, or raises an error diagnostic if the string is not ISO 8601 ordinal date format.
+func NewOrdinalDatePointerValue(value *string) (OrdinalDate, diag.Diagnostics) {
+	if value == nil {
+		return NewOrdinalDateNull(), nil
+	}
+
+	return NewOrdinalDateValue(*value)
+}
+
+// NewOrdinalDatePointerValueMust creates an OrdinalDate with a null value if nil, a
+// known value, or raises a panic if the string is not ISO 8601 ordinal date format.
+//
+// This creation function is only recommended to create OrdinalDate values which
+// either will not potentially affect practitioners, such as testing, or within
+// exhaustively tested provider logic.
+func NewOrdinalDatePointerValueMust(value *string) OrdinalDate {
+	if value == nil {
+		return NewOrdinalDateNull()
+	}
+
+	return NewOrdinalDateValueMust(*value)
+}
+
+// parseOrdinalDate parses an ISO 8601 ordinal date in the extended format, such as `2026-289`, returning the date at
+// midnight in the given location.
+func parseOrdinalDate(value string, loc *time.Location) (time.Time, error) {
+	year, rest, err := parseFixedDigits(value, 4, "year", 0, 9999)
+	if err != nil {
+		return time.Time{}, err
+	}
+
+	if rest, err = parseSeparator(rest, '-', "year"); err != nil {
+		return time.Time{}, err
+	}
+
+	day, rest, err := parseFixedDigits(rest, 3, "day of year", 1, 366)
+	if err != nil {
+		return time.Time{}, err
+	}
+
+	if rest != "" {
+		return time.Time{}, fmt.Errorf("unexpected text after day of year: %q", rest)
+	}
+
+	if days := time.Date(year, time.December, 31, 0, 0, 0, 0, time.UTC).YearDay(); day > days {
+		return time.Time{}, fmt.Errorf("day of year %d is out of range for %d, which has %d days", day, year, days)
+	}
+
+	return time.Date(year, time.January, day, 0, 0, 0, 0, loc), nil
+}
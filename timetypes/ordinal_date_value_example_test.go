@@ -0,0 +1,33 @@
+// Copyright IBM Corp. 2023, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package timetypes_test
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework-timetypes/timetypes"
+)
+
+type ObservationDataSourceModel struct {
+	ObservedOn timetypes.OrdinalDate `tfsdk:"observed_on"`
+}
+
+func ExampleOrdinalDate_ToDateValue() {
+	// For example purposes, typically the data model would be populated automatically by Plugin Framework via Config, Plan or State.
+	// https://developer.hashicorp.com/terraform/plugin/framework/handling-data/accessing-values
+	data := ObservationDataSourceModel{
+		ObservedOn: timetypes.NewOrdinalDateValueMust("2026-289"),
+	}
+
+	// Check that the ordinal date data is known and able to be converted to a Date
+	if !data.ObservedOn.IsNull() && !data.ObservedOn.IsUnknown() {
+		date, diags := data.ObservedOn.ToDateValue()
+		if diags.HasError() {
+			return
+		}
+
+		// Output: 2026-10-16
+		fmt.Println(date.ValueString())
+	}
+}
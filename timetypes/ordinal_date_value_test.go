@@ -0,0 +1,346 @@
+// Copyright IBM Corp. 2023, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package timetypes_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-framework/attr/xattr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+
+	"github.com/hashicorp/terraform-plugin-framework-timetypes/timetypes"
+)
+
+func TestOrdinalDateValidateAttribute(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		ordinalDate   timetypes.OrdinalDate
+		expectedDiags diag.Diagnostics
+	}{
+		"empty-struct": {
+			ordinalDate: timetypes.OrdinalDate{},
+		},
+		"null": {
+			ordinalDate: timetypes.NewOrdinalDateNull(),
+		},
+		"unknown": {
+			ordinalDate: timetypes.NewOrdinalDateUnknown(),
+		},
+		"valid ordinal date": {
+			ordinalDate: timetypes.NewOrdinalDateValueMust("2026-289"),
+		},
+		"valid ordinal date - leap year": {
+			ordinalDate: timetypes.NewOrdinalDateValueMust("2028-366"),
+		},
+		"invalid ordinal date - day 366 in common year": {
+			ordinalDate: timetypes.OrdinalDate{
+				StringValue: basetypes.NewStringValue("2026-366"),
+			},
+			expectedDiags: diag.Diagnostics{
+				diag.NewAttributeErrorDiagnostic(
+					path.Root("test"),
+					"Invalid Ordinal Date String Value",
+					"A string value was provided that is not valid ISO 8601 ordinal date string format, such as \"2026-289\".\n\n"+
+						"Given Value: 2026-366\n"+
+						"Error: day of year 366 is out of range for 2026, which has 365 days",
+				),
+			},
+		},
+		"invalid ordinal date - day 0": {
+			ordinalDate: timetypes.OrdinalDate{
+				StringValue: basetypes.NewStringValue("2026-000"),
+			},
+			expectedDiags: diag.Diagnostics{
+				diag.NewAttributeErrorDiagnostic(
+					path.Root("test"),
+					"Invalid Ordinal Date String Value",
+					"A string value was provided that is not valid ISO 8601 ordinal date string format, such as \"2026-289\".\n\n"+
+						"Given Value: 2026-000\n"+
+						"Error: day of year \"000\" is out of range [1, 366]",
+				),
+			},
+		},
+		"invalid ordinal date - two digit day": {
+			ordinalDate: timetypes.OrdinalDate{
+				StringValue: basetypes.NewStringValue("2026-10-16"),
+			},
+			expectedDiags: diag.Diagnostics{
+				diag.NewAttributeErrorDiagnostic(
+					path.Root("test"),
+					"Invalid Ordinal Date String Value",
+					"A string value was provided that is not valid ISO 8601 ordinal date string format, such as \"2026-289\".\n\n"+
+						"Given Value: 2026-10-16\n"+
+						"Error: expected 3 digit day of year, got \"10-\"",
+				),
+			},
+		},
+		"invalid ordinal date - trailing text": {
+			ordinalDate: timetypes.OrdinalDate{
+				StringValue: basetypes.NewStringValue("2026-2890"),
+			},
+			expectedDiags: diag.Diagnostics{
+				diag.NewAttributeErrorDiagnostic(
+					path.Root("test"),
+					"Invalid Ordinal Date String Value",
+					"A string value was provided that is not valid ISO 8601 ordinal date string format, such as \"2026-289\".\n\n"+
+						"Given Value: 2026-2890\n"+
+						"Error: unexpected text after day of year: \"0\"",
+				),
+			},
+		},
+	}
+	for name, testCase := range testCases {
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			resp := xattr.ValidateAttributeResponse{}
+
+			testCase.ordinalDate.ValidateAttribute(
+				context.Background(),
+				xattr.ValidateAttributeRequest{
+					Path: path.Root("test"),
+				},
+				&resp,
+			)
+
+			if diff := cmp.Diff(resp.Diagnostics, testCase.expectedDiags); diff != "" {
+				t.Errorf("Unexpected diagnostics (-got, +expected): %s", diff)
+			}
+		})
+	}
+}
+
+func TestOrdinalDateValidateParameter(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		ordinalDate     timetypes.OrdinalDate
+		expectedFuncErr *function.FuncError
+	}{
+		"empty-struct": {
+			ordinalDate: timetypes.OrdinalDate{},
+		},
+		"null": {
+			ordinalDate: timetypes.NewOrdinalDateNull(),
+		},
+		"unknown": {
+			ordinalDate: timetypes.NewOrdinalDateUnknown(),
+		},
+		"valid ordinal date": {
+			ordinalDate: timetypes.NewOrdinalDateValueMust("2026-289"),
+		},
+		"invalid ordinal date": {
+			ordinalDate: timetypes.OrdinalDate{
+				StringValue: basetypes.NewStringValue("2026289"),
+			},
+			expectedFuncErr: function.NewArgumentFuncError(
+				0,
+				"Invalid Ordinal Date String Value: "+
+					"A string value was provided that is not valid ISO 8601 ordinal date string format, such as \"2026-289\".\n\n"+
+					"Given Value: 2026289\n"+
+					"Error: expected '-' after year, got \"289\"",
+			),
+		},
+	}
+	for name, testCase := range testCases {
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			resp := function.ValidateParameterResponse{}
+
+			testCase.ordinalDate.ValidateParameter(
+				context.Background(),
+				function.ValidateParameterRequest{
+					Position: int64(0),
+				},
+				&resp,
+			)
+
+			if diff := cmp.Diff(resp.Error, testCase.expectedFuncErr); diff != "" {
+				t.Errorf("Unexpected diagnostics (-got, +expected): %s", diff)
+			}
+		})
+	}
+}
+
+func TestOrdinalDate_ValueDate(t *testing.T) {
+	t.Parallel()
+
+	berlin, err := time.LoadLocation("Europe/Berlin")
+	if err != nil {
+		t.Fatalf("Unexpected error loading location: %s", err)
+	}
+
+	testCases := map[string]struct {
+		ordinalDate   timetypes.OrdinalDate
+		location      *time.Location
+		expectedTime  time.Time
+		expectedDiags diag.Diagnostics
+	}{
+		"ordinal date string value is null": {
+			ordinalDate: timetypes.NewOrdinalDateNull(),
+			expectedDiags: diag.Diagnostics{
+				diag.NewErrorDiagnostic("OrdinalDate ValueDate Error", "Ordinal date string value is null"),
+			},
+		},
+		"ordinal date string value is unknown": {
+			ordinalDate: timetypes.NewOrdinalDateUnknown(),
+			expectedDiags: diag.Diagnostics{
+				diag.NewErrorDiagnostic("OrdinalDate ValueDate Error", "Ordinal date string value is unknown"),
+			},
+		},
+		"valid ordinal date - nil location": {
+			ordinalDate:  timetypes.NewOrdinalDateValueMust("2026-289"),
+			expectedTime: time.Date(2026, time.October, 16, 0, 0, 0, 0, time.UTC),
+		},
+		"valid ordinal date - location": {
+			ordinalDate:  timetypes.NewOrdinalDateValueMust("2028-060"),
+			location:     berlin,
+			expectedTime: time.Date(2028, time.February, 29, 0, 0, 0, 0, berlin),
+		},
+	}
+	for name, testCase := range testCases {
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got, diags := testCase.ordinalDate.ValueDate(testCase.location)
+
+			if !got.Equal(testCase.expectedTime) || got.Location() != testCase.expectedTime.Location() {
+				t.Errorf("Unexpected difference in time.Time, got: %s, expected: %s", got, testCase.expectedTime)
+			}
+
+			if diff := cmp.Diff(diags, testCase.expectedDiags); diff != "" {
+				t.Errorf("Unexpected diagnostics (-got, +expected): %s", diff)
+			}
+		})
+	}
+}
+
+func TestOrdinalDate_ToDateValue(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		ordinalDate   timetypes.OrdinalDate
+		expected      timetypes.Date
+		expectedDiags diag.Diagnostics
+	}{
+		"null": {
+			ordinalDate: timetypes.NewOrdinalDateNull(),
+			expected:    timetypes.NewDateNull(),
+		},
+		"unknown": {
+			ordinalDate: timetypes.NewOrdinalDateUnknown(),
+			expected:    timetypes.NewDateUnknown(),
+		},
+		"valid ordinal date": {
+			ordinalDate: timetypes.NewOrdinalDateValueMust("2026-289"),
+			expected:    timetypes.NewDateValueMust("2026-10-16"),
+		},
+	}
+	for name, testCase := range testCases {
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got, diags := testCase.ordinalDate.ToDateValue()
+
+			if !got.Equal(testCase.expected) {
+				t.Errorf("Expected %s, got %s", testCase.expected, got)
+			}
+
+			if diff := cmp.Diff(diags, testCase.expectedDiags); diff != "" {
+				t.Errorf("Unexpected diagnostics (-got, +expected): %s", diff)
+			}
+		})
+	}
+}
+
+func TestOrdinalDate_ToRFC3339Value(t *testing.T) {
+	t.Parallel()
+
+	berlin, err := time.LoadLocation("Europe/Berlin")
+	if err != nil {
+		t.Fatalf("Unexpected error loading location: %s", err)
+	}
+
+	got, diags := timetypes.NewOrdinalDateValueMust("2026-289").ToRFC3339Value(berlin)
+	if diags.HasError() {
+		t.Fatalf("Unexpected diagnostics: %v", diags)
+	}
+
+	if expected := timetypes.NewRFC3339ValueMust("2026-10-16T00:00:00+02:00"); !got.Equal(expected) {
+		t.Errorf("Expected %s, got %s", expected, got)
+	}
+}
+
+func TestNewOrdinalDateDateValue(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		date          timetypes.Date
+		expected      timetypes.OrdinalDate
+		expectedDiags diag.Diagnostics
+	}{
+		"null": {
+			date:     timetypes.NewDateNull(),
+			expected: timetypes.NewOrdinalDateNull(),
+		},
+		"unknown": {
+			date:     timetypes.NewDateUnknown(),
+			expected: timetypes.NewOrdinalDateUnknown(),
+		},
+		"valid date": {
+			date:     timetypes.NewDateValueMust("2028-12-31"),
+			expected: timetypes.NewOrdinalDateValueMust("2028-366"),
+		},
+		"invalid date": {
+			date: timetypes.Date{
+				StringValue: basetypes.NewStringValue("2026-02-30"),
+			},
+			expected: timetypes.NewOrdinalDateUnknown(),
+			expectedDiags: diag.Diagnostics{
+				diag.NewErrorDiagnostic("Date ValueDate Error", `parsing time "2026-02-30": day out of range`),
+			},
+		},
+	}
+	for name, testCase := range testCases {
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got, diags := timetypes.NewOrdinalDateDateValue(testCase.date)
+
+			if !got.Equal(testCase.expected) {
+				t.Errorf("Expected %s, got %s", testCase.expected, got)
+			}
+
+			if diff := cmp.Diff(diags, testCase.expectedDiags); diff != "" {
+				t.Errorf("Unexpected diagnostics (-got, +expected): %s", diff)
+			}
+		})
+	}
+}
+
+func TestNewOrdinalDateRFC3339Value(t *testing.T) {
+	t.Parallel()
+
+	got, diags := timetypes.NewOrdinalDateRFC3339Value(timetypes.NewRFC3339ValueMust("2026-10-16T23:30:00-04:00"))
+	if diags.HasError() {
+		t.Fatalf("Unexpected diagnostics: %v", diags)
+	}
+
+	if expected := timetypes.NewOrdinalDateValueMust("2026-289"); !got.Equal(expected) {
+		t.Errorf("Expected %s, got %s", expected, got)
+	}
+}
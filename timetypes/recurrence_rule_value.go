@@ -0,0 +1,1371 @@
+// Copyright IBM Corp. 2023, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package timetypes
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/attr/xattr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+)
+
+var (
+	_ basetypes.StringValuableWithSemanticEquals = (*RecurrenceRule)(nil)
+	_ xattr.ValidateableAttribute                = (*RecurrenceRule)(nil)
+	_ function.ValidateableParameter             = (*RecurrenceRule)(nil)
+)
+
+// recurrenceRuleSearchYears is the number of years after the previous occurrence that RecurrenceRule.ValueOccurrences
+// will search for the next occurrence, such that rules which can never match, such as
+// `FREQ=YEARLY;BYMONTH=2;BYMONTHDAY=30`, do not search forever.
+const recurrenceRuleSearchYears = 10
+
+// recurrenceRuleSearchPeriods is the maximum number of FREQ and INTERVAL periods that RecurrenceRule.ValueOccurrences
+// will search, such that rules with a short period which rarely match, such as `FREQ=SECONDLY;BYSECOND=1;BYMONTH=2`,
+// have a bounded cost even within the search years.
+const recurrenceRuleSearchPeriods = 100_000
+
+// recurrenceFrequency is an RFC 5545 recurrence rule frequency, ordered from the longest to the shortest period.
+type recurrenceFrequency int
+
+const (
+	recurrenceYearly recurrenceFrequency = iota
+	recurrenceMonthly
+	recurrenceWeekly
+	recurrenceDaily
+	recurrenceHourly
+	recurrenceMinutely
+	recurrenceSecondly
+)
+
+// recurrenceFrequencyNames are the RFC 5545 frequency names, indexed by recurrenceFrequency.
+var recurrenceFrequencyNames = []string{"YEARLY", "MONTHLY", "WEEKLY", "DAILY", "HOURLY", "MINUTELY", "SECONDLY"}
+
+// recurrenceWeekdayNames are the RFC 5545 weekday names, indexed by time.Weekday.
+var recurrenceWeekdayNames = []string{"SU", "MO", "TU", "WE", "TH", "FR", "SA"}
+
+// recurrenceRuleParts are the supported RFC 5545 recurrence rule part names, in the order they are written when
+// comparing rules for semantic equality.
+var recurrenceRuleParts = []string{
+	"FREQ", "INTERVAL", "COUNT", "UNTIL",
+	"BYSECOND", "BYMINUTE", "BYHOUR", "BYDAY", "BYMONTHDAY", "BYYEARDAY", "BYWEEKNO", "BYMONTH", "BYSETPOS",
+	"WKST",
+}
+
+// recurrenceDateTimeForm is the form of an RFC 5545 DTSTART or UNTIL value.
+type recurrenceDateTimeForm int
+
+const (
+	// recurrenceNoDateTime is used when the DTSTART or UNTIL value is not given.
+	recurrenceNoDateTime recurrenceDateTimeForm = iota
+
+	// recurrenceDate is a date without a time, such as `20261016`.
+	recurrenceDate
+
+	// recurrenceLocalDateTime is a date-time without a time zone, such as `20261016T090000`.
+	recurrenceLocalDateTime
+
+	// recurrenceUTCDateTime is a UTC date-time, such as `20261016T090000Z`.
+	recurrenceUTCDateTime
+
+	// recurrenceZonedDateTime is a date-time with a TZID parameter, such as `TZID=Europe/Berlin:20261016T090000`.
+	recurrenceZonedDateTime
+)
+
+// recurrenceWeekday is an RFC 5545 BYDAY value, such as `MO` or `-1FR`. An n of zero matches every weekday.
+type recurrenceWeekday struct {
+	n       int
+	weekday time.Weekday
+}
+
+// recurrenceRule is a parsed RFC 5545 recurrence rule and its optional DTSTART. The DTSTART and UNTIL wall clock
+// times are stored in UTC, with their form recorded separately.
+type recurrenceRule struct {
+	freq      recurrenceFrequency
+	interval  int
+	count     int
+	until     time.Time
+	untilForm recurrenceDateTimeForm
+
+	bySecond, byMinute, byHour                         []int
+	byDay                                              []recurrenceWeekday
+	byMonthDay, byYearDay, byWeekNo, byMonth, bySetPos []int
+	weekStart                                          time.Weekday
+
+	dtstart     time.Time
+	dtstartForm recurrenceDateTimeForm
+	tzid        string
+}
+
+// RecurrenceRule represents a valid RFC 5545 recurrence rule string, such as `FREQ=WEEKLY;BYDAY=MO,WE;BYHOUR=3`.
+//
+// The value may be a single rule, optionally prefixed with `RRULE:`, or a DTSTART line and an `RRULE:` line separated
+// by a newline, such as:
+//
+//	DTSTART;TZID=Europe/Berlin:20261016T090000
+//	RRULE:FREQ=WEEKLY;BYDAY=MO,WE;BYHOUR=3
+//
+// All rule parts of RFC 5545 are supported. Rule part names and values are case-insensitive and each rule part may
+// only be given once. The DTSTART must be a date-time, which may be local, UTC or have a TZID parameter with an IANA
+// time zone database name, and an UNTIL rule part must be the same form of date-time, where a DTSTART with a TZID
+// requires a UTC UNTIL. Leap seconds are not supported. A rule with a UTC DTSTART or a DTSTART with a TZID is invalid if
+// its INTERVAL can never reach a time matching its BYHOUR, BYMINUTE and BYSECOND rule parts, such as
+// `FREQ=SECONDLY;INTERVAL=2;BYSECOND=1` from an even second.
+//
+// See https://www.rfc-editor.org/rfc/rfc5545.html#section-3.3.10 for more details on the string format.
+type RecurrenceRule struct {
+	basetypes.StringValue
+}
+
+// Type returns a RecurrenceRuleType.
+func (v RecurrenceRule) Type(_ context.Context) attr.Type {
+	return RecurrenceRuleType{}
+}
+
+// Equal returns true if the given value is equivalent.
+func (v RecurrenceRule) Equal(o attr.Value) bool {
+	other, ok := o.(RecurrenceRule)
+
+	if !ok {
+		return false
+	}
+
+	return v.StringValue.Equal(other.StringValue)
+}
+
+// StringSemanticEquals returns true if the given recurrence rule string value is semantically equal to the current
+// recurrence rule string value. Both values are parsed into their DTSTART and rule parts, which are then compared
+// regardless of order and case, where list values are compared as sets and the default `INTERVAL=1` and `WKST=MO`
+// rule parts are equal to omitting them.
+//
+// Examples:
+//   - `FREQ=WEEKLY;BYDAY=MO` is semantically equal to `BYDAY=MO;FREQ=WEEKLY`
+//   - `FREQ=WEEKLY;BYDAY=MO,WE` is semantically equal to `RRULE:FREQ=WEEKLY;INTERVAL=1;BYDAY=WE,MO`
+//
+// Counterexamples:
+//   - `FREQ=DAILY;BYDAY=MO,TU,WE,TH,FR,SA,SU` matches the same days as `FREQ=DAILY` but is NOT considered to be
+//     semantically equal.
+func (v RecurrenceRule) StringSemanticEquals(_ context.Context, newValuable basetypes.StringValuable) (bool, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	newValue, ok := newValuable.(RecurrenceRule)
+	if !ok {
+		diags.AddError(
+			"Semantic Equality Check Error",
+			"An unexpected value type was received while performing semantic equality checks. "+
+				"Please report this to the provider developers.\n\n"+
+				"Expected Value Type: "+fmt.Sprintf("%T", v)+"\n"+
+				"Got Value Type: "+fmt.Sprintf("%T", newValuable),
+		)
+
+		return false, diags
+	}
+
+	// Recurrence rule strings are already validated at this point, ignoring errors
+	currentRule, _ := parseRecurrenceRule(v.ValueString())
+	newRule, _ := parseRecurrenceRule(newValue.ValueString())
+
+	return currentRule.String() == newRule.String(), diags
+}
+
+// ValidateAttribute implements attribute value validation. This type requires the value to be a String value that
+// is a valid RFC 5545 recurrence rule.
+func (v RecurrenceRule) ValidateAttribute(ctx context.Context, req xattr.ValidateAttributeRequest, resp *xattr.ValidateAttributeResponse) {
+	if v.IsUnknown() || v.IsNull() {
+		return
+	}
+
+	if _, err := parseRecurrenceRule(v.ValueString()); err != nil {
+		resp.Diagnostics.Append(diag.WithPath(req.Path, recurrenceRuleInvalidStringDiagnostic(v.ValueString(), err)))
+
+		return
+	}
+}
+
+// ValidateParameter implements provider-defined function parameter value validation. This type requires the value to
+// be a String value that is a valid RFC 5545 recurrence rule.
+func (v RecurrenceRule) ValidateParameter(ctx context.Context, req function.ValidateParameterRequest, resp *function.ValidateParameterResponse) {
+	if v.IsUnknown() || v.IsNull() {
+		return
+	}
+
+	if _, err := parseRecurrenceRule(v.ValueString()); err != nil {
+		resp.Error = function.NewArgumentFuncError(
+			req.Position,
+			"Invalid Recurrence Rule String Value: "+
+				"A string value was provided that is not a valid RFC 5545 recurrence rule, such as \"FREQ=WEEKLY;BYDAY=MO,WE;BYHOUR=3\".\n\n"+
+				"Given Value: "+v.ValueString()+"\n"+
+				"Error: "+err.Error(),
+		)
+
+		return
+	}
+}
+
+// ValueDTStart creates a new time.Time instance with the DTSTART of the RecurrenceRule. A local DTSTART is in the
+// given location, or UTC if nil, while a UTC DTSTART or a DTSTART with a TZID is in that location. A null or unknown
+// value, or a value without a DTSTART, will produce an error diagnostic.
+func (v RecurrenceRule) ValueDTStart(loc *time.Location) (time.Time, diag.Diagnostics) {
+	rule, diags := v.valueRecurrenceRule("ValueDTStart")
+	if diags.HasError() {
+		return time.Time{}, diags
+	}
+
+	if rule.dtstartForm == recurrenceNoDateTime {
+		diags.Append(diag.NewErrorDiagnostic("RecurrenceRule ValueDTStart Error", "Recurrence rule string value does not include a DTSTART"))
+		return time.Time{}, diags
+	}
+
+	dtstart, err := rule.dtstartIn(loc)
+	if err != nil {
+		diags.Append(diag.NewErrorDiagnostic("RecurrenceRule ValueDTStart Error", err.Error()))
+		return time.Time{}, diags
+	}
+
+	return dtstart, nil
+}
+
+// ValueOccurrences returns the first n occurrences of the RecurrenceRule starting at its DTSTART or the given dtstart.
+// When the value includes a DTSTART, the dtstart may be the zero time.Time to use it, and is otherwise required to be
+// the same instant, where a local DTSTART is in the given location, or the location of dtstart if nil. The rule is
+// expanded in the given location, or the location of dtstart if nil, such that the days and hours of the rule are
+// those of that location. As with other implementations, the dtstart itself is only an occurrence if it matches the
+// rule. Fewer than n occurrences are returned if the rule ends, if no occurrence is found within 10 years of the
+// previous occurrence, or after searching 100,000 periods of the rule's FREQ and INTERVAL. A null or unknown value, a
+// negative n, a dtstart which conflicts with the DTSTART of the value, or a zero dtstart when the value does not
+// include a DTSTART, will produce an error diagnostic.
+func (v RecurrenceRule) ValueOccurrences(dtstart time.Time, loc *time.Location, n int) ([]time.Time, diag.Diagnostics) {
+	rule, diags := v.valueRecurrenceRule("ValueOccurrences")
+	if diags.HasError() {
+		return nil, diags
+	}
+
+	if n < 0 {
+		diags.Append(diag.NewErrorDiagnostic("RecurrenceRule ValueOccurrences Error", fmt.Sprintf("number of occurrences %d must not be negative", n)))
+		return nil, diags
+	}
+
+	switch {
+	case rule.dtstartForm != recurrenceNoDateTime:
+		dtstartLoc := loc
+		if dtstartLoc == nil && !dtstart.IsZero() {
+			dtstartLoc = dtstart.Location()
+		}
+
+		ruleDTStart, err := rule.dtstartIn(dtstartLoc)
+		if err != nil {
+			diags.Append(diag.NewErrorDiagnostic("RecurrenceRule ValueOccurrences Error", err.Error()))
+			return nil, diags
+		}
+
+		if dtstart.IsZero() {
+			dtstart = ruleDTStart
+		} else if !dtstart.Equal(ruleDTStart) {
+			diags.Append(diag.NewErrorDiagnostic(
+				"RecurrenceRule ValueOccurrences Error",
+				fmt.Sprintf("dtstart %s conflicts with the recurrence rule DTSTART %s", dtstart.Format(time.RFC3339), ruleDTStart.Format(time.RFC3339)),
+			))
+			return nil, diags
+		}
+	case dtstart.IsZero():
+		diags.Append(diag.NewErrorDiagnostic("RecurrenceRule ValueOccurrences Error", "dtstart is required as the recurrence rule string value does not include a DTSTART"))
+		return nil, diags
+	}
+
+	if loc == nil {
+		loc = dtstart.Location()
+	}
+
+	return rule.occurrences(dtstart.In(loc), n), nil
+}
+
+// valueRecurrenceRule returns the parsed RecurrenceRule, with error diagnostics summarized by the given accessor
+// method name.
+func (v RecurrenceRule) valueRecurrenceRule(accessor string) (recurrenceRule, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	summary := "RecurrenceRule " + accessor + " Error"
+
+	if v.IsNull() {
+		diags.Append(diag.NewErrorDiagnostic(summary, "Recurrence rule string value is null"))
+		return recurrenceRule{}, diags
+	}
+
+	if v.IsUnknown() {
+		diags.Append(diag.NewErrorDiagnostic(summary, "Recurrence rule string value is unknown"))
+		return recurrenceRule{}, diags
+	}
+
+	rule, err := parseRecurrenceRule(v.ValueString())
+	if err != nil {
+		diags.Append(diag.NewErrorDiagnostic(summary, err.Error()))
+		return recurrenceRule{}, diags
+	}
+
+	return rule, nil
+}
+
+// NewRecurrenceRuleNull creates a RecurrenceRule with a null value. Determine whether the value is null via IsNull method.
+func NewRecurrenceRuleNull() RecurrenceRule {
+	return RecurrenceRule{
+		StringValue: basetypes.NewStringNull(),
+	}
+}
+
+// NewRecurrenceRuleUnknown creates a RecurrenceRule with an unknown value. Determine whether the value is unknown via IsUnknown method.
+func NewRecurrenceRuleUnknown() RecurrenceRule {
+	return RecurrenceRule{
+		StringValue: basetypes.NewStringUnknown(),
+	}
+}
+
+// NewRecurrenceRuleValue creates a RecurrenceRule with a known value or raises
+// an error diagnostic if the string is not a valid RFC 5545 recurrence rule.
+func NewRecurrenceRuleValue(value string) (RecurrenceRule, diag.Diagnostics) {
+	_, err := parseRecurrenceRule(value)
+
+	if err != nil {
+		// Returning an unknown value will guarantee that, as a last resort,
+		// Terraform will return an error if attempting to store into state.
+		return NewRecurrenceRuleUnknown(), diag.Diagnostics{recurrenceRuleInvalidStringDiagnostic(value, err)}
+	}
+
+	return RecurrenceRule{
+		StringValue: basetypes.NewStringValue(value),
+	}, nil
+}
+
+// NewRecurrenceRuleValueMust creates a RecurrenceRule with a known value or
+// raises a panic if the string is not a valid RFC 5545 recurrence rule.
+//
+// This creation function is only recommended to create RecurrenceRule values which
+// either will not potentially affect practitioners, such as testing, or within
+// exhaustively tested provider logic.
+func NewRecurrenceRuleValueMust(value string) RecurrenceRule {
+	_, err := parseRecurrenceRule(value)
+
+	if err != nil {
+		panic(fmt.Sprintf("Invalid Recurrence Rule String Value (%s): %s", value, err))
+	}
+
+	return RecurrenceRule{
+		StringValue: basetypes.NewStringValue(value),
+	}
+}
+
+// NewRecurrenceRulePointerValue creates a RecurrenceRule with a null value if
+// nil, a known value, or raises an error diagnostic if the string is not a
+// valid RFC 5545 recurrence rule.
+func NewRecurrenceRulePointerValue(value *string) (RecurrenceRule, diag.Diagnostics) {
+	if value == nil {
+		return NewRecurrenceRuleNull(), nil
+	}
+
+	return NewRecurrenceRuleValue(*value)
+}
+
+// NewRecurrenceRulePointerValueMust creates a RecurrenceRule with a null value
+// if nil, a known value, or raises a panic if the string is not a valid RFC
+// 5545 recurrence rule.
+//
+// This creation function is only recommended to create RecurrenceRule values which
+// either will not potentially affect practitioners, such as testing, or within
+// exhaustively tested provider logic.
+func NewRecurrenceRulePointerValueMust(value *string) RecurrenceRule {
+	if value == nil {
+		return NewRecurrenceRuleNull()
+	}
+
+	return NewRecurrenceRuleValueMust(*value)
+}
+
+// parseRecurrenceRule parses an RFC 5545 recurrence rule string, with an optional DTSTART line, into a recurrenceRule.
+func parseRecurrenceRule(value string) (recurrenceRule, error) {
+	rule := recurrenceRule{
+		interval:  1,
+		weekStart: time.Monday,
+	}
+
+	lines := strings.Split(strings.TrimRight(value, "\r\n"), "\n")
+
+	var ruleValue string
+	var hasRule bool
+
+	for _, line := range lines {
+		line = strings.TrimSuffix(line, "\r")
+		upperLine := strings.ToUpper(line)
+
+		switch {
+		case strings.HasPrefix(upperLine, "DTSTART"):
+			if rule.dtstartForm != recurrenceNoDateTime {
+				return recurrenceRule{}, errors.New("DTSTART must not be repeated")
+			}
+
+			if err := rule.parseDTStart(line[len("DTSTART"):]); err != nil {
+				return recurrenceRule{}, fmt.Errorf("invalid DTSTART %q: %w", line, err)
+			}
+		case strings.HasPrefix(upperLine, "RRULE:"):
+			if hasRule {
+				return recurrenceRule{}, errors.New("RRULE must not be repeated")
+			}
+
+			ruleValue, hasRule = line[len("RRULE:"):], true
+		case len(lines) == 1:
+			ruleValue, hasRule = line, true
+		default:
+			return recurrenceRule{}, fmt.Errorf("expected a DTSTART or RRULE line, got %q", line)
+		}
+	}
+
+	if !hasRule {
+		return recurrenceRule{}, errors.New("expected an RRULE line")
+	}
+
+	seen := make(map[string]bool)
+
+	for _, part := range strings.Split(ruleValue, ";") {
+		name, partValue, ok := strings.Cut(part, "=")
+		if !ok {
+			return recurrenceRule{}, fmt.Errorf("expected a NAME=VALUE rule part, got %q", part)
+		}
+
+		name = strings.ToUpper(name)
+
+		if !slices.Contains(recurrenceRuleParts, name) {
+			return recurrenceRule{}, fmt.Errorf("unknown rule part %q", name)
+		}
+
+		if seen[name] {
+			return recurrenceRule{}, fmt.Errorf("rule part %s must not be repeated", name)
+		}
+
+		seen[name] = true
+
+		if err := rule.parsePart(name, strings.ToUpper(partValue)); err != nil {
+			return recurrenceRule{}, fmt.Errorf("invalid %s rule part %q: %w", name, partValue, err)
+		}
+	}
+
+	if !seen["FREQ"] {
+		return recurrenceRule{}, errors.New("rule must include a FREQ part")
+	}
+
+	if err := rule.validate(seen); err != nil {
+		return recurrenceRule{}, err
+	}
+
+	return rule, nil
+}
+
+// parseDTStart parses the parameters and value of a DTSTART line, following the DTSTART property name, such as
+// `;TZID=Europe/Berlin:20261016T090000`.
+func (r *recurrenceRule) parseDTStart(value string) error {
+	params, dateTime, ok := strings.Cut(value, ":")
+	if !ok {
+		return errors.New(`expected ":" before the DTSTART value`)
+	}
+
+	var tzid string
+
+	if params != "" {
+		if params[0] != ';' {
+			return fmt.Errorf(`expected ";" or ":" after DTSTART, got %q`, value)
+		}
+
+		for _, param := range strings.Split(params[1:], ";") {
+			name, paramValue, _ := strings.Cut(param, "=")
+
+			switch strings.ToUpper(name) {
+			case "TZID":
+				if err := validateTimeZoneName(paramValue); err != nil {
+					return err
+				}
+
+				tzid = paramValue
+			case "VALUE":
+				if !strings.EqualFold(paramValue, "DATE-TIME") {
+					return fmt.Errorf("unsupported DTSTART value type %q, expected DATE-TIME", paramValue)
+				}
+			default:
+				return fmt.Errorf("unsupported DTSTART parameter %q", param)
+			}
+		}
+	}
+
+	t, form, err := parseRecurrenceDateTime(dateTime)
+	if err != nil {
+		return err
+	}
+
+	switch {
+	case form == recurrenceDate:
+		return fmt.Errorf("expected a date-time such as \"20261016T090000\", got %q", dateTime)
+	case tzid != "" && form == recurrenceUTCDateTime:
+		return errors.New("a DTSTART with a TZID must not be a UTC date-time")
+	case tzid != "":
+		form = recurrenceZonedDateTime
+	}
+
+	r.dtstart, r.dtstartForm, r.tzid = t, form, tzid
+
+	return nil
+}
+
+// parsePart parses the upper case value of a single recurrence rule part with the given upper case name.
+func (r *recurrenceRule) parsePart(name string, value string) error {
+	var err error
+
+	switch name {
+	case "FREQ":
+		i := slices.Index(recurrenceFrequencyNames, value)
+		if i < 0 {
+			return fmt.Errorf("expected one of %s", strings.Join(recurrenceFrequencyNames, ", "))
+		}
+
+		r.freq = recurrenceFrequency(i)
+	case "INTERVAL":
+		r.interval, err = parseRecurrenceInteger(value, 1, math.MaxInt32, false)
+	case "COUNT":
+		r.count, err = parseRecurrenceInteger(value, 1, math.MaxInt32, false)
+	case "UNTIL":
+		r.until, r.untilForm, err = parseRecurrenceDateTime(value)
+	case "BYSECOND":
+		r.bySecond, err = parseRecurrenceIntegerList(value, 0, 59, false)
+	case "BYMINUTE":
+		r.byMinute, err = parseRecurrenceIntegerList(value, 0, 59, false)
+	case "BYHOUR":
+		r.byHour, err = parseRecurrenceIntegerList(value, 0, 23, false)
+	case "BYDAY":
+		for _, item := range strings.Split(value, ",") {
+			if len(item) < 2 {
+				return fmt.Errorf("expected a weekday such as \"MO\" or \"-1FR\", got %q", item)
+			}
+
+			weekday, err := parseRecurrenceWeekday(item[len(item)-2:])
+			if err != nil {
+				return err
+			}
+
+			n := 0
+
+			if item[:len(item)-2] != "" {
+				if n, err = parseRecurrenceInteger(item[:len(item)-2], 1, 53, true); err != nil {
+					return err
+				}
+			}
+
+			r.byDay = append(r.byDay, recurrenceWeekday{n: n, weekday: weekday})
+		}
+	case "BYMONTHDAY":
+		r.byMonthDay, err = parseRecurrenceIntegerList(value, 1, 31, true)
+	case "BYYEARDAY":
+		r.byYearDay, err = parseRecurrenceIntegerList(value, 1, 366, true)
+	case "BYWEEKNO":
+		r.byWeekNo, err = parseRecurrenceIntegerList(value, 1, 53, true)
+	case "BYMONTH":
+		r.byMonth, err = parseRecurrenceIntegerList(value, 1, 12, false)
+	case "BYSETPOS":
+		r.bySetPos, err = parseRecurrenceIntegerList(value, 1, 366, true)
+	case "WKST":
+		r.weekStart, err = parseRecurrenceWeekday(value)
+	}
+
+	return err
+}
+
+// validate returns an error if the combination of rule parts, given by their upper case names, is not allowed by
+// RFC 5545.
+func (r *recurrenceRule) validate(parts map[string]bool) error {
+	if parts["COUNT"] && parts["UNTIL"] {
+		return errors.New("rule must not include both COUNT and UNTIL parts")
+	}
+
+	for _, weekday := range r.byDay {
+		if weekday.n == 0 {
+			continue
+		}
+
+		if r.freq != recurrenceMonthly && r.freq != recurrenceYearly {
+			return errors.New("numeric BYDAY values are only allowed with a MONTHLY or YEARLY frequency")
+		}
+
+		if r.freq == recurrenceYearly && parts["BYWEEKNO"] {
+			return errors.New("numeric BYDAY values are not allowed with a YEARLY frequency and a BYWEEKNO part")
+		}
+	}
+
+	if parts["BYMONTHDAY"] && r.freq == recurrenceWeekly {
+		return errors.New("BYMONTHDAY is not allowed with a WEEKLY frequency")
+	}
+
+	if parts["BYYEARDAY"] && (r.freq == recurrenceMonthly || r.freq == recurrenceWeekly || r.freq == recurrenceDaily) {
+		return fmt.Errorf("BYYEARDAY is not allowed with a %s frequency", recurrenceFrequencyNames[r.freq])
+	}
+
+	if parts["BYWEEKNO"] && r.freq != recurrenceYearly {
+		return errors.New("BYWEEKNO is only allowed with a YEARLY frequency")
+	}
+
+	if parts["BYSETPOS"] {
+		hasOtherByPart := false
+
+		for name := range parts {
+			if strings.HasPrefix(name, "BY") && name != "BYSETPOS" {
+				hasOtherByPart = true
+			}
+		}
+
+		if !hasOtherByPart {
+			return errors.New("BYSETPOS must be used with another BYxxx rule part")
+		}
+	}
+
+	// A local DTSTART may be expanded in any location, so the UTC offset changes affecting the times of day that can be
+	// reached are only known for a UTC DTSTART or a DTSTART with a TZID.
+	switch r.dtstartForm {
+	case recurrenceUTCDateTime:
+		if err := r.checkTimeOfDayReachable(r.dtstart); err != nil {
+			return err
+		}
+	case recurrenceZonedDateTime:
+		if loc, err := time.LoadLocation(r.tzid); err == nil {
+			if err := r.checkTimeOfDayReachable(recurrenceWallClock(r.dtstart, loc)); err != nil {
+				return err
+			}
+		}
+	}
+
+	switch {
+	case r.dtstartForm == recurrenceNoDateTime || r.untilForm == recurrenceNoDateTime:
+	case r.dtstartForm == recurrenceLocalDateTime && r.untilForm != recurrenceLocalDateTime:
+		return errors.New(`UNTIL must be a local date-time without a "Z" suffix when DTSTART is a local date-time`)
+	case r.dtstartForm != recurrenceLocalDateTime && r.untilForm != recurrenceUTCDateTime:
+		return errors.New(`UNTIL must be a UTC date-time, such as "20261231T235959Z", when DTSTART is a UTC date-time or has a TZID`)
+	}
+
+	return nil
+}
+
+// String returns the canonical string format of the recurrence rule, which is used for semantic equality. Rule parts
+// are written in a fixed order, list values are sorted and default rule parts are omitted.
+func (r recurrenceRule) String() string {
+	var b strings.Builder
+
+	switch r.dtstartForm {
+	case recurrenceLocalDateTime:
+		b.WriteString("DTSTART:" + r.dtstart.Format("20060102T150405") + "\n")
+	case recurrenceUTCDateTime:
+		b.WriteString("DTSTART:" + r.dtstart.Format("20060102T150405Z") + "\n")
+	case recurrenceZonedDateTime:
+		b.WriteString("DTSTART;TZID=" + r.tzid + ":" + r.dtstart.Format("20060102T150405") + "\n")
+	}
+
+	b.WriteString("RRULE:FREQ=" + recurrenceFrequencyNames[r.freq])
+
+	if r.interval != 1 {
+		b.WriteString(";INTERVAL=" + strconv.Itoa(r.interval))
+	}
+
+	if r.count != 0 {
+		b.WriteString(";COUNT=" + strconv.Itoa(r.count))
+	}
+
+	switch r.untilForm {
+	case recurrenceDate:
+		b.WriteString(";UNTIL=" + r.until.Format("20060102"))
+	case recurrenceLocalDateTime:
+		b.WriteString(";UNTIL=" + r.until.Format("20060102T150405"))
+	case recurrenceUTCDateTime:
+		b.WriteString(";UNTIL=" + r.until.Format("20060102T150405Z"))
+	}
+
+	writeList := func(name string, values []int) {
+		if len(values) == 0 {
+			return
+		}
+
+		values = slices.Compact(slices.Sorted(slices.Values(values)))
+		items := make([]string, 0, len(values))
+
+		for _, value := range values {
+			items = append(items, strconv.Itoa(value))
+		}
+
+		b.WriteString(";" + name + "=" + strings.Join(items, ","))
+	}
+
+	writeList("BYSECOND", r.bySecond)
+	writeList("BYMINUTE", r.byMinute)
+	writeList("BYHOUR", r.byHour)
+
+	if len(r.byDay) > 0 {
+		byDay := slices.Clone(r.byDay)
+
+		slices.SortFunc(byDay, func(a, b recurrenceWeekday) int {
+			if a.n != b.n {
+				return a.n - b.n
+			}
+
+			return int(a.weekday) - int(b.weekday)
+		})
+
+		items := make([]string, 0, len(byDay))
+
+		for _, weekday := range slices.Compact(byDay) {
+			item := recurrenceWeekdayNames[weekday.weekday]
+
+			if weekday.n != 0 {
+				item = strconv.Itoa(weekday.n) + item
+			}
+
+			items = append(items, item)
+		}
+
+		b.WriteString(";BYDAY=" + strings.Join(items, ","))
+	}
+
+	writeList("BYMONTHDAY", r.byMonthDay)
+	writeList("BYYEARDAY", r.byYearDay)
+	writeList("BYWEEKNO", r.byWeekNo)
+	writeList("BYMONTH", r.byMonth)
+	writeList("BYSETPOS", r.bySetPos)
+
+	if r.weekStart != time.Monday {
+		b.WriteString(";WKST=" + recurrenceWeekdayNames[r.weekStart])
+	}
+
+	return b.String()
+}
+
+// parseRecurrenceDateTime parses an RFC 5545 date, such as `20261016`, or date-time, such as `20261016T090000` or
+// `20261016T090000Z`, returning the wall clock time in UTC and its form.
+func parseRecurrenceDateTime(value string) (time.Time, recurrenceDateTimeForm, error) {
+	if len(value) != 8 && len(value) != 15 && len(value) != 16 {
+		return time.Time{}, recurrenceNoDateTime, fmt.Errorf("expected a date such as \"20261016\" or a date-time such as \"20261016T090000Z\", got %q", value)
+	}
+
+	year, rest, err := parseFixedDigits(value, 4, "year", 0, 9999)
+	if err != nil {
+		return time.Time{}, recurrenceNoDateTime, err
+	}
+
+	month, rest, err := parseFixedDigits(rest, 2, "month", 1, 12)
+	if err != nil {
+		return time.Time{}, recurrenceNoDateTime, err
+	}
+
+	day, rest, err := parseFixedDigits(rest, 2, "day", 1, 31)
+	if err != nil {
+		return time.Time{}, recurrenceNoDateTime, err
+	}
+
+	if t := time.Date(year, time.Month(month), day, 0, 0, 0, 0, time.UTC); t.Day() != day {
+		return time.Time{}, recurrenceNoDateTime, fmt.Errorf("day %d is out of range for %s %d", day, time.Month(month), year)
+	}
+
+	if rest == "" {
+		return time.Date(year, time.Month(month), day, 0, 0, 0, 0, time.UTC), recurrenceDate, nil
+	}
+
+	if rest, err = parseSeparator(rest, 'T', "day"); err != nil {
+		return time.Time{}, recurrenceNoDateTime, err
+	}
+
+	hour, rest, err := parseFixedDigits(rest, 2, "hour", 0, 23)
+	if err != nil {
+		return time.Time{}, recurrenceNoDateTime, err
+	}
+
+	minute, rest, err := parseFixedDigits(rest, 2, "minute", 0, 59)
+	if err != nil {
+		return time.Time{}, recurrenceNoDateTime, err
+	}
+
+	second, rest, err := parseFixedDigits(rest, 2, "second", 0, 59)
+	if err != nil {
+		return time.Time{}, recurrenceNoDateTime, err
+	}
+
+	form := recurrenceLocalDateTime
+
+	if rest != "" {
+		if rest != "Z" {
+			return time.Time{}, recurrenceNoDateTime, fmt.Errorf("expected \"Z\" or nothing after second, got %q", rest)
+		}
+
+		form = recurrenceUTCDateTime
+	}
+
+	return time.Date(year, time.Month(month), day, hour, minute, second, 0, time.UTC), form, nil
+}
+
+// parseRecurrenceInteger parses a decimal integer between the given bounds. If signed, the integer may be preceded by
+// `+` or `-` and may be within the negated bounds.
+func parseRecurrenceInteger(value string, lowerBound int, upperBound int, signed bool) (int, error) {
+	digits := value
+	sign := 1
+
+	if signed && value != "" && (value[0] == '+' || value[0] == '-') {
+		if value[0] == '-' {
+			sign = -1
+		}
+
+		digits = value[1:]
+	}
+
+	if !isDigits(digits) {
+		return 0, fmt.Errorf("expected an integer, got %q", value)
+	}
+
+	n, err := strconv.Atoi(digits)
+	if err != nil || n < lowerBound || n > upperBound {
+		if signed {
+			return 0, fmt.Errorf("value %q is out of range [%d, %d] or [%d, %d]", value, -upperBound, -lowerBound, lowerBound, upperBound)
+		}
+
+		return 0, fmt.Errorf("value %q is out of range [%d, %d]", value, lowerBound, upperBound)
+	}
+
+	return sign * n, nil
+}
+
+// parseRecurrenceIntegerList parses a comma-separated list of integers via parseRecurrenceInteger.
+func parseRecurrenceIntegerList(value string, lowerBound int, upperBound int, signed bool) ([]int, error) {
+	var values []int
+
+	for _, item := range strings.Split(value, ",") {
+		n, err := parseRecurrenceInteger(item, lowerBound, upperBound, signed)
+		if err != nil {
+			return nil, err
+		}
+
+		values = append(values, n)
+	}
+
+	return values, nil
+}
+
+// parseRecurrenceWeekday parses an upper case RFC 5545 weekday name, such as `MO`.
+func parseRecurrenceWeekday(value string) (time.Weekday, error) {
+	i := slices.Index(recurrenceWeekdayNames, value)
+	if i < 0 {
+		return 0, fmt.Errorf("expected a weekday such as \"MO\" or \"-1FR\", got %q", value)
+	}
+
+	return time.Weekday(i), nil
+}
+
+// recurrenceWallClock returns the time in the given location with the same wall clock as the given UTC time.
+func recurrenceWallClock(t time.Time, loc *time.Location) time.Time {
+	return time.Date(t.Year(), t.Month(), t.Day(), t.Hour(), t.Minute(), t.Second(), 0, loc)
+}
+
+// dtstartIn returns the DTSTART of the recurrence rule, where a local DTSTART is in the given location, or UTC if nil,
+// while a UTC DTSTART or a DTSTART with a TZID is in that location.
+func (r recurrenceRule) dtstartIn(loc *time.Location) (time.Time, error) {
+	switch r.dtstartForm {
+	case recurrenceUTCDateTime:
+		loc = time.UTC
+	case recurrenceZonedDateTime:
+		var err error
+
+		if loc, err = time.LoadLocation(r.tzid); err != nil {
+			return time.Time{}, err
+		}
+	}
+
+	if loc == nil {
+		loc = time.UTC
+	}
+
+	return recurrenceWallClock(r.dtstart, loc), nil
+}
+
+// occurrences returns the first n occurrences of the recurrence rule starting at dtstart, expanded in the location of
+// dtstart.
+func (r recurrenceRule) occurrences(dtstart time.Time, n int) []time.Time {
+	loc := dtstart.Location()
+	occurrences := make([]time.Time, 0)
+
+	if r.count != 0 && r.count < n {
+		n = r.count
+	}
+
+	if n == 0 {
+		return occurrences
+	}
+
+	if r.checkTimeOfDayReachable(dtstart) != nil {
+		return occurrences
+	}
+
+	r = r.withDefaults(dtstart)
+
+	afterUntil := func(t time.Time) bool {
+		switch r.untilForm {
+		case recurrenceDate:
+			return !t.Before(time.Date(r.until.Year(), r.until.Month(), r.until.Day()+1, 0, 0, 0, 0, loc))
+		case recurrenceLocalDateTime:
+			return t.After(recurrenceWallClock(r.until, loc))
+		case recurrenceUTCDateTime:
+			return t.After(r.until)
+		}
+
+		return false
+	}
+
+	previous := dtstart
+
+	for period, periods := r.newPeriodIterator(dtstart), 0; ; periods++ {
+		periodStart, candidates := period.next()
+
+		if periodStart.After(previous.AddDate(recurrenceRuleSearchYears, 0, 0)) || periods == recurrenceRuleSearchPeriods {
+			return occurrences
+		}
+
+		for _, candidate := range r.applySetPos(candidates) {
+			if candidate.Before(dtstart) {
+				continue
+			}
+
+			if afterUntil(candidate) {
+				return occurrences
+			}
+
+			occurrences = append(occurrences, candidate)
+			previous = candidate
+
+			if len(occurrences) == n {
+				return occurrences
+			}
+		}
+	}
+}
+
+// withDefaults returns the recurrence rule with the BYxxx rule parts that default to the values of dtstart, as defined
+// by RFC 5545, set to those values.
+func (r recurrenceRule) withDefaults(dtstart time.Time) recurrenceRule {
+	if r.byWeekNo == nil && r.byYearDay == nil && r.byMonthDay == nil && r.byDay == nil {
+		switch r.freq {
+		case recurrenceYearly:
+			if r.byMonth == nil {
+				r.byMonth = []int{int(dtstart.Month())}
+			}
+
+			r.byMonthDay = []int{dtstart.Day()}
+		case recurrenceMonthly:
+			r.byMonthDay = []int{dtstart.Day()}
+		case recurrenceWeekly:
+			r.byDay = []recurrenceWeekday{{weekday: dtstart.Weekday()}}
+		}
+	}
+
+	if r.byHour == nil && r.freq < recurrenceHourly {
+		r.byHour = []int{dtstart.Hour()}
+	}
+
+	if r.byMinute == nil && r.freq < recurrenceMinutely {
+		r.byMinute = []int{dtstart.Minute()}
+	}
+
+	if r.bySecond == nil && r.freq < recurrenceSecondly {
+		r.bySecond = []int{dtstart.Second()}
+	}
+
+	return r
+}
+
+// checkTimeOfDayReachable returns an error if the periods of an HOURLY, MINUTELY or SECONDLY recurrence rule from
+// dtstart can never start at a time of day matching its BYHOUR, BYMINUTE and BYSECOND rule parts. Periods start a
+// multiple of INTERVAL after dtstart, so their time of day is dtstart's modulo the greatest common divisor of the
+// period, a day and any UTC offset changes of dtstart's location within the search years.
+func (r recurrenceRule) checkTimeOfDayReachable(dtstart time.Time) error {
+	var unit int
+	var parts []string
+
+	hours, minutes, seconds := r.byHour, r.byMinute, r.bySecond
+
+	// Rule parts finer than the frequency are not matched against the start of a period, which is always zero for them.
+	switch r.freq {
+	case recurrenceHourly:
+		unit = 60 * 60
+		minutes, seconds = []int{0}, []int{0}
+	case recurrenceMinutely:
+		unit = 60
+		seconds = []int{0}
+	case recurrenceSecondly:
+		unit = 1
+	default:
+		return nil
+	}
+
+	if hours != nil {
+		parts = append(parts, "BYHOUR")
+	} else {
+		hours = recurrenceRange(24)
+	}
+
+	if minutes == nil {
+		minutes = recurrenceRange(60)
+	} else if r.freq != recurrenceHourly {
+		parts = append(parts, "BYMINUTE")
+	}
+
+	if seconds == nil {
+		seconds = recurrenceRange(60)
+	} else if r.freq == recurrenceSecondly {
+		parts = append(parts, "BYSECOND")
+	}
+
+	if parts == nil {
+		return nil
+	}
+
+	const day = 24 * 60 * 60
+
+	divisor := recurrenceGCD(day, r.interval%day*unit%day)
+
+	_, offset := dtstart.Zone()
+	end := dtstart.AddDate(recurrenceRuleSearchYears, 0, 0)
+
+	for t := dtstart; ; {
+		_, next := t.ZoneBounds()
+
+		if next.IsZero() || next.After(end) {
+			break
+		}
+
+		_, nextOffset := next.Zone()
+		divisor = recurrenceGCD(divisor, nextOffset-offset)
+		t = next
+	}
+
+	start := dtstart.Hour()*60*60 + dtstart.Minute()*60 + dtstart.Second()
+	start -= start % unit
+
+	for _, hour := range hours {
+		for _, minute := range minutes {
+			for _, second := range seconds {
+				if (hour*60*60+minute*60+second-start)%divisor == 0 {
+					return nil
+				}
+			}
+		}
+	}
+
+	return fmt.Errorf("INTERVAL=%d never reaches a time matching %s from DTSTART", r.interval, strings.Join(parts, " and "))
+}
+
+// recurrenceRange returns the integers from zero up to, but excluding, n.
+func recurrenceRange(n int) []int {
+	values := make([]int, n)
+
+	for i := range values {
+		values[i] = i
+	}
+
+	return values
+}
+
+// recurrenceGCD returns the greatest common divisor of the absolute values of a and b.
+func recurrenceGCD(a int, b int) int {
+	for b != 0 {
+		a, b = b, a%b
+	}
+
+	if a < 0 {
+		return -a
+	}
+
+	return a
+}
+
+// applySetPos returns the sorted candidates of a period limited by the BYSETPOS rule part, if any.
+func (r recurrenceRule) applySetPos(candidates []time.Time) []time.Time {
+	slices.SortFunc(candidates, func(a, b time.Time) int {
+		return a.Compare(b)
+	})
+
+	// Wall clock times skipped by daylight saving time transitions are normalized, which may duplicate others.
+	candidates = slices.CompactFunc(candidates, time.Time.Equal)
+
+	if r.bySetPos == nil {
+		return candidates
+	}
+
+	var selected []time.Time
+
+	for _, pos := range r.bySetPos {
+		i := pos - 1
+
+		if pos < 0 {
+			i = len(candidates) + pos
+		}
+
+		if i >= 0 && i < len(candidates) && !slices.ContainsFunc(selected, candidates[i].Equal) {
+			selected = append(selected, candidates[i])
+		}
+	}
+
+	slices.SortFunc(selected, func(a, b time.Time) int {
+		return a.Compare(b)
+	})
+
+	return selected
+}
+
+// recurrencePeriodIterator iterates over the periods of a recurrence rule, as defined by its FREQ and INTERVAL, from
+// a dtstart.
+type recurrencePeriodIterator struct {
+	rule    recurrenceRule
+	dtstart time.Time
+
+	// k is the index of the next period.
+	k int
+
+	// base and step are the start of the first period and the time between periods of a sub-daily frequency.
+	base time.Time
+	step time.Duration
+}
+
+// newPeriodIterator returns a recurrencePeriodIterator for the recurrence rule from the given dtstart.
+func (r recurrenceRule) newPeriodIterator(dtstart time.Time) *recurrencePeriodIterator {
+	it := &recurrencePeriodIterator{
+		rule:    r,
+		dtstart: dtstart,
+	}
+
+	var unit time.Duration
+
+	switch r.freq {
+	case recurrenceHourly:
+		unit = time.Hour
+		it.base = dtstart.Add(-time.Duration(dtstart.Minute())*time.Minute - time.Duration(dtstart.Second())*time.Second - time.Duration(dtstart.Nanosecond()))
+	case recurrenceMinutely:
+		unit = time.Minute
+		it.base = dtstart.Add(-time.Duration(dtstart.Second())*time.Second - time.Duration(dtstart.Nanosecond()))
+	case recurrenceSecondly:
+		unit = time.Second
+		it.base = dtstart.Add(-time.Duration(dtstart.Nanosecond()))
+	default:
+		return it
+	}
+
+	// Periods longer than the search limit are clamped, which avoids overflow without changing the result, as only
+	// the first period is ever searched.
+	maxInterval := int(time.Duration(recurrenceRuleSearchYears+1) * 366 * 24 * time.Hour / unit)
+	it.step = time.Duration(min(r.interval, maxInterval)) * unit
+
+	return it
+}
+
+// next returns the start of the next period and the candidate occurrences within it, which are not yet limited by
+// BYSETPOS, DTSTART, COUNT or UNTIL.
+func (it *recurrencePeriodIterator) next() (time.Time, []time.Time) {
+	r := it.rule
+
+	if r.freq > recurrenceDaily {
+		return it.nextSubDaily()
+	}
+
+	loc := it.dtstart.Location()
+	k := it.k
+	it.k++
+
+	var days []time.Time
+
+	switch r.freq {
+	case recurrenceYearly:
+		start := time.Date(it.dtstart.Year()+k*r.interval, time.January, 1, 0, 0, 0, 0, time.UTC)
+		days = recurrenceDays(start, start.AddDate(1, 0, 0))
+	case recurrenceMonthly:
+		start := time.Date(it.dtstart.Year(), it.dtstart.Month()+time.Month(k*r.interval), 1, 0, 0, 0, 0, time.UTC)
+		days = recurrenceDays(start, start.AddDate(0, 1, 0))
+	case recurrenceWeekly:
+		offset := (int(it.dtstart.Weekday()) - int(r.weekStart) + 7) % 7
+		start := time.Date(it.dtstart.Year(), it.dtstart.Month(), it.dtstart.Day()-offset+7*k*r.interval, 0, 0, 0, 0, time.UTC)
+		days = recurrenceDays(start, start.AddDate(0, 0, 7))
+	case recurrenceDaily:
+		start := time.Date(it.dtstart.Year(), it.dtstart.Month(), it.dtstart.Day()+k*r.interval, 0, 0, 0, 0, time.UTC)
+		days = []time.Time{start}
+	}
+
+	var candidates []time.Time
+
+	for _, day := range days {
+		if !r.matchesDay(day) {
+			continue
+		}
+
+		for _, hour := range r.byHour {
+			for _, minute := range r.byMinute {
+				for _, second := range r.bySecond {
+					candidates = append(candidates, time.Date(day.Year(), day.Month(), day.Day(), hour, minute, second, 0, loc))
+				}
+			}
+		}
+	}
+
+	return recurrenceWallClock(days[0], loc), candidates
+}
+
+// nextSubDaily returns the start of the next period and the candidate occurrences within it for an HOURLY, MINUTELY
+// or SECONDLY frequency. If the period is on a day, hour, minute or second that can never match, no candidates are
+// returned and the following periods up to the next day, hour, minute or matching second are skipped.
+func (it *recurrencePeriodIterator) nextSubDaily() (time.Time, []time.Time) {
+	r := it.rule
+	start := it.base.Add(time.Duration(it.k) * it.step)
+	day := time.Date(start.Year(), start.Month(), start.Day(), 0, 0, 0, 0, time.UTC)
+
+	var skipTo time.Time
+
+	switch {
+	case !r.matchesDay(day):
+		skipTo = time.Date(start.Year(), start.Month(), start.Day()+1, 0, 0, 0, 0, start.Location())
+	case r.byHour != nil && !slices.Contains(r.byHour, start.Hour()):
+		skipTo = start.Add(time.Hour - time.Duration(start.Minute())*time.Minute - time.Duration(start.Second())*time.Second)
+	case r.freq != recurrenceHourly && r.byMinute != nil && !slices.Contains(r.byMinute, start.Minute()):
+		skipTo = start.Add(time.Minute - time.Duration(start.Second())*time.Second)
+	case r.freq == recurrenceSecondly && r.bySecond != nil && !slices.Contains(r.bySecond, start.Second()):
+		// The next matching second in the same minute, otherwise the start of the next minute.
+		next := 60
+
+		for _, second := range r.bySecond {
+			if second > start.Second() && second < next {
+				next = second
+			}
+		}
+
+		skipTo = start.Add(time.Duration(next-start.Second()) * time.Second)
+	}
+
+	if !skipTo.IsZero() {
+		it.k = max(it.k+1, int((skipTo.Sub(it.base)+it.step-1)/it.step))
+
+		return start, nil
+	}
+
+	it.k++
+
+	var candidates []time.Time
+
+	switch r.freq {
+	case recurrenceHourly:
+		for _, minute := range r.byMinute {
+			for _, second := range r.bySecond {
+				candidates = append(candidates, start.Add(time.Duration(minute)*time.Minute+time.Duration(second)*time.Second))
+			}
+		}
+	case recurrenceMinutely:
+		for _, second := range r.bySecond {
+			candidates = append(candidates, start.Add(time.Duration(second)*time.Second))
+		}
+	case recurrenceSecondly:
+		candidates = append(candidates, start)
+	}
+
+	return start, candidates
+}
+
+// matchesDay returns true if the given day, at midnight UTC, matches the day-level BYxxx rule parts of the
+// recurrence rule.
+func (r recurrenceRule) matchesDay(day time.Time) bool {
+	if r.byMonth != nil && !slices.Contains(r.byMonth, int(day.Month())) {
+		return false
+	}
+
+	if r.byWeekNo != nil {
+		week, weeks := recurrenceWeekNumber(day, r.weekStart)
+
+		if !slices.Contains(r.byWeekNo, week) && !slices.Contains(r.byWeekNo, week-weeks-1) {
+			return false
+		}
+	}
+
+	daysInYear := time.Date(day.Year(), time.December, 31, 0, 0, 0, 0, time.UTC).YearDay()
+
+	if r.byYearDay != nil && !slices.Contains(r.byYearDay, day.YearDay()) && !slices.Contains(r.byYearDay, day.YearDay()-daysInYear-1) {
+		return false
+	}
+
+	daysInMonth := time.Date(day.Year(), day.Month()+1, 0, 0, 0, 0, 0, time.UTC).Day()
+
+	if r.byMonthDay != nil && !slices.Contains(r.byMonthDay, day.Day()) && !slices.Contains(r.byMonthDay, day.Day()-daysInMonth-1) {
+		return false
+	}
+
+	if r.byDay == nil {
+		return true
+	}
+
+	// Numeric weekdays count within the month for a MONTHLY frequency or a YEARLY frequency with BYMONTH, otherwise
+	// within the year.
+	index, total := day.YearDay(), daysInYear
+
+	if r.freq == recurrenceMonthly || (r.freq == recurrenceYearly && r.byMonth != nil) {
+		index, total = day.Day(), daysInMonth
+	}
+
+	for _, weekday := range r.byDay {
+		if weekday.weekday != day.Weekday() {
+			continue
+		}
+
+		if weekday.n == 0 || weekday.n == (index-1)/7+1 || weekday.n == -((total-index)/7+1) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// recurrenceDays returns each day at midnight UTC from start until, but not including, end.
+func recurrenceDays(start time.Time, end time.Time) []time.Time {
+	var days []time.Time
+
+	for day := start; day.Before(end); day = day.AddDate(0, 0, 1) {
+		days = append(days, day)
+	}
+
+	return days
+}
+
+// recurrenceWeekNumber returns the RFC 5545 week number of the given day, at midnight UTC, with weeks starting on the
+// given weekday, and the number of weeks in the year of that week. The first week of a year is the first week with at
+// least four days in that year.
+func recurrenceWeekNumber(day time.Time, weekStart time.Weekday) (int, int) {
+	firstWeek := func(year int) time.Time {
+		january1 := time.Date(year, time.January, 1, 0, 0, 0, 0, time.UTC)
+		offset := (int(january1.Weekday()) - int(weekStart) + 7) % 7
+
+		if offset >= 4 {
+			offset -= 7
+		}
+
+		return january1.AddDate(0, 0, -offset)
+	}
+
+	year := day.Year()
+
+	if next := firstWeek(year + 1); !day.Before(next) {
+		year++
+	} else if day.Before(firstWeek(year)) {
+		year--
+	}
+
+	start := firstWeek(year)
+	weeks := int(firstWeek(year+1).Sub(start).Hours()) / (24 * 7)
+
+	return int(day.Sub(start).Hours())/(24*7) + 1, weeks
+}
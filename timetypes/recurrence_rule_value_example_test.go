@@ -0,0 +1,46 @@
+// Copyright IBM Corp. 2023, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package timetypes_test
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework-timetypes/timetypes"
+)
+
+type PatchWindowResourceModel struct {
+	Recurrence timetypes.RecurrenceRule `tfsdk:"recurrence"`
+}
+
+func ExampleRecurrenceRule_ValueOccurrences() {
+	// For example purposes, typically the data model would be populated automatically by Plugin Framework via Config, Plan or State.
+	// https://developer.hashicorp.com/terraform/plugin/framework/handling-data/accessing-values
+	data := PatchWindowResourceModel{
+		Recurrence: timetypes.NewRecurrenceRuleValueMust("DTSTART;TZID=Europe/Berlin:20261016T000000\nRRULE:FREQ=WEEKLY;BYDAY=MO,WE;BYHOUR=3"),
+	}
+
+	// Check that the recurrence rule data is known and able to be expanded
+	if !data.Recurrence.IsNull() && !data.Recurrence.IsUnknown() {
+		dtstart, diags := data.Recurrence.ValueDTStart(nil)
+		if diags.HasError() {
+			return
+		}
+
+		occurrences, diags := data.Recurrence.ValueOccurrences(dtstart, nil, 4)
+		if diags.HasError() {
+			return
+		}
+
+		for _, occurrence := range occurrences {
+			fmt.Println(occurrence.Format(time.RFC3339))
+		}
+
+		// Output:
+		// 2026-10-19T03:00:00+02:00
+		// 2026-10-21T03:00:00+02:00
+		// 2026-10-26T03:00:00+01:00
+		// 2026-10-28T03:00:00+01:00
+	}
+}
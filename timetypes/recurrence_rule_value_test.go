@@ -0,0 +1,764 @@
+// Copyright IBM Corp. 2023, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package timetypes_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-framework/attr/xattr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+
+	"github.com/hashicorp/terraform-plugin-framework-timetypes/timetypes"
+)
+
+func TestRecurrenceRule_StringSemanticEquals(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		currentRecurrenceRule timetypes.RecurrenceRule
+		givenRecurrenceRule   basetypes.StringValuable
+		expectedMatch         bool
+		expectedDiags         diag.Diagnostics
+	}{
+		"not equal - different weekdays": {
+			currentRecurrenceRule: timetypes.NewRecurrenceRuleValueMust("FREQ=WEEKLY;BYDAY=MO"),
+			givenRecurrenceRule:   timetypes.NewRecurrenceRuleValueMust("FREQ=WEEKLY;BYDAY=TU"),
+			expectedMatch:         false,
+		},
+		"not equal - different DTSTART": {
+			currentRecurrenceRule: timetypes.NewRecurrenceRuleValueMust("DTSTART:20261016T090000Z\nRRULE:FREQ=WEEKLY"),
+			givenRecurrenceRule:   timetypes.NewRecurrenceRuleValueMust("DTSTART;TZID=UTC:20261016T090000\nRRULE:FREQ=WEEKLY"),
+			expectedMatch:         false,
+		},
+		"not equal - same days with a different rule": {
+			currentRecurrenceRule: timetypes.NewRecurrenceRuleValueMust("FREQ=DAILY"),
+			givenRecurrenceRule:   timetypes.NewRecurrenceRuleValueMust("FREQ=DAILY;BYDAY=MO,TU,WE,TH,FR,SA,SU"),
+			expectedMatch:         false,
+		},
+		"semantically equal - byte for byte match": {
+			currentRecurrenceRule: timetypes.NewRecurrenceRuleValueMust("FREQ=WEEKLY;BYDAY=MO,WE;BYHOUR=3"),
+			givenRecurrenceRule:   timetypes.NewRecurrenceRuleValueMust("FREQ=WEEKLY;BYDAY=MO,WE;BYHOUR=3"),
+			expectedMatch:         true,
+		},
+		"semantically equal - rule part order": {
+			currentRecurrenceRule: timetypes.NewRecurrenceRuleValueMust("FREQ=WEEKLY;BYDAY=MO"),
+			givenRecurrenceRule:   timetypes.NewRecurrenceRuleValueMust("BYDAY=MO;FREQ=WEEKLY"),
+			expectedMatch:         true,
+		},
+		"semantically equal - value order and case": {
+			currentRecurrenceRule: timetypes.NewRecurrenceRuleValueMust("FREQ=MONTHLY;BYDAY=MO,-1FR;BYMONTHDAY=1,15"),
+			givenRecurrenceRule:   timetypes.NewRecurrenceRuleValueMust("freq=monthly;bymonthday=15,1;byday=-1fr,mo"),
+			expectedMatch:         true,
+		},
+		"semantically equal - default rule parts": {
+			currentRecurrenceRule: timetypes.NewRecurrenceRuleValueMust("FREQ=WEEKLY;BYDAY=MO,WE"),
+			givenRecurrenceRule:   timetypes.NewRecurrenceRuleValueMust("RRULE:FREQ=WEEKLY;INTERVAL=1;BYDAY=WE,MO;WKST=MO"),
+			expectedMatch:         true,
+		},
+		"semantically equal - DTSTART line order": {
+			currentRecurrenceRule: timetypes.NewRecurrenceRuleValueMust("DTSTART;TZID=Europe/Berlin:20261016T090000\nRRULE:FREQ=DAILY;COUNT=5"),
+			givenRecurrenceRule:   timetypes.NewRecurrenceRuleValueMust("RRULE:COUNT=5;FREQ=DAILY\r\nDTSTART;TZID=Europe/Berlin:20261016T090000\r\n"),
+			expectedMatch:         true,
+		},
+		"error - not given RecurrenceRule value": {
+			currentRecurrenceRule: timetypes.NewRecurrenceRuleValueMust("FREQ=WEEKLY;BYDAY=MO"),
+			givenRecurrenceRule:   basetypes.NewStringValue("FREQ=WEEKLY;BYDAY=MO"),
+			expectedMatch:         false,
+			expectedDiags: diag.Diagnostics{
+				diag.NewErrorDiagnostic(
+					"Semantic Equality Check Error",
+					"An unexpected value type was received while performing semantic equality checks. "+
+						"Please report this to the provider developers.\n\n"+
+						"Expected Value Type: timetypes.RecurrenceRule\n"+
+						"Got Value Type: basetypes.StringValue",
+				),
+			},
+		},
+	}
+	for name, testCase := range testCases {
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			match, diags := testCase.currentRecurrenceRule.StringSemanticEquals(context.Background(), testCase.givenRecurrenceRule)
+
+			if testCase.expectedMatch != match {
+				t.Errorf("Expected StringSemanticEquals to return: %t, but got: %t", testCase.expectedMatch, match)
+			}
+
+			if diff := cmp.Diff(diags, testCase.expectedDiags); diff != "" {
+				t.Errorf("Unexpected diagnostics (-got, +expected): %s", diff)
+			}
+		})
+	}
+}
+
+func TestRecurrenceRuleValidateAttribute(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		recurrenceRule timetypes.RecurrenceRule
+		expectedDiags  diag.Diagnostics
+	}{
+		"empty-struct": {
+			recurrenceRule: timetypes.RecurrenceRule{},
+		},
+		"null": {
+			recurrenceRule: timetypes.NewRecurrenceRuleNull(),
+		},
+		"unknown": {
+			recurrenceRule: timetypes.NewRecurrenceRuleUnknown(),
+		},
+		"valid recurrence rule - rule": {
+			recurrenceRule: timetypes.NewRecurrenceRuleValueMust("FREQ=WEEKLY;BYDAY=MO,WE;BYHOUR=3"),
+		},
+		"valid recurrence rule - all rule parts": {
+			recurrenceRule: timetypes.NewRecurrenceRuleValueMust("FREQ=YEARLY;INTERVAL=2;UNTIL=20301231;BYSECOND=0;BYMINUTE=30;BYHOUR=3;BYDAY=MO;BYMONTHDAY=-1;BYYEARDAY=1,-1;BYMONTH=1,12;BYSETPOS=1;WKST=SU"),
+		},
+		"valid recurrence rule - DTSTART with TZID": {
+			recurrenceRule: timetypes.NewRecurrenceRuleValueMust("DTSTART;TZID=Europe/Berlin:20261016T090000\nRRULE:FREQ=DAILY;UNTIL=20261231T230000Z"),
+		},
+		"valid recurrence rule - local DTSTART": {
+			recurrenceRule: timetypes.NewRecurrenceRuleValueMust("DTSTART;VALUE=DATE-TIME:20261016T090000\nRRULE:FREQ=DAILY;UNTIL=20261231T090000"),
+		},
+		"valid recurrence rule - INTERVAL reaches BYHOUR after a UTC offset change": {
+			recurrenceRule: timetypes.NewRecurrenceRuleValueMust("DTSTART;TZID=Europe/Berlin:20261016T000000\nRRULE:FREQ=HOURLY;INTERVAL=2;BYHOUR=1"),
+		},
+		"valid recurrence rule - INTERVAL with local DTSTART": {
+			recurrenceRule: timetypes.NewRecurrenceRuleValueMust("DTSTART:20261016T000000\nRRULE:FREQ=HOURLY;INTERVAL=2;BYHOUR=1"),
+		},
+		"invalid recurrence rule - missing FREQ": {
+			recurrenceRule: timetypes.RecurrenceRule{
+				StringValue: basetypes.NewStringValue("BYDAY=MO"),
+			},
+			expectedDiags: diag.Diagnostics{
+				diag.NewAttributeErrorDiagnostic(
+					path.Root("test"),
+					"Invalid Recurrence Rule String Value",
+					"A string value was provided that is not a valid RFC 5545 recurrence rule, such as \"FREQ=WEEKLY;BYDAY=MO,WE;BYHOUR=3\".\n\n"+
+						"Given Value: BYDAY=MO\n"+
+						"Error: rule must include a FREQ part",
+				),
+			},
+		},
+		"invalid recurrence rule - unknown rule part": {
+			recurrenceRule: timetypes.RecurrenceRule{
+				StringValue: basetypes.NewStringValue("FREQ=WEEKLY;BYWEEKDAY=MO"),
+			},
+			expectedDiags: diag.Diagnostics{
+				diag.NewAttributeErrorDiagnostic(
+					path.Root("test"),
+					"Invalid Recurrence Rule String Value",
+					"A string value was provided that is not a valid RFC 5545 recurrence rule, such as \"FREQ=WEEKLY;BYDAY=MO,WE;BYHOUR=3\".\n\n"+
+						"Given Value: FREQ=WEEKLY;BYWEEKDAY=MO\n"+
+						"Error: unknown rule part \"BYWEEKDAY\"",
+				),
+			},
+		},
+		"invalid recurrence rule - repeated rule part": {
+			recurrenceRule: timetypes.RecurrenceRule{
+				StringValue: basetypes.NewStringValue("FREQ=WEEKLY;BYDAY=MO;BYDAY=WE"),
+			},
+			expectedDiags: diag.Diagnostics{
+				diag.NewAttributeErrorDiagnostic(
+					path.Root("test"),
+					"Invalid Recurrence Rule String Value",
+					"A string value was provided that is not a valid RFC 5545 recurrence rule, such as \"FREQ=WEEKLY;BYDAY=MO,WE;BYHOUR=3\".\n\n"+
+						"Given Value: FREQ=WEEKLY;BYDAY=MO;BYDAY=WE\n"+
+						"Error: rule part BYDAY must not be repeated",
+				),
+			},
+		},
+		"invalid recurrence rule - out of range value": {
+			recurrenceRule: timetypes.RecurrenceRule{
+				StringValue: basetypes.NewStringValue("FREQ=MONTHLY;BYMONTHDAY=0"),
+			},
+			expectedDiags: diag.Diagnostics{
+				diag.NewAttributeErrorDiagnostic(
+					path.Root("test"),
+					"Invalid Recurrence Rule String Value",
+					"A string value was provided that is not a valid RFC 5545 recurrence rule, such as \"FREQ=WEEKLY;BYDAY=MO,WE;BYHOUR=3\".\n\n"+
+						"Given Value: FREQ=MONTHLY;BYMONTHDAY=0\n"+
+						"Error: invalid BYMONTHDAY rule part \"0\": value \"0\" is out of range [-31, -1] or [1, 31]",
+				),
+			},
+		},
+		"invalid recurrence rule - invalid weekday": {
+			recurrenceRule: timetypes.RecurrenceRule{
+				StringValue: basetypes.NewStringValue("FREQ=WEEKLY;BYDAY=MON"),
+			},
+			expectedDiags: diag.Diagnostics{
+				diag.NewAttributeErrorDiagnostic(
+					path.Root("test"),
+					"Invalid Recurrence Rule String Value",
+					"A string value was provided that is not a valid RFC 5545 recurrence rule, such as \"FREQ=WEEKLY;BYDAY=MO,WE;BYHOUR=3\".\n\n"+
+						"Given Value: FREQ=WEEKLY;BYDAY=MON\n"+
+						"Error: invalid BYDAY rule part \"MON\": expected a weekday such as \"MO\" or \"-1FR\", got \"ON\"",
+				),
+			},
+		},
+		"invalid recurrence rule - COUNT and UNTIL": {
+			recurrenceRule: timetypes.RecurrenceRule{
+				StringValue: basetypes.NewStringValue("FREQ=DAILY;COUNT=5;UNTIL=20261231"),
+			},
+			expectedDiags: diag.Diagnostics{
+				diag.NewAttributeErrorDiagnostic(
+					path.Root("test"),
+					"Invalid Recurrence Rule String Value",
+					"A string value was provided that is not a valid RFC 5545 recurrence rule, such as \"FREQ=WEEKLY;BYDAY=MO,WE;BYHOUR=3\".\n\n"+
+						"Given Value: FREQ=DAILY;COUNT=5;UNTIL=20261231\n"+
+						"Error: rule must not include both COUNT and UNTIL parts",
+				),
+			},
+		},
+		"invalid recurrence rule - numeric BYDAY with WEEKLY frequency": {
+			recurrenceRule: timetypes.RecurrenceRule{
+				StringValue: basetypes.NewStringValue("FREQ=WEEKLY;BYDAY=1MO"),
+			},
+			expectedDiags: diag.Diagnostics{
+				diag.NewAttributeErrorDiagnostic(
+					path.Root("test"),
+					"Invalid Recurrence Rule String Value",
+					"A string value was provided that is not a valid RFC 5545 recurrence rule, such as \"FREQ=WEEKLY;BYDAY=MO,WE;BYHOUR=3\".\n\n"+
+						"Given Value: FREQ=WEEKLY;BYDAY=1MO\n"+
+						"Error: numeric BYDAY values are only allowed with a MONTHLY or YEARLY frequency",
+				),
+			},
+		},
+		"invalid recurrence rule - BYSETPOS without another BYxxx rule part": {
+			recurrenceRule: timetypes.RecurrenceRule{
+				StringValue: basetypes.NewStringValue("FREQ=MONTHLY;BYSETPOS=-1"),
+			},
+			expectedDiags: diag.Diagnostics{
+				diag.NewAttributeErrorDiagnostic(
+					path.Root("test"),
+					"Invalid Recurrence Rule String Value",
+					"A string value was provided that is not a valid RFC 5545 recurrence rule, such as \"FREQ=WEEKLY;BYDAY=MO,WE;BYHOUR=3\".\n\n"+
+						"Given Value: FREQ=MONTHLY;BYSETPOS=-1\n"+
+						"Error: BYSETPOS must be used with another BYxxx rule part",
+				),
+			},
+		},
+		"invalid recurrence rule - local UNTIL with TZID DTSTART": {
+			recurrenceRule: timetypes.RecurrenceRule{
+				StringValue: basetypes.NewStringValue("DTSTART;TZID=Europe/Berlin:20261016T090000\nRRULE:FREQ=DAILY;UNTIL=20261231T090000"),
+			},
+			expectedDiags: diag.Diagnostics{
+				diag.NewAttributeErrorDiagnostic(
+					path.Root("test"),
+					"Invalid Recurrence Rule String Value",
+					"A string value was provided that is not a valid RFC 5545 recurrence rule, such as \"FREQ=WEEKLY;BYDAY=MO,WE;BYHOUR=3\".\n\n"+
+						"Given Value: DTSTART;TZID=Europe/Berlin:20261016T090000\nRRULE:FREQ=DAILY;UNTIL=20261231T090000\n"+
+						"Error: UNTIL must be a UTC date-time, such as \"20261231T235959Z\", when DTSTART is a UTC date-time or has a TZID",
+				),
+			},
+		},
+		"invalid recurrence rule - unknown TZID": {
+			recurrenceRule: timetypes.RecurrenceRule{
+				StringValue: basetypes.NewStringValue("DTSTART;TZID=Mars/Olympus_Mons:20261016T090000\nRRULE:FREQ=DAILY"),
+			},
+			expectedDiags: diag.Diagnostics{
+				diag.NewAttributeErrorDiagnostic(
+					path.Root("test"),
+					"Invalid Recurrence Rule String Value",
+					"A string value was provided that is not a valid RFC 5545 recurrence rule, such as \"FREQ=WEEKLY;BYDAY=MO,WE;BYHOUR=3\".\n\n"+
+						"Given Value: DTSTART;TZID=Mars/Olympus_Mons:20261016T090000\nRRULE:FREQ=DAILY\n"+
+						"Error: invalid DTSTART \"DTSTART;TZID=Mars/Olympus_Mons:20261016T090000\": unknown time zone \"Mars/Olympus_Mons\"",
+				),
+			},
+		},
+		"invalid recurrence rule - INTERVAL never reaches BYSECOND": {
+			recurrenceRule: timetypes.RecurrenceRule{
+				StringValue: basetypes.NewStringValue("DTSTART:20261016T000000Z\nRRULE:FREQ=SECONDLY;INTERVAL=2;BYSECOND=1"),
+			},
+			expectedDiags: diag.Diagnostics{
+				diag.NewAttributeErrorDiagnostic(
+					path.Root("test"),
+					"Invalid Recurrence Rule String Value",
+					"A string value was provided that is not a valid RFC 5545 recurrence rule, such as \"FREQ=WEEKLY;BYDAY=MO,WE;BYHOUR=3\".\n\n"+
+						"Given Value: DTSTART:20261016T000000Z\nRRULE:FREQ=SECONDLY;INTERVAL=2;BYSECOND=1\n"+
+						"Error: INTERVAL=2 never reaches a time matching BYSECOND from DTSTART",
+				),
+			},
+		},
+		"invalid recurrence rule - INTERVAL never reaches BYHOUR and BYMINUTE": {
+			recurrenceRule: timetypes.RecurrenceRule{
+				StringValue: basetypes.NewStringValue("DTSTART;TZID=Asia/Tokyo:20261016T090000\nRRULE:FREQ=MINUTELY;INTERVAL=120;BYHOUR=10;BYMINUTE=0"),
+			},
+			expectedDiags: diag.Diagnostics{
+				diag.NewAttributeErrorDiagnostic(
+					path.Root("test"),
+					"Invalid Recurrence Rule String Value",
+					"A string value was provided that is not a valid RFC 5545 recurrence rule, such as \"FREQ=WEEKLY;BYDAY=MO,WE;BYHOUR=3\".\n\n"+
+						"Given Value: DTSTART;TZID=Asia/Tokyo:20261016T090000\nRRULE:FREQ=MINUTELY;INTERVAL=120;BYHOUR=10;BYMINUTE=0\n"+
+						"Error: INTERVAL=120 never reaches a time matching BYHOUR and BYMINUTE from DTSTART",
+				),
+			},
+		},
+		"invalid recurrence rule - missing RRULE": {
+			recurrenceRule: timetypes.RecurrenceRule{
+				StringValue: basetypes.NewStringValue("DTSTART:20261016T090000Z"),
+			},
+			expectedDiags: diag.Diagnostics{
+				diag.NewAttributeErrorDiagnostic(
+					path.Root("test"),
+					"Invalid Recurrence Rule String Value",
+					"A string value was provided that is not a valid RFC 5545 recurrence rule, such as \"FREQ=WEEKLY;BYDAY=MO,WE;BYHOUR=3\".\n\n"+
+						"Given Value: DTSTART:20261016T090000Z\n"+
+						"Error: expected an RRULE line",
+				),
+			},
+		},
+	}
+	for name, testCase := range testCases {
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			resp := xattr.ValidateAttributeResponse{}
+
+			testCase.recurrenceRule.ValidateAttribute(
+				context.Background(),
+				xattr.ValidateAttributeRequest{
+					Path: path.Root("test"),
+				},
+				&resp,
+			)
+
+			if diff := cmp.Diff(resp.Diagnostics, testCase.expectedDiags); diff != "" {
+				t.Errorf("Unexpected diagnostics (-got, +expected): %s", diff)
+			}
+		})
+	}
+}
+
+func TestRecurrenceRuleValidateParameter(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		recurrenceRule  timetypes.RecurrenceRule
+		expectedFuncErr *function.FuncError
+	}{
+		"empty-struct": {
+			recurrenceRule: timetypes.RecurrenceRule{},
+		},
+		"null": {
+			recurrenceRule: timetypes.NewRecurrenceRuleNull(),
+		},
+		"unknown": {
+			recurrenceRule: timetypes.NewRecurrenceRuleUnknown(),
+		},
+		"valid recurrence rule": {
+			recurrenceRule: timetypes.NewRecurrenceRuleValueMust("FREQ=WEEKLY;BYDAY=MO,WE;BYHOUR=3"),
+		},
+		"invalid recurrence rule": {
+			recurrenceRule: timetypes.RecurrenceRule{
+				StringValue: basetypes.NewStringValue("FREQ=FORTNIGHTLY"),
+			},
+			expectedFuncErr: function.NewArgumentFuncError(
+				0,
+				"Invalid Recurrence Rule String Value: "+
+					"A string value was provided that is not a valid RFC 5545 recurrence rule, such as \"FREQ=WEEKLY;BYDAY=MO,WE;BYHOUR=3\".\n\n"+
+					"Given Value: FREQ=FORTNIGHTLY\n"+
+					"Error: invalid FREQ rule part \"FORTNIGHTLY\": expected one of YEARLY, MONTHLY, WEEKLY, DAILY, HOURLY, MINUTELY, SECONDLY",
+			),
+		},
+	}
+	for name, testCase := range testCases {
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			resp := function.ValidateParameterResponse{}
+
+			testCase.recurrenceRule.ValidateParameter(
+				context.Background(),
+				function.ValidateParameterRequest{
+					Position: int64(0),
+				},
+				&resp,
+			)
+
+			if diff := cmp.Diff(resp.Error, testCase.expectedFuncErr); diff != "" {
+				t.Errorf("Unexpected diagnostics (-got, +expected): %s", diff)
+			}
+		})
+	}
+}
+
+func TestRecurrenceRule_ValueDTStart(t *testing.T) {
+	t.Parallel()
+
+	berlin, err := time.LoadLocation("Europe/Berlin")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	newYork, err := time.LoadLocation("America/New_York")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	testCases := map[string]struct {
+		recurrenceRule  timetypes.RecurrenceRule
+		loc             *time.Location
+		expectedDTStart time.Time
+		expectedDiags   diag.Diagnostics
+	}{
+		"recurrence rule string value is null": {
+			recurrenceRule: timetypes.NewRecurrenceRuleNull(),
+			expectedDiags: diag.Diagnostics{
+				diag.NewErrorDiagnostic("RecurrenceRule ValueDTStart Error", "Recurrence rule string value is null"),
+			},
+		},
+		"recurrence rule string value is unknown": {
+			recurrenceRule: timetypes.NewRecurrenceRuleUnknown(),
+			expectedDiags: diag.Diagnostics{
+				diag.NewErrorDiagnostic("RecurrenceRule ValueDTStart Error", "Recurrence rule string value is unknown"),
+			},
+		},
+		"missing DTSTART": {
+			recurrenceRule: timetypes.NewRecurrenceRuleValueMust("FREQ=DAILY"),
+			expectedDiags: diag.Diagnostics{
+				diag.NewErrorDiagnostic("RecurrenceRule ValueDTStart Error", "Recurrence rule string value does not include a DTSTART"),
+			},
+		},
+		"local DTSTART - nil location": {
+			recurrenceRule:  timetypes.NewRecurrenceRuleValueMust("DTSTART:20261016T090000\nRRULE:FREQ=DAILY"),
+			expectedDTStart: time.Date(2026, time.October, 16, 9, 0, 0, 0, time.UTC),
+		},
+		"local DTSTART - location": {
+			recurrenceRule:  timetypes.NewRecurrenceRuleValueMust("DTSTART:20261016T090000\nRRULE:FREQ=DAILY"),
+			loc:             newYork,
+			expectedDTStart: time.Date(2026, time.October, 16, 9, 0, 0, 0, newYork),
+		},
+		"UTC DTSTART": {
+			recurrenceRule:  timetypes.NewRecurrenceRuleValueMust("DTSTART:20261016T090000Z\nRRULE:FREQ=DAILY"),
+			loc:             newYork,
+			expectedDTStart: time.Date(2026, time.October, 16, 9, 0, 0, 0, time.UTC),
+		},
+		"DTSTART with TZID": {
+			recurrenceRule:  timetypes.NewRecurrenceRuleValueMust("DTSTART;TZID=Europe/Berlin:20261016T090000\nRRULE:FREQ=DAILY"),
+			loc:             newYork,
+			expectedDTStart: time.Date(2026, time.October, 16, 9, 0, 0, 0, berlin),
+		},
+	}
+	for name, testCase := range testCases {
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			dtstart, diags := testCase.recurrenceRule.ValueDTStart(testCase.loc)
+
+			if !dtstart.Equal(testCase.expectedDTStart) {
+				t.Errorf("Unexpected DTSTART, got: %s, expected: %s", dtstart, testCase.expectedDTStart)
+			}
+
+			if diff := cmp.Diff(diags, testCase.expectedDiags); diff != "" {
+				t.Errorf("Unexpected diagnostics (-got, +expected): %s", diff)
+			}
+		})
+	}
+}
+
+func TestRecurrenceRule_ValueOccurrences(t *testing.T) {
+	t.Parallel()
+
+	berlin, err := time.LoadLocation("Europe/Berlin")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	testCases := map[string]struct {
+		recurrenceRule      timetypes.RecurrenceRule
+		dtstart             time.Time
+		loc                 *time.Location
+		n                   int
+		expectedOccurrences []time.Time
+		expectedDiags       diag.Diagnostics
+	}{
+		"recurrence rule string value is null": {
+			recurrenceRule: timetypes.NewRecurrenceRuleNull(),
+			n:              3,
+			expectedDiags: diag.Diagnostics{
+				diag.NewErrorDiagnostic("RecurrenceRule ValueOccurrences Error", "Recurrence rule string value is null"),
+			},
+		},
+		"negative n": {
+			recurrenceRule: timetypes.NewRecurrenceRuleValueMust("FREQ=DAILY"),
+			n:              -1,
+			expectedDiags: diag.Diagnostics{
+				diag.NewErrorDiagnostic("RecurrenceRule ValueOccurrences Error", "number of occurrences -1 must not be negative"),
+			},
+		},
+		"DTSTART - zero dtstart": {
+			recurrenceRule: timetypes.NewRecurrenceRuleValueMust("DTSTART;TZID=Europe/Berlin:20261016T000000\nRRULE:FREQ=WEEKLY;BYDAY=MO;BYHOUR=3"),
+			n:              2,
+			expectedOccurrences: []time.Time{
+				time.Date(2026, time.October, 19, 3, 0, 0, 0, berlin),
+				time.Date(2026, time.October, 26, 3, 0, 0, 0, berlin),
+			},
+		},
+		"DTSTART - same instant": {
+			recurrenceRule: timetypes.NewRecurrenceRuleValueMust("DTSTART;TZID=Europe/Berlin:20261016T000000\nRRULE:FREQ=WEEKLY;BYDAY=MO;BYHOUR=3"),
+			dtstart:        time.Date(2026, time.October, 15, 22, 0, 0, 0, time.UTC),
+			loc:            berlin,
+			n:              2,
+			expectedOccurrences: []time.Time{
+				time.Date(2026, time.October, 19, 3, 0, 0, 0, berlin),
+				time.Date(2026, time.October, 26, 3, 0, 0, 0, berlin),
+			},
+		},
+		"DTSTART - conflicts": {
+			recurrenceRule: timetypes.NewRecurrenceRuleValueMust("DTSTART;TZID=Europe/Berlin:20261016T000000\nRRULE:FREQ=WEEKLY;BYDAY=MO;BYHOUR=3"),
+			dtstart:        time.Date(2026, time.October, 17, 0, 0, 0, 0, berlin),
+			n:              2,
+			expectedDiags: diag.Diagnostics{
+				diag.NewErrorDiagnostic(
+					"RecurrenceRule ValueOccurrences Error",
+					"dtstart 2026-10-17T00:00:00+02:00 conflicts with the recurrence rule DTSTART 2026-10-16T00:00:00+02:00",
+				),
+			},
+		},
+		"local DTSTART - location of dtstart": {
+			recurrenceRule: timetypes.NewRecurrenceRuleValueMust("DTSTART:20261016T090000\nRRULE:FREQ=DAILY"),
+			dtstart:        time.Date(2026, time.October, 16, 9, 0, 0, 0, berlin),
+			n:              2,
+			expectedOccurrences: []time.Time{
+				time.Date(2026, time.October, 16, 9, 0, 0, 0, berlin),
+				time.Date(2026, time.October, 17, 9, 0, 0, 0, berlin),
+			},
+		},
+		"local DTSTART - conflicts with location": {
+			recurrenceRule: timetypes.NewRecurrenceRuleValueMust("DTSTART:20261016T090000\nRRULE:FREQ=DAILY"),
+			dtstart:        time.Date(2026, time.October, 16, 9, 0, 0, 0, time.UTC),
+			loc:            berlin,
+			n:              2,
+			expectedDiags: diag.Diagnostics{
+				diag.NewErrorDiagnostic(
+					"RecurrenceRule ValueOccurrences Error",
+					"dtstart 2026-10-16T09:00:00Z conflicts with the recurrence rule DTSTART 2026-10-16T09:00:00+02:00",
+				),
+			},
+		},
+		"no DTSTART - zero dtstart": {
+			recurrenceRule: timetypes.NewRecurrenceRuleValueMust("FREQ=DAILY"),
+			n:              2,
+			expectedDiags: diag.Diagnostics{
+				diag.NewErrorDiagnostic("RecurrenceRule ValueOccurrences Error", "dtstart is required as the recurrence rule string value does not include a DTSTART"),
+			},
+		},
+		"weekly - DTSTART does not match": {
+			recurrenceRule: timetypes.NewRecurrenceRuleValueMust("FREQ=WEEKLY;BYDAY=MO,WE;BYHOUR=3"),
+			dtstart:        time.Date(2026, time.October, 16, 9, 0, 0, 0, berlin),
+			n:              4,
+			expectedOccurrences: []time.Time{
+				time.Date(2026, time.October, 19, 3, 0, 0, 0, berlin),
+				time.Date(2026, time.October, 21, 3, 0, 0, 0, berlin),
+				time.Date(2026, time.October, 26, 3, 0, 0, 0, berlin),
+				time.Date(2026, time.October, 28, 3, 0, 0, 0, berlin),
+			},
+		},
+		"weekly - location": {
+			recurrenceRule: timetypes.NewRecurrenceRuleValueMust("FREQ=WEEKLY;BYDAY=MO;BYHOUR=3"),
+			dtstart:        time.Date(2026, time.October, 16, 0, 0, 0, 0, time.UTC),
+			loc:            berlin,
+			n:              2,
+			expectedOccurrences: []time.Time{
+				time.Date(2026, time.October, 19, 1, 0, 0, 0, time.UTC),
+				time.Date(2026, time.October, 26, 2, 0, 0, 0, time.UTC),
+			},
+		},
+		"monthly - last Friday": {
+			recurrenceRule: timetypes.NewRecurrenceRuleValueMust("FREQ=MONTHLY;BYDAY=-1FR"),
+			dtstart:        time.Date(2026, time.October, 16, 9, 0, 0, 0, time.UTC),
+			n:              3,
+			expectedOccurrences: []time.Time{
+				time.Date(2026, time.October, 30, 9, 0, 0, 0, time.UTC),
+				time.Date(2026, time.November, 27, 9, 0, 0, 0, time.UTC),
+				time.Date(2026, time.December, 25, 9, 0, 0, 0, time.UTC),
+			},
+		},
+		"monthly - last weekday": {
+			recurrenceRule: timetypes.NewRecurrenceRuleValueMust("FREQ=MONTHLY;BYDAY=MO,TU,WE,TH,FR;BYSETPOS=-1"),
+			dtstart:        time.Date(2026, time.October, 16, 0, 0, 0, 0, time.UTC),
+			n:              3,
+			expectedOccurrences: []time.Time{
+				time.Date(2026, time.October, 30, 0, 0, 0, 0, time.UTC),
+				time.Date(2026, time.November, 30, 0, 0, 0, 0, time.UTC),
+				time.Date(2026, time.December, 31, 0, 0, 0, 0, time.UTC),
+			},
+		},
+		"yearly - leap day": {
+			recurrenceRule: timetypes.NewRecurrenceRuleValueMust("FREQ=YEARLY"),
+			dtstart:        time.Date(2024, time.February, 29, 0, 0, 0, 0, time.UTC),
+			n:              2,
+			expectedOccurrences: []time.Time{
+				time.Date(2024, time.February, 29, 0, 0, 0, 0, time.UTC),
+				time.Date(2028, time.February, 29, 0, 0, 0, 0, time.UTC),
+			},
+		},
+		"yearly - week number": {
+			recurrenceRule: timetypes.NewRecurrenceRuleValueMust("FREQ=YEARLY;BYWEEKNO=1;BYDAY=MO"),
+			dtstart:        time.Date(2026, time.October, 16, 9, 0, 0, 0, time.UTC),
+			n:              2,
+			expectedOccurrences: []time.Time{
+				time.Date(2027, time.January, 4, 9, 0, 0, 0, time.UTC),
+				time.Date(2028, time.January, 3, 9, 0, 0, 0, time.UTC),
+			},
+		},
+		"daily - COUNT": {
+			recurrenceRule: timetypes.NewRecurrenceRuleValueMust("FREQ=DAILY;COUNT=2"),
+			dtstart:        time.Date(2026, time.October, 16, 12, 0, 0, 0, time.UTC),
+			n:              5,
+			expectedOccurrences: []time.Time{
+				time.Date(2026, time.October, 16, 12, 0, 0, 0, time.UTC),
+				time.Date(2026, time.October, 17, 12, 0, 0, 0, time.UTC),
+			},
+		},
+		"daily - UNTIL": {
+			recurrenceRule: timetypes.NewRecurrenceRuleValueMust("FREQ=DAILY;UNTIL=20261018T120000Z"),
+			dtstart:        time.Date(2026, time.October, 16, 12, 0, 0, 0, time.UTC),
+			n:              5,
+			expectedOccurrences: []time.Time{
+				time.Date(2026, time.October, 16, 12, 0, 0, 0, time.UTC),
+				time.Date(2026, time.October, 17, 12, 0, 0, 0, time.UTC),
+				time.Date(2026, time.October, 18, 12, 0, 0, 0, time.UTC),
+			},
+		},
+		"daily - across daylight saving time": {
+			recurrenceRule: timetypes.NewRecurrenceRuleValueMust("FREQ=DAILY"),
+			dtstart:        time.Date(2026, time.October, 24, 9, 0, 0, 0, berlin),
+			n:              2,
+			expectedOccurrences: []time.Time{
+				time.Date(2026, time.October, 24, 7, 0, 0, 0, time.UTC),
+				time.Date(2026, time.October, 25, 8, 0, 0, 0, time.UTC),
+			},
+		},
+		"hourly - across daylight saving time": {
+			recurrenceRule: timetypes.NewRecurrenceRuleValueMust("FREQ=HOURLY"),
+			dtstart:        time.Date(2026, time.October, 25, 1, 0, 0, 0, berlin),
+			n:              4,
+			expectedOccurrences: []time.Time{
+				time.Date(2026, time.October, 24, 23, 0, 0, 0, time.UTC),
+				time.Date(2026, time.October, 25, 0, 0, 0, 0, time.UTC),
+				time.Date(2026, time.October, 25, 1, 0, 0, 0, time.UTC),
+				time.Date(2026, time.October, 25, 2, 0, 0, 0, time.UTC),
+			},
+		},
+		"minutely - BYHOUR": {
+			recurrenceRule: timetypes.NewRecurrenceRuleValueMust("FREQ=MINUTELY;INTERVAL=20;BYHOUR=9"),
+			dtstart:        time.Date(2026, time.October, 16, 9, 40, 0, 0, time.UTC),
+			n:              3,
+			expectedOccurrences: []time.Time{
+				time.Date(2026, time.October, 16, 9, 40, 0, 0, time.UTC),
+				time.Date(2026, time.October, 17, 9, 0, 0, 0, time.UTC),
+				time.Date(2026, time.October, 17, 9, 20, 0, 0, time.UTC),
+			},
+		},
+		"never matches": {
+			recurrenceRule:      timetypes.NewRecurrenceRuleValueMust("FREQ=YEARLY;BYMONTH=2;BYMONTHDAY=30"),
+			dtstart:             time.Date(2026, time.October, 16, 0, 0, 0, 0, time.UTC),
+			n:                   3,
+			expectedOccurrences: []time.Time{},
+		},
+		"never matches - INTERVAL skips BYSECOND": {
+			recurrenceRule:      timetypes.NewRecurrenceRuleValueMust("FREQ=SECONDLY;INTERVAL=2;BYSECOND=1"),
+			dtstart:             time.Date(2026, time.October, 16, 0, 0, 0, 0, time.UTC),
+			n:                   1,
+			expectedOccurrences: []time.Time{},
+		},
+		"never matches - INTERVAL skips BYMINUTE": {
+			recurrenceRule:      timetypes.NewRecurrenceRuleValueMust("FREQ=MINUTELY;INTERVAL=2;BYMINUTE=1"),
+			dtstart:             time.Date(2026, time.October, 16, 0, 0, 0, 0, time.UTC),
+			n:                   1,
+			expectedOccurrences: []time.Time{},
+		},
+		"secondly - INTERVAL and BYSECOND": {
+			recurrenceRule: timetypes.NewRecurrenceRuleValueMust("FREQ=SECONDLY;INTERVAL=7;BYSECOND=0;BYMINUTE=0"),
+			dtstart:        time.Date(2026, time.October, 16, 0, 0, 0, 0, time.UTC),
+			n:              3,
+			expectedOccurrences: []time.Time{
+				time.Date(2026, time.October, 16, 0, 0, 0, 0, time.UTC),
+				time.Date(2026, time.October, 16, 7, 0, 0, 0, time.UTC),
+				time.Date(2026, time.October, 16, 14, 0, 0, 0, time.UTC),
+			},
+		},
+		"hourly - INTERVAL reaches BYHOUR after daylight saving time ends": {
+			recurrenceRule: timetypes.NewRecurrenceRuleValueMust("FREQ=HOURLY;INTERVAL=2;BYHOUR=1"),
+			dtstart:        time.Date(2026, time.October, 16, 0, 0, 0, 0, berlin),
+			n:              2,
+			expectedOccurrences: []time.Time{
+				time.Date(2026, time.October, 26, 0, 0, 0, 0, time.UTC),
+				time.Date(2026, time.October, 27, 0, 0, 0, 0, time.UTC),
+			},
+		},
+	}
+	for name, testCase := range testCases {
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			occurrences, diags := testCase.recurrenceRule.ValueOccurrences(testCase.dtstart, testCase.loc, testCase.n)
+
+			if diff := cmp.Diff(occurrences, testCase.expectedOccurrences); diff != "" {
+				t.Errorf("Unexpected occurrences (-got, +expected): %s", diff)
+			}
+
+			if diff := cmp.Diff(diags, testCase.expectedDiags); diff != "" {
+				t.Errorf("Unexpected diagnostics (-got, +expected): %s", diff)
+			}
+		})
+	}
+}
+
+func TestRecurrenceRule_ValueOccurrences_SearchLimit(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		recurrenceRule timetypes.RecurrenceRule
+		n              int
+	}{
+		"INTERVAL skips BYSECOND": {
+			recurrenceRule: timetypes.NewRecurrenceRuleValueMust("FREQ=SECONDLY;INTERVAL=2;BYSECOND=1"),
+			n:              1,
+		},
+		"INTERVAL skips BYMINUTE": {
+			recurrenceRule: timetypes.NewRecurrenceRuleValueMust("FREQ=MINUTELY;INTERVAL=2;BYMINUTE=1"),
+			n:              1,
+		},
+		"BYSECOND on a day that never matches": {
+			recurrenceRule: timetypes.NewRecurrenceRuleValueMust("FREQ=SECONDLY;BYSECOND=1;BYMONTH=2;BYMONTHDAY=30"),
+			n:              1,
+		},
+		"many sparse occurrences": {
+			recurrenceRule: timetypes.NewRecurrenceRuleValueMust("FREQ=SECONDLY;BYSECOND=59;BYMINUTE=59"),
+			n:              1_000_000,
+		},
+	}
+	for name, testCase := range testCases {
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			start := time.Now()
+
+			_, diags := testCase.recurrenceRule.ValueOccurrences(time.Date(2026, time.October, 16, 0, 0, 0, 0, time.UTC), nil, testCase.n)
+
+			if diags.HasError() {
+				t.Fatalf("Unexpected diagnostics: %v", diags)
+			}
+
+			if elapsed := time.Since(start); elapsed > 500*time.Millisecond {
+				t.Errorf("Expected ValueOccurrences to return within 500ms, took: %s", elapsed)
+			}
+		})
+	}
+}
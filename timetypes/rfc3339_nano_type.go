@@ -0,0 +1,77 @@
+// Copyright IBM Corp. 2023, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package timetypes
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+var (
+	_ basetypes.StringTypable = (*RFC3339NanoType)(nil)
+)
+
+// RFC3339NanoType is an attribute type that represents a valid RFC 3339 string which preserves fractional seconds.
+// Semantic equality logic is defined for RFC3339NanoType such that inconsequential differences between the `Z` suffix
+// and a `00:00` UTC offset, as well as trailing zeros in fractional seconds, are ignored.
+type RFC3339NanoType struct {
+	basetypes.StringType
+}
+
+// String returns a human-readable string of the type name.
+func (t RFC3339NanoType) String() string {
+	return "timetypes.RFC3339NanoType"
+}
+
+// ValueType returns the Value type.
+func (t RFC3339NanoType) ValueType(ctx context.Context) attr.Value {
+	return RFC3339Nano{}
+}
+
+// Equal returns true if the given type is equivalent.
+func (t RFC3339NanoType) Equal(o attr.Type) bool {
+	other, ok := o.(RFC3339NanoType)
+
+	if !ok {
+		return false
+	}
+
+	return t.StringType.Equal(other.StringType)
+}
+
+// ValueFromString returns a StringValuable type given a StringValue.
+func (t RFC3339NanoType) ValueFromString(ctx context.Context, in basetypes.StringValue) (basetypes.StringValuable, diag.Diagnostics) {
+	return RFC3339Nano{
+		StringValue: in,
+	}, nil
+}
+
+// ValueFromTerraform returns a Value given a tftypes.Value.  This is meant to convert the tftypes.Value into a more convenient Go type
+// for the provider to consume the data with.
+func (t RFC3339NanoType) ValueFromTerraform(ctx context.Context, in tftypes.Value) (attr.Value, error) {
+	attrValue, err := t.StringType.ValueFromTerraform(ctx, in)
+
+	if err != nil {
+		return nil, err
+	}
+
+	stringValue, ok := attrValue.(basetypes.StringValue)
+
+	if !ok {
+		return nil, fmt.Errorf("unexpected value type of %T", attrValue)
+	}
+
+	stringValuable, diags := t.ValueFromString(ctx, stringValue)
+
+	if diags.HasError() {
+		return nil, fmt.Errorf("unexpected error converting StringValue to StringValuable: %v", diags)
+	}
+
+	return stringValuable, nil
+}
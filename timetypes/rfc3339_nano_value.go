@@ -0,0 +1,239 @@
+// Copyright IBM Corp. 2023, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package timetypes
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/attr/xattr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+)
+
+var (
+	_ basetypes.StringValuableWithSemanticEquals = (*RFC3339Nano)(nil)
+	_ xattr.ValidateableAttribute                = (*RFC3339Nano)(nil)
+	_ function.ValidateableParameter             = (*RFC3339Nano)(nil)
+)
+
+// RFC3339Nano represents a valid RFC3339-formatted string which preserves fractional seconds, such as
+// `2023-07-25T20:43:16.123Z`. Semantic equality logic is defined for RFC3339Nano such that inconsequential
+// differences between the `Z` suffix and a `00:00` UTC offset, as well as trailing zeros in fractional
+// seconds, are ignored.
+type RFC3339Nano struct {
+	basetypes.StringValue
+}
+
+// Type returns an RFC3339NanoType.
+func (v RFC3339Nano) Type(_ context.Context) attr.Type {
+	return RFC3339NanoType{}
+}
+
+// Equal returns true if the given value is equivalent.
+func (v RFC3339Nano) Equal(o attr.Value) bool {
+	other, ok := o.(RFC3339Nano)
+
+	if !ok {
+		return false
+	}
+
+	return v.StringValue.Equal(other.StringValue)
+}
+
+// StringSemanticEquals returns true if the given RFC3339Nano string value is semantically equal to the current RFC3339Nano string value.
+// This comparison utilizes time.Parse to create time.Time instances and then compares the resulting time.RFC3339Nano-formatted
+// string representations, which keep fractional seconds up to nanosecond precision but remove trailing zeros.
+//
+// Examples:
+//   - `2023-07-25T20:43:16.500Z` is semantically equal to `2023-07-25T20:43:16.5Z`
+//   - `2023-07-25T20:43:16.000Z` is semantically equal to `2023-07-25T20:43:16Z`
+//   - `2023-07-25T20:43:16.5+00:00` is semantically equal to `2023-07-25T20:43:16.5Z`
+//
+// Counterexamples:
+//   - `2023-07-25T20:43:16.5Z` is NOT considered to be semantically equal to `2023-07-25T20:43:16Z`.
+//   - `2023-07-25T23:43:16.5+00:00` expresses the same time as `2023-07-25T20:43:16.5-03:00` but is NOT considered
+//     to be semantically equal.
+//
+// See RFC 3339 for more details on the string format: https://www.rfc-editor.org/rfc/rfc3339.html.
+func (v RFC3339Nano) StringSemanticEquals(_ context.Context, newValuable basetypes.StringValuable) (bool, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	newValue, ok := newValuable.(RFC3339Nano)
+	if !ok {
+		diags.AddError(
+			"Semantic Equality Check Error",
+			"An unexpected value type was received while performing semantic equality checks. "+
+				"Please report this to the provider developers.\n\n"+
+				"Expected Value Type: "+fmt.Sprintf("%T", v)+"\n"+
+				"Got Value Type: "+fmt.Sprintf("%T", newValuable),
+		)
+
+		return false, diags
+	}
+
+	// RFC3339 strings are already validated at this point, ignoring errors
+	newRFC3339time, _ := time.Parse(time.RFC3339Nano, newValue.ValueString())
+	currentRFC3339time, _ := time.Parse(time.RFC3339Nano, v.ValueString())
+
+	return currentRFC3339time.Format(time.RFC3339Nano) == newRFC3339time.Format(time.RFC3339Nano), diags
+}
+
+// ValidateAttribute implements attribute value validation. This type requires the value to be a String value that
+// is valid RFC 3339 format. This utilizes the Go `time` library which does not strictly adhere to the RFC 3339
+// standard and may allow strings that are not valid RFC 3339 strings
+//
+// See https://github.com/golang/go/issues/54580 for more info on the Go `time` library's RFC 3339 parsing differences.
+func (v RFC3339Nano) ValidateAttribute(ctx context.Context, req xattr.ValidateAttributeRequest, resp *xattr.ValidateAttributeResponse) {
+	if v.IsUnknown() || v.IsNull() {
+		return
+	}
+
+	if _, err := time.Parse(time.RFC3339Nano, v.ValueString()); err != nil {
+		resp.Diagnostics.Append(diag.WithPath(req.Path, rfc3339InvalidStringDiagnostic(v.ValueString(), err)))
+
+		return
+	}
+}
+
+// ValidateParameter implements provider-defined function parameter value validation. This type requires the value to
+// be a String value that is valid RFC 3339 format. This utilizes the Go `time` library which does not strictly
+// adhere to the RFC 3339 standard and may allow strings that are not valid RFC 3339 strings
+//
+// See https://github.com/golang/go/issues/54580 for more info on the Go `time` library's RFC 3339 parsing differences.
+func (v RFC3339Nano) ValidateParameter(ctx context.Context, req function.ValidateParameterRequest, resp *function.ValidateParameterResponse) {
+	if v.IsUnknown() || v.IsNull() {
+		return
+	}
+
+	if _, err := time.Parse(time.RFC3339Nano, v.ValueString()); err != nil {
+		resp.Error = function.NewArgumentFuncError(
+			req.Position,
+			"Invalid RFC3339 String Value: "+
+				"A string value was provided that is not valid RFC3339 string format.\n\n"+
+				"Given Value: "+v.ValueString()+"\n"+
+				"Error: "+err.Error(),
+		)
+
+		return
+	}
+}
+
+// ValueRFC3339Time creates a new time.Time instance with the RFC3339Nano StringValue, including any fractional seconds.
+// A null or unknown value will produce an error diagnostic.
+func (v RFC3339Nano) ValueRFC3339Time() (time.Time, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	if v.IsNull() {
+		diags.Append(diag.NewErrorDiagnostic("RFC3339Nano ValueRFC3339Time Error", "RFC3339 string value is null"))
+		return time.Time{}, diags
+	}
+
+	if v.IsUnknown() {
+		diags.Append(diag.NewErrorDiagnostic("RFC3339Nano ValueRFC3339Time Error", "RFC3339 string value is unknown"))
+		return time.Time{}, diags
+	}
+
+	rfc3339Time, err := time.Parse(time.RFC3339Nano, v.ValueString())
+	if err != nil {
+		diags.Append(diag.NewErrorDiagnostic("RFC3339Nano ValueRFC3339Time Error", err.Error()))
+		return time.Time{}, diags
+	}
+
+	return rfc3339Time, nil
+}
+
+// NewRFC3339NanoNull creates an RFC3339Nano with a null value. Determine whether the value is null via IsNull method.
+func NewRFC3339NanoNull() RFC3339Nano {
+	return RFC3339Nano{
+		StringValue: basetypes.NewStringNull(),
+	}
+}
+
+// NewRFC3339NanoUnknown creates an RFC3339Nano with an unknown value. Determine whether the value is unknown via IsUnknown method.
+func NewRFC3339NanoUnknown() RFC3339Nano {
+	return RFC3339Nano{
+		StringValue: basetypes.NewStringUnknown(),
+	}
+}
+
+// NewRFC3339NanoTimeValue creates an RFC3339Nano with a known value. Fractional seconds are preserved.
+func NewRFC3339NanoTimeValue(value time.Time) RFC3339Nano {
+	return RFC3339Nano{
+		StringValue: basetypes.NewStringValue(value.Format(time.RFC3339Nano)),
+	}
+}
+
+// NewRFC3339NanoTimePointerValue creates an RFC3339Nano with a null value if nil or
+// a known value. Fractional seconds are preserved.
+func NewRFC3339NanoTimePointerValue(value *time.Time) RFC3339Nano {
+	if value == nil {
+		return NewRFC3339NanoNull()
+	}
+
+	return RFC3339Nano{
+		StringValue: basetypes.NewStringValue(value.Format(time.RFC3339Nano)),
+	}
+}
+
+// NewRFC3339NanoValue creates an RFC3339Nano with a known value or raises an error
+// diagnostic if the string is not RFC3339 format.
+func NewRFC3339NanoValue(value string) (RFC3339Nano, diag.Diagnostics) {
+	_, err := time.Parse(time.RFC3339Nano, value)
+
+	if err != nil {
+		// Returning an unknown value will guarantee that, as a last resort,
+		// Terraform will return an error if attempting to store into state.
+		return NewRFC3339NanoUnknown(), diag.Diagnostics{rfc3339InvalidStringDiagnostic(value, err)}
+	}
+
+	return RFC3339Nano{
+		StringValue: basetypes.NewStringValue(value),
+	}, nil
+}
+
+// NewRFC3339NanoValueMust creates an RFC3339Nano with a known value or raises a panic
+// if the string is not RFC3339 format.
+//
+// This creation function is only recommended to create RFC3339Nano values which
+// either will not potentially affect practitioners, such as testing, or within
+// exhaustively tested provider logic.
+func NewRFC3339NanoValueMust(value string) RFC3339Nano {
+	_, err := time.Parse(time.RFC3339Nano, value)
+
+	if err != nil {
+		panic(fmt.Sprintf("Invalid RFC3339Nano String Value (%s): %s", value, err))
+	}
+
+	return RFC3339Nano{
+		StringValue: basetypes.NewStringValue(value),
+	}
+}
+
+// NewRFC3339NanoPointerValue creates an RFC3339Nano with a null value if nil, a known
+// value, or raises an error diagnostic if the string is not RFC3339 format.
+func NewRFC3339NanoPointerValue(value *string) (RFC3339Nano, diag.Diagnostics) {
+	if value == nil {
+		return NewRFC3339NanoNull(), nil
+	}
+
+	return NewRFC3339NanoValue(*value)
+}
+
+// NewRFC3339NanoPointerValueMust creates an RFC3339Nano with a null value if nil, a
+// known value, or raises a panic if the string is not RFC3339 format.
+//
+// This creation function is only recommended to create RFC3339Nano values which
+// either will not potentially affect practitioners, such as testing, or within
+// exhaustively tested provider logic.
+func NewRFC3339NanoPointerValueMust(value *string) RFC3339Nano {
+	if value == nil {
+		return NewRFC3339NanoNull()
+	}
+
+	return NewRFC3339NanoValueMust(*value)
+}
@@ -0,0 +1,307 @@
+// Copyright IBM Corp. 2023, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package timetypes_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-framework/attr/xattr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+
+	"github.com/hashicorp/terraform-plugin-framework-timetypes/timetypes"
+)
+
+func TestRFC3339Nano_StringSemanticEquals(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		currentRFC3339time timetypes.RFC3339Nano
+		givenRFC3339time   basetypes.StringValuable
+		expectedMatch      bool
+		expectedDiags      diag.Diagnostics
+	}{
+		"not equal - different fractional seconds": {
+			currentRFC3339time: timetypes.NewRFC3339NanoValueMust("2023-07-25T23:43:16.5Z"),
+			givenRFC3339time:   timetypes.NewRFC3339NanoValueMust("2023-07-25T23:43:16.501Z"),
+			expectedMatch:      false,
+		},
+		"not equal - fractional seconds and whole seconds": {
+			currentRFC3339time: timetypes.NewRFC3339NanoValueMust("2023-07-25T23:43:16.5Z"),
+			givenRFC3339time:   timetypes.NewRFC3339NanoValueMust("2023-07-25T23:43:16Z"),
+			expectedMatch:      false,
+		},
+		"not equal - nanosecond difference": {
+			currentRFC3339time: timetypes.NewRFC3339NanoValueMust("2023-07-25T23:43:16.000000001Z"),
+			givenRFC3339time:   timetypes.NewRFC3339NanoValueMust("2023-07-25T23:43:16.000000002Z"),
+			expectedMatch:      false,
+		},
+		"not equal - UTC time and local time": {
+			currentRFC3339time: timetypes.NewRFC3339NanoValueMust("2023-07-25T23:43:16.5Z"),
+			givenRFC3339time:   timetypes.NewRFC3339NanoValueMust("2023-07-25T20:43:16.5-03:00"),
+			expectedMatch:      false,
+		},
+		"semantically equal - trailing zeros": {
+			currentRFC3339time: timetypes.NewRFC3339NanoValueMust("2023-07-25T23:43:16.500Z"),
+			givenRFC3339time:   timetypes.NewRFC3339NanoValueMust("2023-07-25T23:43:16.5Z"),
+			expectedMatch:      true,
+		},
+		"semantically equal - zero fractional seconds and whole seconds": {
+			currentRFC3339time: timetypes.NewRFC3339NanoValueMust("2023-07-25T23:43:16.000Z"),
+			givenRFC3339time:   timetypes.NewRFC3339NanoValueMust("2023-07-25T23:43:16Z"),
+			expectedMatch:      true,
+		},
+		"semantically equal - Z suffix and positive zero num offset": {
+			currentRFC3339time: timetypes.NewRFC3339NanoValueMust("2023-07-25T23:43:16.123Z"),
+			givenRFC3339time:   timetypes.NewRFC3339NanoValueMust("2023-07-25T23:43:16.123+00:00"),
+			expectedMatch:      true,
+		},
+		"semantically equal - byte for byte match": {
+			currentRFC3339time: timetypes.NewRFC3339NanoValueMust("2023-07-25T23:43:16.123-05:00"),
+			givenRFC3339time:   timetypes.NewRFC3339NanoValueMust("2023-07-25T23:43:16.123-05:00"),
+			expectedMatch:      true,
+		},
+		"error - not given RFC3339Nano value": {
+			currentRFC3339time: timetypes.NewRFC3339NanoValueMust("2023-07-25T23:43:16Z"),
+			givenRFC3339time:   timetypes.NewRFC3339ValueMust("2023-07-25T23:43:16Z"),
+			expectedMatch:      false,
+			expectedDiags: diag.Diagnostics{
+				diag.NewErrorDiagnostic(
+					"Semantic Equality Check Error",
+					"An unexpected value type was received while performing semantic equality checks. "+
+						"Please report this to the provider developers.\n\n"+
+						"Expected Value Type: timetypes.RFC3339Nano\n"+
+						"Got Value Type: timetypes.RFC3339",
+				),
+			},
+		},
+	}
+	for name, testCase := range testCases {
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			match, diags := testCase.currentRFC3339time.StringSemanticEquals(context.Background(), testCase.givenRFC3339time)
+
+			if testCase.expectedMatch != match {
+				t.Errorf("Expected StringSemanticEquals to return: %t, but got: %t", testCase.expectedMatch, match)
+			}
+
+			if diff := cmp.Diff(diags, testCase.expectedDiags); diff != "" {
+				t.Errorf("Unexpected diagnostics (-got, +expected): %s", diff)
+			}
+		})
+	}
+}
+
+func TestRFC3339NanoValidateAttribute(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		RFC3339Nano   timetypes.RFC3339Nano
+		expectedDiags diag.Diagnostics
+	}{
+		"empty-struct": {
+			RFC3339Nano: timetypes.RFC3339Nano{},
+		},
+		"null": {
+			RFC3339Nano: timetypes.NewRFC3339NanoNull(),
+		},
+		"unknown": {
+			RFC3339Nano: timetypes.NewRFC3339NanoUnknown(),
+		},
+		"valid RFC3339 - whole seconds": {
+			RFC3339Nano: timetypes.NewRFC3339NanoValueMust("2023-07-25T20:43:16Z"),
+		},
+		"valid RFC3339 - milliseconds": {
+			RFC3339Nano: timetypes.NewRFC3339NanoValueMust("2023-07-25T20:43:16.123Z"),
+		},
+		"valid RFC3339 - nanoseconds with UTC Offset": {
+			RFC3339Nano: timetypes.NewRFC3339NanoValueMust("2023-07-25T20:43:16.123456789-05:00"),
+		},
+		"invalid RFC3339 - no time": {
+			RFC3339Nano: timetypes.RFC3339Nano{
+				StringValue: basetypes.NewStringValue("2023-07-25T"),
+			},
+			expectedDiags: diag.Diagnostics{
+				diag.NewAttributeErrorDiagnostic(
+					path.Root("test"),
+					"Invalid RFC3339 String Value",
+					"A string value was provided that is not valid RFC3339 string format.\n\n"+
+						"Given Value: 2023-07-25T\n"+
+						"Error: parsing time \"2023-07-25T\" as \"2006-01-02T15:04:05.999999999Z07:00\": "+
+						"cannot parse \"\" as \"15\"",
+				),
+			},
+		},
+	}
+	for name, testCase := range testCases {
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			resp := xattr.ValidateAttributeResponse{}
+
+			testCase.RFC3339Nano.ValidateAttribute(
+				context.Background(),
+				xattr.ValidateAttributeRequest{
+					Path: path.Root("test"),
+				},
+				&resp,
+			)
+
+			if diff := cmp.Diff(resp.Diagnostics, testCase.expectedDiags); diff != "" {
+				t.Errorf("Unexpected diagnostics (-got, +expected): %s", diff)
+			}
+		})
+	}
+}
+
+func TestRFC3339NanoValidateParameter(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		RFC3339Nano     timetypes.RFC3339Nano
+		expectedFuncErr *function.FuncError
+	}{
+		"empty-struct": {
+			RFC3339Nano: timetypes.RFC3339Nano{},
+		},
+		"null": {
+			RFC3339Nano: timetypes.NewRFC3339NanoNull(),
+		},
+		"unknown": {
+			RFC3339Nano: timetypes.NewRFC3339NanoUnknown(),
+		},
+		"valid RFC3339 - milliseconds": {
+			RFC3339Nano: timetypes.NewRFC3339NanoValueMust("2023-07-25T20:43:16.123Z"),
+		},
+		"invalid RFC3339 - normal string": {
+			RFC3339Nano: timetypes.RFC3339Nano{
+				StringValue: basetypes.NewStringValue("notvalidrfc3339"),
+			},
+			expectedFuncErr: function.NewArgumentFuncError(
+				0,
+				"Invalid RFC3339 String Value: "+
+					"A string value was provided that is not valid RFC3339 string format.\n\n"+
+					"Given Value: notvalidrfc3339\n"+
+					"Error: parsing time \"notvalidrfc3339\" as \"2006-01-02T15:04:05.999999999Z07:00\": "+
+					"cannot parse \"notvalidrfc3339\" as \"2006\"",
+			),
+		},
+	}
+	for name, testCase := range testCases {
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			resp := function.ValidateParameterResponse{}
+
+			testCase.RFC3339Nano.ValidateParameter(
+				context.Background(),
+				function.ValidateParameterRequest{
+					Position: int64(0),
+				},
+				&resp,
+			)
+
+			if diff := cmp.Diff(resp.Error, testCase.expectedFuncErr); diff != "" {
+				t.Errorf("Unexpected diagnostics (-got, +expected): %s", diff)
+			}
+		})
+	}
+}
+
+func TestRFC3339Nano_ValueRFC3339Time(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		RFC3339Nano       timetypes.RFC3339Nano
+		expectedTimestamp string
+		expectedDiags     diag.Diagnostics
+	}{
+		"RFC3339 string value is null ": {
+			RFC3339Nano: timetypes.NewRFC3339NanoNull(),
+			expectedDiags: diag.Diagnostics{
+				diag.NewErrorDiagnostic(
+					"RFC3339Nano ValueRFC3339Time Error",
+					"RFC3339 string value is null",
+				),
+			},
+		},
+		"RFC3339 string value is unknown ": {
+			RFC3339Nano: timetypes.NewRFC3339NanoUnknown(),
+			expectedDiags: diag.Diagnostics{
+				diag.NewErrorDiagnostic(
+					"RFC3339Nano ValueRFC3339Time Error",
+					"RFC3339 string value is unknown",
+				),
+			},
+		},
+		"valid RFC3339 Timestamp - milliseconds": {
+			RFC3339Nano:       timetypes.NewRFC3339NanoValueMust("2023-07-25T23:43:16.123Z"),
+			expectedTimestamp: "2023-07-25T23:43:16.123Z",
+		},
+		"valid RFC3339 Timestamp - nanoseconds with EDT offset": {
+			RFC3339Nano:       timetypes.NewRFC3339NanoValueMust("2023-07-25T23:43:16.123456789-04:00"),
+			expectedTimestamp: "2023-07-25T23:43:16.123456789-04:00",
+		},
+	}
+	for name, testCase := range testCases {
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			rfc3339Time, diags := testCase.RFC3339Nano.ValueRFC3339Time()
+			expectedRFC3339Time, _ := time.Parse(time.RFC3339Nano, testCase.expectedTimestamp)
+
+			if !rfc3339Time.Equal(expectedRFC3339Time) || rfc3339Time.Nanosecond() != expectedRFC3339Time.Nanosecond() {
+				t.Errorf("Unexpected difference in time.Time, got: %s, expected: %s", rfc3339Time, expectedRFC3339Time)
+			}
+
+			if diff := cmp.Diff(diags, testCase.expectedDiags); diff != "" {
+				t.Errorf("Unexpected diagnostics (-got, +expected): %s", diff)
+			}
+		})
+	}
+}
+
+func TestNewRFC3339NanoTimeValue(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		time     time.Time
+		expected timetypes.RFC3339Nano
+	}{
+		"whole seconds": {
+			time:     time.Date(2023, time.July, 25, 23, 43, 16, 0, time.UTC),
+			expected: timetypes.NewRFC3339NanoValueMust("2023-07-25T23:43:16Z"),
+		},
+		"milliseconds": {
+			time:     time.Date(2023, time.July, 25, 23, 43, 16, 123000000, time.UTC),
+			expected: timetypes.NewRFC3339NanoValueMust("2023-07-25T23:43:16.123Z"),
+		},
+		"nanoseconds with offset": {
+			time:     time.Date(2023, time.July, 25, 23, 43, 16, 1, time.FixedZone("", -4*60*60)),
+			expected: timetypes.NewRFC3339NanoValueMust("2023-07-25T23:43:16.000000001-04:00"),
+		},
+	}
+	for name, testCase := range testCases {
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got := timetypes.NewRFC3339NanoTimeValue(testCase.time)
+
+			if !got.Equal(testCase.expected) {
+				t.Errorf("Expected %s, got %s", testCase.expected, got)
+			}
+		})
+	}
+}
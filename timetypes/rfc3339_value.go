@@ -85,6 +85,7 @@ func (v RFC3339) StringSemanticEquals(_ context.Context, newValuable basetypes.S
 // standard and may allow strings that are not valid RFC 3339 strings
 //
 // See https://github.com/golang/go/issues/54580 for more info on the Go `time` library's RFC 3339 parsing differences.
+// Use StrictRFC3339 to validate against the RFC 3339 grammar exactly.
 func (v RFC3339) ValidateAttribute(ctx context.Context, req xattr.ValidateAttributeRequest, resp *xattr.ValidateAttributeResponse) {
 	if v.IsUnknown() || v.IsNull() {
 		return
@@ -102,6 +103,7 @@ func (v RFC3339) ValidateAttribute(ctx context.Context, req xattr.ValidateAttrib
 // adhere to the RFC 3339 standard and may allow strings that are not valid RFC 3339 strings
 //
 // See https://github.com/golang/go/issues/54580 for more info on the Go `time` library's RFC 3339 parsing differences.
+// Use StrictRFC3339 to validate against the RFC 3339 grammar exactly.
 func (v RFC3339) ValidateParameter(ctx context.Context, req function.ValidateParameterRequest, resp *function.ValidateParameterResponse) {
 	if v.IsUnknown() || v.IsNull() {
 		return
@@ -164,6 +166,14 @@ func NewRFC3339TimeValue(value time.Time) RFC3339 {
 	}
 }
 
+// newRFC3339TimeNanoValue creates an RFC3339 with a known value, preserving any fractional seconds. This is used by
+// the ToRFC3339Value conversions of other types, so that none of them lose precision.
+func newRFC3339TimeNanoValue(value time.Time) RFC3339 {
+	return RFC3339{
+		StringValue: basetypes.NewStringValue(value.Format(time.RFC3339Nano)),
+	}
+}
+
 // NewRFC3339TimePointerValue creates an RFC3339 with a null value if nil or
 // a known value.
 func NewRFC3339TimePointerValue(value *time.Time) RFC3339 {
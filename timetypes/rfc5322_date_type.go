@@ -0,0 +1,77 @@
+// Copyright IBM Corp. 2023, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package timetypes
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+var (
+	_ basetypes.StringTypable = (*RFC5322DateType)(nil)
+)
+
+// RFC5322DateType is an attribute type that represents a valid RFC 5322 date-time string, such as
+// `Fri, 16 Oct 2026 12:00:00 +0200`. Semantic equality logic is defined for RFC5322DateType such that dates with the
+// same instant and UTC offset are considered equal, regardless of an optional day of the week or obsolete zone name.
+type RFC5322DateType struct {
+	basetypes.StringType
+}
+
+// String returns a human-readable string of the type name.
+func (t RFC5322DateType) String() string {
+	return "timetypes.RFC5322DateType"
+}
+
+// ValueType returns the Value type.
+func (t RFC5322DateType) ValueType(ctx context.Context) attr.Value {
+	return RFC5322Date{}
+}
+
+// Equal returns true if the given type is equivalent.
+func (t RFC5322DateType) Equal(o attr.Type) bool {
+	other, ok := o.(RFC5322DateType)
+
+	if !ok {
+		return false
+	}
+
+	return t.StringType.Equal(other.StringType)
+}
+
+// ValueFromString returns a StringValuable type given a StringValue.
+func (t RFC5322DateType) ValueFromString(ctx context.Context, in basetypes.StringValue) (basetypes.StringValuable, diag.Diagnostics) {
+	return RFC5322Date{
+		StringValue: in,
+	}, nil
+}
+
+// ValueFromTerraform returns a Value given a tftypes.Value.  This is meant to convert the tftypes.Value into a more convenient Go type
+// for the provider to consume the data with.
+func (t RFC5322DateType) ValueFromTerraform(ctx context.Context, in tftypes.Value) (attr.Value, error) {
+	attrValue, err := t.StringType.ValueFromTerraform(ctx, in)
+
+	if err != nil {
+		return nil, err
+	}
+
+	stringValue, ok := attrValue.(basetypes.StringValue)
+
+	if !ok {
+		return nil, fmt.Errorf("unexpected value type of %T", attrValue)
+	}
+
+	stringValuable, diags := t.ValueFromString(ctx, stringValue)
+
+	if diags.HasError() {
+		return nil, fmt.Errorf("unexpected error converting StringValue to StringValuable: %v", diags)
+	}
+
+	return stringValuable, nil
+}
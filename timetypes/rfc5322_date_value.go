@@ -0,0 +1,469 @@
+// Copyright IBM Corp. 2023, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package timetypes
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/attr/xattr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+)
+
+var (
+	_ basetypes.StringValuableWithSemanticEquals = (*RFC5322Date)(nil)
+	_ xattr.ValidateableAttribute                = (*RFC5322Date)(nil)
+	_ function.ValidateableParameter             = (*RFC5322Date)(nil)
+)
+
+// rfc5322DateObsoleteZones are the UTC offsets in seconds of the obsolete RFC 5322 zone names. Military zone names
+// are not included, as they are always treated as `-0000`.
+var rfc5322DateObsoleteZones = map[string]int{
+	"UT":  0,
+	"GMT": 0,
+	"EST": -5 * 60 * 60,
+	"EDT": -4 * 60 * 60,
+	"CST": -6 * 60 * 60,
+	"CDT": -5 * 60 * 60,
+	"MST": -7 * 60 * 60,
+	"MDT": -6 * 60 * 60,
+	"PST": -8 * 60 * 60,
+	"PDT": -7 * 60 * 60,
+}
+
+// RFC5322Date represents a valid RFC 5322 date-time string, as used in email headers, such as
+// `Fri, 16 Oct 2026 12:00:00 +0200`.
+//
+// The day of the week is optional, but must match the date if present. Names are case-insensitive, the day of the month
+// may be one or two digits and seconds may be omitted. The obsolete syntax of two and three digit years and zone names
+// such as `GMT` or `EST` is also accepted. As recommended by RFC 5322, two digit years less than 50 are in the 2000s,
+// other two and three digit years are added to 1900 and single letter military zone names are treated as `-0000`.
+// Comments and line folding are not supported.
+//
+// See https://www.rfc-editor.org/rfc/rfc5322.html#section-3.3 for more details on the string format.
+type RFC5322Date struct {
+	basetypes.StringValue
+}
+
+// Type returns an RFC5322DateType.
+func (v RFC5322Date) Type(_ context.Context) attr.Type {
+	return RFC5322DateType{}
+}
+
+// Equal returns true if the given value is equivalent.
+func (v RFC5322Date) Equal(o attr.Value) bool {
+	other, ok := o.(RFC5322Date)
+
+	if !ok {
+		return false
+	}
+
+	return v.StringValue.Equal(other.StringValue)
+}
+
+// StringSemanticEquals returns true if the given RFC 5322 date string value is semantically equal to the current RFC
+// 5322 date string value. Both values are parsed into time.Time instances and then compared as RFC 3339-formatted
+// strings, consistent with RFC3339 semantic equality, so the instant and UTC offset must both be equal.
+//
+// Examples:
+//   - `Fri, 16 Oct 2026 12:00:00 +0200` is semantically equal to `16 Oct 2026 12:00 +0200`
+//   - `Fri, 16 Oct 2026 12:00:00 GMT` is semantically equal to `Fri, 16 Oct 2026 12:00:00 +0000`
+//   - `Fri, 16 Oct 2026 12:00:00 EST` is semantically equal to `Fri, 16 Oct 2026 12:00:00 -0500`
+//
+// Counterexamples:
+//   - `Fri, 16 Oct 2026 12:00:00 +0200` expresses the same time as `Fri, 16 Oct 2026 10:00:00 +0000` but is NOT
+//     considered to be semantically equal.
+func (v RFC5322Date) StringSemanticEquals(_ context.Context, newValuable basetypes.StringValuable) (bool, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	newValue, ok := newValuable.(RFC5322Date)
+	if !ok {
+		diags.AddError(
+			"Semantic Equality Check Error",
+			"An unexpected value type was received while performing semantic equality checks. "+
+				"Please report this to the provider developers.\n\n"+
+				"Expected Value Type: "+fmt.Sprintf("%T", v)+"\n"+
+				"Got Value Type: "+fmt.Sprintf("%T", newValuable),
+		)
+
+		return false, diags
+	}
+
+	// RFC 5322 date strings are already validated at this point, ignoring errors
+	currentTime, _ := parseRFC5322Date(v.ValueString())
+	newTime, _ := parseRFC5322Date(newValue.ValueString())
+
+	return currentTime.Format(time.RFC3339) == newTime.Format(time.RFC3339), diags
+}
+
+// ValidateAttribute implements attribute value validation. This type requires the value to be a String value that
+// is a valid RFC 5322 date-time.
+func (v RFC5322Date) ValidateAttribute(ctx context.Context, req xattr.ValidateAttributeRequest, resp *xattr.ValidateAttributeResponse) {
+	if v.IsUnknown() || v.IsNull() {
+		return
+	}
+
+	if _, err := parseRFC5322Date(v.ValueString()); err != nil {
+		resp.Diagnostics.Append(diag.WithPath(req.Path, rfc5322DateInvalidStringDiagnostic(v.ValueString(), err)))
+
+		return
+	}
+}
+
+// ValidateParameter implements provider-defined function parameter value validation. This type requires the value to
+// be a String value that is a valid RFC 5322 date-time.
+func (v RFC5322Date) ValidateParameter(ctx context.Context, req function.ValidateParameterRequest, resp *function.ValidateParameterResponse) {
+	if v.IsUnknown() || v.IsNull() {
+		return
+	}
+
+	if _, err := parseRFC5322Date(v.ValueString()); err != nil {
+		resp.Error = function.NewArgumentFuncError(
+			req.Position,
+			"Invalid RFC 5322 Date String Value: "+
+				"A string value was provided that is not valid RFC 5322 date-time string format, such as \"Fri, 16 Oct 2026 12:00:00 +0200\".\n\n"+
+				"Given Value: "+v.ValueString()+"\n"+
+				"Error: "+err.Error(),
+		)
+
+		return
+	}
+}
+
+// ValueRFC5322DateTime creates a new time.Time instance with the RFC5322Date StringValue, using a fixed location of
+// the zone offset. A null or unknown value will produce an error diagnostic.
+func (v RFC5322Date) ValueRFC5322DateTime() (time.Time, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	if v.IsNull() {
+		diags.Append(diag.NewErrorDiagnostic("RFC5322Date ValueRFC5322DateTime Error", "RFC 5322 date string value is null"))
+		return time.Time{}, diags
+	}
+
+	if v.IsUnknown() {
+		diags.Append(diag.NewErrorDiagnostic("RFC5322Date ValueRFC5322DateTime Error", "RFC 5322 date string value is unknown"))
+		return time.Time{}, diags
+	}
+
+	rfc5322Time, err := parseRFC5322Date(v.ValueString())
+	if err != nil {
+		diags.Append(diag.NewErrorDiagnostic("RFC5322Date ValueRFC5322DateTime Error", err.Error()))
+		return time.Time{}, diags
+	}
+
+	return rfc5322Time, nil
+}
+
+// NewRFC5322DateNull creates an RFC5322Date with a null value. Determine whether the value is null via IsNull method.
+func NewRFC5322DateNull() RFC5322Date {
+	return RFC5322Date{
+		StringValue: basetypes.NewStringNull(),
+	}
+}
+
+// NewRFC5322DateUnknown creates an RFC5322Date with an unknown value. Determine whether the value is unknown via IsUnknown method.
+func NewRFC5322DateUnknown() RFC5322Date {
+	return RFC5322Date{
+		StringValue: basetypes.NewStringUnknown(),
+	}
+}
+
+// NewRFC5322DateTimeValue creates an RFC5322Date with a known value, formatted with a day of the week and a numeric
+// zone, such as `Fri, 16 Oct 2026 12:00:00 +0200`. Any fractional seconds are truncated.
+func NewRFC5322DateTimeValue(value time.Time) RFC5322Date {
+	return RFC5322Date{
+		StringValue: basetypes.NewStringValue(value.Format(time.RFC1123Z)),
+	}
+}
+
+// NewRFC5322DateTimePointerValue creates an RFC5322Date with a null value if
+// nil or a known value.
+func NewRFC5322DateTimePointerValue(value *time.Time) RFC5322Date {
+	if value == nil {
+		return NewRFC5322DateNull()
+	}
+
+	return NewRFC5322DateTimeValue(*value)
+}
+
+// NewRFC5322DateValue creates an RFC5322Date with a known value or raises an
+// error diagnostic if the string is not RFC 5322 date-time format.
+func NewRFC5322DateValue(value string) (RFC5322Date, diag.Diagnostics) {
+	_, err := parseRFC5322Date(value)
+
+	if err != nil {
+		// Returning an unknown value will guarantee that, as a last resort,
+		// Terraform will return an error if attempting to store into state.
+		return NewRFC5322DateUnknown(), diag.Diagnostics{rfc5322DateInvalidStringDiagnostic(value, err)}
+	}
+
+	return RFC5322Date{
+		StringValue: basetypes.NewStringValue(value),
+	}, nil
+}
+
+// NewRFC5322DateValueMust creates an RFC5322Date with a known value or raises
+// a panic if the string is not RFC 5322 date-time format.
+//
+// This creation function is only recommended to create RFC5322Date values which
+// either will not potentially affect practitioners, such as testing, or within
+// exhaustively tested provider logic.
+func NewRFC5322DateValueMust(value string) RFC5322Date {
+	_, err := parseRFC5322Date(value)
+
+	if err != nil {
+		panic(fmt.Sprintf("Invalid RFC 5322 Date String Value (%s): %s", value, err))
+	}
+
+	return RFC5322Date{
+		StringValue: basetypes.NewStringValue(value),
+	}
+}
+
+// NewRFC5322DatePointerValue creates an RFC5322Date with a null value if nil,
+// a known value, or raises an error diagnostic if the string is not RFC 5322
+// date-time format.
+func NewRFC5322DatePointerValue(value *string) (RFC5322Date, diag.Diagnostics) {
+	if value == nil {
+		return NewRFC5322DateNull(), nil
+	}
+
+	return NewRFC5322DateValue(*value)
+}
+
+// NewRFC5322DatePointerValueMust creates an RFC5322Date with a null value if
+// nil, a known value, or raises a panic if the string is not RFC 5322
+// date-time format.
+//
+// This creation function is only recommended to create RFC5322Date values which
+// either will not potentially affect practitioners, such as testing, or within
+// exhaustively tested provider logic.
+func NewRFC5322DatePointerValueMust(value *string) RFC5322Date {
+	if value == nil {
+		return NewRFC5322DateNull()
+	}
+
+	return NewRFC5322DateValueMust(*value)
+}
+
+// parseRFC5322Date parses an RFC 5322 date-time string of the format `[day-of-week ","] day month year hour ":" minute
+// [":" second] zone` into a time.Time with a fixed location of the zone offset.
+func parseRFC5322Date(value string) (time.Time, error) {
+	rest := trimRFC5322Whitespace(value)
+
+	var dayOfWeek string
+
+	if i := strings.IndexByte(rest, ','); i >= 0 {
+		dayOfWeek = strings.TrimRight(rest[:i], " \t")
+
+		if _, ok := lookupRFC5322Name(dayOfWeek, rfc5322DayNames); !ok {
+			return time.Time{}, fmt.Errorf("unknown day of week %q", dayOfWeek)
+		}
+
+		rest = trimRFC5322Whitespace(rest[i+1:])
+	}
+
+	digits := leadingDigits(rest)
+
+	if digits < 1 || digits > 2 {
+		return time.Time{}, fmt.Errorf("expected 1 or 2 digit day, got %q", rest)
+	}
+
+	day, rest, err := parseFixedDigits(rest, digits, "day", 1, 31)
+	if err != nil {
+		return time.Time{}, err
+	}
+
+	if rest, err = parseRFC5322Whitespace(rest, "day"); err != nil {
+		return time.Time{}, err
+	}
+
+	if len(rest) < 3 {
+		return time.Time{}, fmt.Errorf("expected month name, got %q", rest)
+	}
+
+	month, ok := lookupRFC5322Name(rest[:3], rfc5322MonthNames)
+	if !ok {
+		return time.Time{}, fmt.Errorf("unknown month %q", rest[:3])
+	}
+
+	if rest, err = parseRFC5322Whitespace(rest[3:], "month"); err != nil {
+		return time.Time{}, err
+	}
+
+	year, rest, err := parseRFC5322Year(rest)
+	if err != nil {
+		return time.Time{}, err
+	}
+
+	if rest, err = parseRFC5322Whitespace(rest, "year"); err != nil {
+		return time.Time{}, err
+	}
+
+	hour, rest, err := parseFixedDigits(rest, 2, "hour", 0, 23)
+	if err != nil {
+		return time.Time{}, err
+	}
+
+	if rest, err = parseSeparator(rest, ':', "hour"); err != nil {
+		return time.Time{}, err
+	}
+
+	minute, rest, err := parseFixedDigits(rest, 2, "minute", 0, 59)
+	if err != nil {
+		return time.Time{}, err
+	}
+
+	var second int
+
+	if strings.HasPrefix(rest, ":") {
+		if second, rest, err = parseFixedDigits(rest[1:], 2, "second", 0, 59); err != nil {
+			return time.Time{}, err
+		}
+	}
+
+	if rest, err = parseRFC5322Whitespace(rest, "time"); err != nil {
+		return time.Time{}, err
+	}
+
+	zone := rest
+
+	if i := strings.IndexAny(rest, " \t"); i >= 0 {
+		zone, rest = rest[:i], rest[i:]
+	} else {
+		rest = ""
+	}
+
+	offset, err := parseRFC5322Zone(zone)
+	if err != nil {
+		return time.Time{}, err
+	}
+
+	if rest = trimRFC5322Whitespace(rest); rest != "" {
+		return time.Time{}, fmt.Errorf("unexpected text after zone: %q", rest)
+	}
+
+	t := time.Date(year, time.Month(month+1), day, hour, minute, second, 0, utcOffsetLocation(offset))
+
+	if t.Day() != day {
+		return time.Time{}, fmt.Errorf("day %d is out of range for %s %d", day, time.Month(month+1), year)
+	}
+
+	if dayOfWeek != "" {
+		if weekday, _ := lookupRFC5322Name(dayOfWeek, rfc5322DayNames); time.Weekday(weekday) != t.Weekday() {
+			return time.Time{}, fmt.Errorf("day of week %q does not match date, expected %q", dayOfWeek, rfc5322DayNames[t.Weekday()])
+		}
+	}
+
+	return t, nil
+}
+
+// rfc5322DayNames are the RFC 5322 day names, indexed by time.Weekday.
+var rfc5322DayNames = []string{"Sun", "Mon", "Tue", "Wed", "Thu", "Fri", "Sat"}
+
+// rfc5322MonthNames are the RFC 5322 month names, indexed by time.Month minus one.
+var rfc5322MonthNames = []string{"Jan", "Feb", "Mar", "Apr", "May", "Jun", "Jul", "Aug", "Sep", "Oct", "Nov", "Dec"}
+
+// lookupRFC5322Name returns the index of the case-insensitive name in the given names.
+func lookupRFC5322Name(name string, names []string) (int, bool) {
+	for i, candidate := range names {
+		if strings.EqualFold(name, candidate) {
+			return i, true
+		}
+	}
+
+	return 0, false
+}
+
+// parseRFC5322Year parses a leading two, three or four digit year, returning the full year and the remainder of the
+// string.
+func parseRFC5322Year(value string) (int, string, error) {
+	switch digits := leadingDigits(value); digits {
+	case 2:
+		year, rest, err := parseFixedDigits(value, 2, "year", 0, 99)
+
+		if year < 50 {
+			return year + 2000, rest, err
+		}
+
+		return year + 1900, rest, err
+	case 3:
+		year, rest, err := parseFixedDigits(value, 3, "year", 0, 999)
+
+		return year + 1900, rest, err
+	case 4:
+		return parseFixedDigits(value, 4, "year", 1900, 9999)
+	default:
+		return 0, value, fmt.Errorf("expected 2 to 4 digit year, got %q", value)
+	}
+}
+
+// parseRFC5322Zone parses a `+hhmm` or `-hhmm` zone or an obsolete zone name into a UTC offset in seconds.
+func parseRFC5322Zone(value string) (int, error) {
+	if value != "" && (value[0] == '+' || value[0] == '-') {
+		hours, rest, err := parseFixedDigits(value[1:], 2, "zone hour", 0, 23)
+		if err != nil {
+			return 0, err
+		}
+
+		minutes, rest, err := parseFixedDigits(rest, 2, "zone minute", 0, 59)
+		if err != nil {
+			return 0, err
+		}
+
+		if rest != "" {
+			return 0, fmt.Errorf("unexpected text after zone: %q", rest)
+		}
+
+		if value[0] == '-' {
+			return -(hours*60*60 + minutes*60), nil
+		}
+
+		return hours*60*60 + minutes*60, nil
+	}
+
+	if offset, ok := rfc5322DateObsoleteZones[strings.ToUpper(value)]; ok {
+		return offset, nil
+	}
+
+	// Military zones are defined incorrectly in RFC 822, so RFC 5322 requires them to be treated as "-0000".
+	if len(value) == 1 && strings.ContainsAny(strings.ToUpper(value), "ABCDEFGHIKLMNOPQRSTUVWXYZ") {
+		return 0, nil
+	}
+
+	return 0, fmt.Errorf(`expected a "+hhmm" or "-hhmm" zone or an obsolete zone name such as "GMT" or "EST", got %q`, value)
+}
+
+// parseRFC5322Whitespace removes the required whitespace from the start of a string, returning an error mentioning
+// the preceding component name if it is missing.
+func parseRFC5322Whitespace(value string, after string) (string, error) {
+	rest := trimRFC5322Whitespace(value)
+
+	if rest == value {
+		return value, fmt.Errorf("expected whitespace after %s, got %q", after, value)
+	}
+
+	return rest, nil
+}
+
+// trimRFC5322Whitespace removes any leading spaces and tabs from a string.
+func trimRFC5322Whitespace(value string) string {
+	return strings.TrimLeft(value, " \t")
+}
+
+// leadingDigits returns the number of leading ASCII digits in a string.
+func leadingDigits(value string) int {
+	i := 0
+
+	for i < len(value) && value[i] >= '0' && value[i] <= '9' {
+		i++
+	}
+
+	return i
+}
@@ -0,0 +1,34 @@
+// Copyright IBM Corp. 2023, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package timetypes_test
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework-timetypes/timetypes"
+)
+
+type MessageDataSourceModel struct {
+	Date timetypes.RFC5322Date `tfsdk:"date"`
+}
+
+func ExampleRFC5322Date_ValueRFC5322DateTime() {
+	// For example purposes, typically the data model would be populated automatically by Plugin Framework via Config, Plan or State.
+	// https://developer.hashicorp.com/terraform/plugin/framework/handling-data/accessing-values
+	data := MessageDataSourceModel{
+		Date: timetypes.NewRFC5322DateValueMust("Fri, 16 Oct 2026 12:00:00 +0200"),
+	}
+
+	// Check that the RFC 5322 date data is known and able to be converted to time.Time
+	if !data.Date.IsNull() && !data.Date.IsUnknown() {
+		t, diags := data.Date.ValueRFC5322DateTime()
+		if diags.HasError() {
+			return
+		}
+
+		// Output: 2026-10-16T12:00:00+02:00
+		fmt.Println(t.Format(time.RFC3339))
+	}
+}
@@ -0,0 +1,339 @@
+// Copyright IBM Corp. 2023, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package timetypes_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-framework/attr/xattr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+
+	"github.com/hashicorp/terraform-plugin-framework-timetypes/timetypes"
+)
+
+func TestRFC5322Date_StringSemanticEquals(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		currentRFC5322Date timetypes.RFC5322Date
+		givenRFC5322Date   basetypes.StringValuable
+		expectedMatch      bool
+		expectedDiags      diag.Diagnostics
+	}{
+		"not equal - different dates": {
+			currentRFC5322Date: timetypes.NewRFC5322DateValueMust("Fri, 16 Oct 2026 12:00:00 +0200"),
+			givenRFC5322Date:   timetypes.NewRFC5322DateValueMust("Sat, 17 Oct 2026 12:00:00 +0200"),
+			expectedMatch:      false,
+		},
+		"not equal - same instant with different offsets": {
+			currentRFC5322Date: timetypes.NewRFC5322DateValueMust("Fri, 16 Oct 2026 12:00:00 +0200"),
+			givenRFC5322Date:   timetypes.NewRFC5322DateValueMust("Fri, 16 Oct 2026 10:00:00 +0000"),
+			expectedMatch:      false,
+		},
+		"semantically equal - byte for byte match": {
+			currentRFC5322Date: timetypes.NewRFC5322DateValueMust("Fri, 16 Oct 2026 12:00:00 +0200"),
+			givenRFC5322Date:   timetypes.NewRFC5322DateValueMust("Fri, 16 Oct 2026 12:00:00 +0200"),
+			expectedMatch:      true,
+		},
+		"semantically equal - optional day of week and seconds": {
+			currentRFC5322Date: timetypes.NewRFC5322DateValueMust("Fri, 16 Oct 2026 12:00:00 +0200"),
+			givenRFC5322Date:   timetypes.NewRFC5322DateValueMust("16 Oct 2026 12:00 +0200"),
+			expectedMatch:      true,
+		},
+		"semantically equal - GMT and zero offset": {
+			currentRFC5322Date: timetypes.NewRFC5322DateValueMust("Fri, 16 Oct 2026 12:00:00 GMT"),
+			givenRFC5322Date:   timetypes.NewRFC5322DateValueMust("Fri, 16 Oct 2026 12:00:00 +0000"),
+			expectedMatch:      true,
+		},
+		"semantically equal - EST and numeric offset": {
+			currentRFC5322Date: timetypes.NewRFC5322DateValueMust("Fri, 16 Oct 2026 12:00:00 EST"),
+			givenRFC5322Date:   timetypes.NewRFC5322DateValueMust("Fri, 16 Oct 2026 12:00:00 -0500"),
+			expectedMatch:      true,
+		},
+		"semantically equal - obsolete two digit year": {
+			currentRFC5322Date: timetypes.NewRFC5322DateValueMust("fri, 16 oct 26 12:00:00 +0200"),
+			givenRFC5322Date:   timetypes.NewRFC5322DateValueMust("Fri, 16 Oct 2026 12:00:00 +0200"),
+			expectedMatch:      true,
+		},
+		"error - not given RFC5322Date value": {
+			currentRFC5322Date: timetypes.NewRFC5322DateValueMust("Fri, 16 Oct 2026 12:00:00 +0200"),
+			givenRFC5322Date:   basetypes.NewStringValue("Fri, 16 Oct 2026 12:00:00 +0200"),
+			expectedMatch:      false,
+			expectedDiags: diag.Diagnostics{
+				diag.NewErrorDiagnostic(
+					"Semantic Equality Check Error",
+					"An unexpected value type was received while performing semantic equality checks. "+
+						"Please report this to the provider developers.\n\n"+
+						"Expected Value Type: timetypes.RFC5322Date\n"+
+						"Got Value Type: basetypes.StringValue",
+				),
+			},
+		},
+	}
+	for name, testCase := range testCases {
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			match, diags := testCase.currentRFC5322Date.StringSemanticEquals(context.Background(), testCase.givenRFC5322Date)
+
+			if testCase.expectedMatch != match {
+				t.Errorf("Expected StringSemanticEquals to return: %t, but got: %t", testCase.expectedMatch, match)
+			}
+
+			if diff := cmp.Diff(diags, testCase.expectedDiags); diff != "" {
+				t.Errorf("Unexpected diagnostics (-got, +expected): %s", diff)
+			}
+		})
+	}
+}
+
+func TestRFC5322DateValidateAttribute(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		RFC5322Date   timetypes.RFC5322Date
+		expectedDiags diag.Diagnostics
+	}{
+		"empty-struct": {
+			RFC5322Date: timetypes.RFC5322Date{},
+		},
+		"null": {
+			RFC5322Date: timetypes.NewRFC5322DateNull(),
+		},
+		"unknown": {
+			RFC5322Date: timetypes.NewRFC5322DateUnknown(),
+		},
+		"valid RFC 5322 date": {
+			RFC5322Date: timetypes.NewRFC5322DateValueMust("Fri, 16 Oct 2026 12:00:00 +0200"),
+		},
+		"valid RFC 5322 date - single digit day": {
+			RFC5322Date: timetypes.NewRFC5322DateValueMust("Tue, 6 Oct 2026 12:00:00 -0700"),
+		},
+		"valid RFC 5322 date - obsolete syntax": {
+			RFC5322Date: timetypes.NewRFC5322DateValueMust(" tue , 6  OCT 126\t12:00 pdt"),
+		},
+		"valid RFC 5322 date - military zone": {
+			RFC5322Date: timetypes.NewRFC5322DateValueMust("16 Oct 2026 12:00 Z"),
+		},
+		"invalid RFC 5322 date - day of week mismatch": {
+			RFC5322Date: timetypes.RFC5322Date{
+				StringValue: basetypes.NewStringValue("Thu, 16 Oct 2026 12:00:00 +0200"),
+			},
+			expectedDiags: diag.Diagnostics{
+				diag.NewAttributeErrorDiagnostic(
+					path.Root("test"),
+					"Invalid RFC 5322 Date String Value",
+					"A string value was provided that is not valid RFC 5322 date-time string format, such as \"Fri, 16 Oct 2026 12:00:00 +0200\".\n\n"+
+						"Given Value: Thu, 16 Oct 2026 12:00:00 +0200\n"+
+						"Error: day of week \"Thu\" does not match date, expected \"Fri\"",
+				),
+			},
+		},
+		"invalid RFC 5322 date - day out of range for month": {
+			RFC5322Date: timetypes.RFC5322Date{
+				StringValue: basetypes.NewStringValue("31 Feb 2026 12:00 GMT"),
+			},
+			expectedDiags: diag.Diagnostics{
+				diag.NewAttributeErrorDiagnostic(
+					path.Root("test"),
+					"Invalid RFC 5322 Date String Value",
+					"A string value was provided that is not valid RFC 5322 date-time string format, such as \"Fri, 16 Oct 2026 12:00:00 +0200\".\n\n"+
+						"Given Value: 31 Feb 2026 12:00 GMT\n"+
+						"Error: day 31 is out of range for February 2026",
+				),
+			},
+		},
+		"invalid RFC 5322 date - RFC 3339 style offset": {
+			RFC5322Date: timetypes.RFC5322Date{
+				StringValue: basetypes.NewStringValue("16 Oct 2026 12:00 +02:00"),
+			},
+			expectedDiags: diag.Diagnostics{
+				diag.NewAttributeErrorDiagnostic(
+					path.Root("test"),
+					"Invalid RFC 5322 Date String Value",
+					"A string value was provided that is not valid RFC 5322 date-time string format, such as \"Fri, 16 Oct 2026 12:00:00 +0200\".\n\n"+
+						"Given Value: 16 Oct 2026 12:00 +02:00\n"+
+						"Error: expected 2 digit zone minute, got \":0\"",
+				),
+			},
+		},
+		"invalid RFC 5322 date - unknown zone": {
+			RFC5322Date: timetypes.RFC5322Date{
+				StringValue: basetypes.NewStringValue("16 Oct 2026 12:00 CEST"),
+			},
+			expectedDiags: diag.Diagnostics{
+				diag.NewAttributeErrorDiagnostic(
+					path.Root("test"),
+					"Invalid RFC 5322 Date String Value",
+					"A string value was provided that is not valid RFC 5322 date-time string format, such as \"Fri, 16 Oct 2026 12:00:00 +0200\".\n\n"+
+						"Given Value: 16 Oct 2026 12:00 CEST\n"+
+						"Error: expected a \"+hhmm\" or \"-hhmm\" zone or an obsolete zone name such as \"GMT\" or \"EST\", got \"CEST\"",
+				),
+			},
+		},
+		"invalid RFC 5322 date - comment": {
+			RFC5322Date: timetypes.RFC5322Date{
+				StringValue: basetypes.NewStringValue("16 Oct 2026 12:00 +0200 (CEST)"),
+			},
+			expectedDiags: diag.Diagnostics{
+				diag.NewAttributeErrorDiagnostic(
+					path.Root("test"),
+					"Invalid RFC 5322 Date String Value",
+					"A string value was provided that is not valid RFC 5322 date-time string format, such as \"Fri, 16 Oct 2026 12:00:00 +0200\".\n\n"+
+						"Given Value: 16 Oct 2026 12:00 +0200 (CEST)\n"+
+						"Error: unexpected text after zone: \"(CEST)\"",
+				),
+			},
+		},
+	}
+	for name, testCase := range testCases {
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			resp := xattr.ValidateAttributeResponse{}
+
+			testCase.RFC5322Date.ValidateAttribute(
+				context.Background(),
+				xattr.ValidateAttributeRequest{
+					Path: path.Root("test"),
+				},
+				&resp,
+			)
+
+			if diff := cmp.Diff(resp.Diagnostics, testCase.expectedDiags); diff != "" {
+				t.Errorf("Unexpected diagnostics (-got, +expected): %s", diff)
+			}
+		})
+	}
+}
+
+func TestRFC5322DateValidateParameter(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		RFC5322Date     timetypes.RFC5322Date
+		expectedFuncErr *function.FuncError
+	}{
+		"empty-struct": {
+			RFC5322Date: timetypes.RFC5322Date{},
+		},
+		"null": {
+			RFC5322Date: timetypes.NewRFC5322DateNull(),
+		},
+		"unknown": {
+			RFC5322Date: timetypes.NewRFC5322DateUnknown(),
+		},
+		"valid RFC 5322 date": {
+			RFC5322Date: timetypes.NewRFC5322DateValueMust("Fri, 16 Oct 2026 12:00:00 +0200"),
+		},
+		"invalid RFC 5322 date": {
+			RFC5322Date: timetypes.RFC5322Date{
+				StringValue: basetypes.NewStringValue("16 Oct 1899 12:00 GMT"),
+			},
+			expectedFuncErr: function.NewArgumentFuncError(
+				0,
+				"Invalid RFC 5322 Date String Value: "+
+					"A string value was provided that is not valid RFC 5322 date-time string format, such as \"Fri, 16 Oct 2026 12:00:00 +0200\".\n\n"+
+					"Given Value: 16 Oct 1899 12:00 GMT\n"+
+					"Error: year \"1899\" is out of range [1900, 9999]",
+			),
+		},
+	}
+	for name, testCase := range testCases {
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			resp := function.ValidateParameterResponse{}
+
+			testCase.RFC5322Date.ValidateParameter(
+				context.Background(),
+				function.ValidateParameterRequest{
+					Position: int64(0),
+				},
+				&resp,
+			)
+
+			if diff := cmp.Diff(resp.Error, testCase.expectedFuncErr); diff != "" {
+				t.Errorf("Unexpected diagnostics (-got, +expected): %s", diff)
+			}
+		})
+	}
+}
+
+func TestRFC5322Date_ValueRFC5322DateTime(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		RFC5322Date   timetypes.RFC5322Date
+		expectedTime  time.Time
+		expectedDiags diag.Diagnostics
+	}{
+		"RFC 5322 date string value is null ": {
+			RFC5322Date: timetypes.NewRFC5322DateNull(),
+			expectedDiags: diag.Diagnostics{
+				diag.NewErrorDiagnostic(
+					"RFC5322Date ValueRFC5322DateTime Error",
+					"RFC 5322 date string value is null",
+				),
+			},
+		},
+		"RFC 5322 date string value is unknown ": {
+			RFC5322Date: timetypes.NewRFC5322DateUnknown(),
+			expectedDiags: diag.Diagnostics{
+				diag.NewErrorDiagnostic(
+					"RFC5322Date ValueRFC5322DateTime Error",
+					"RFC 5322 date string value is unknown",
+				),
+			},
+		},
+		"valid RFC 5322 date": {
+			RFC5322Date:  timetypes.NewRFC5322DateValueMust("Fri, 16 Oct 2026 12:00:00 +0200"),
+			expectedTime: time.Date(2026, time.October, 16, 12, 0, 0, 0, time.FixedZone("", 2*60*60)),
+		},
+		"valid RFC 5322 date - obsolete zone": {
+			RFC5322Date:  timetypes.NewRFC5322DateValueMust("16 Oct 2026 12:00 EDT"),
+			expectedTime: time.Date(2026, time.October, 16, 12, 0, 0, 0, time.FixedZone("", -4*60*60)),
+		},
+		"valid RFC 5322 date - obsolete year": {
+			RFC5322Date:  timetypes.NewRFC5322DateValueMust("16 Oct 96 12:00 GMT"),
+			expectedTime: time.Date(1996, time.October, 16, 12, 0, 0, 0, time.UTC),
+		},
+	}
+	for name, testCase := range testCases {
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			rfc5322Time, diags := testCase.RFC5322Date.ValueRFC5322DateTime()
+
+			if !rfc5322Time.Equal(testCase.expectedTime) {
+				t.Errorf("Unexpected time, got: %s, expected: %s", rfc5322Time, testCase.expectedTime)
+			}
+
+			if diff := cmp.Diff(diags, testCase.expectedDiags); diff != "" {
+				t.Errorf("Unexpected diagnostics (-got, +expected): %s", diff)
+			}
+		})
+	}
+}
+
+func TestNewRFC5322DateTimeValue(t *testing.T) {
+	t.Parallel()
+
+	got := timetypes.NewRFC5322DateTimeValue(time.Date(2026, time.October, 16, 12, 0, 0, 999, time.FixedZone("", 2*60*60)))
+	expected := timetypes.NewRFC5322DateValueMust("Fri, 16 Oct 2026 12:00:00 +0200")
+
+	if !got.Equal(expected) {
+		t.Errorf("Expected %s, got %s", expected, got)
+	}
+}
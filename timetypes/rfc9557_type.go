@@ -0,0 +1,77 @@
+// Copyright IBM Corp. 2023, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package timetypes
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+var (
+	_ basetypes.StringTypable = (*RFC9557Type)(nil)
+)
+
+// RFC9557Type is an attribute type that represents a valid RFC 9557 Internet Extended Date/Time Format string with a
+// bracketed time zone, such as `2026-10-16T12:00:00+02:00[Europe/Paris]`. Semantic equality logic is defined for
+// RFC9557Type such that values with the same instant, UTC offset and time zone are considered equal.
+type RFC9557Type struct {
+	basetypes.StringType
+}
+
+// String returns a human-readable string of the type name.
+func (t RFC9557Type) String() string {
+	return "timetypes.RFC9557Type"
+}
+
+// ValueType returns the Value type.
+func (t RFC9557Type) ValueType(ctx context.Context) attr.Value {
+	return RFC9557{}
+}
+
+// Equal returns true if the given type is equivalent.
+func (t RFC9557Type) Equal(o attr.Type) bool {
+	other, ok := o.(RFC9557Type)
+
+	if !ok {
+		return false
+	}
+
+	return t.StringType.Equal(other.StringType)
+}
+
+// ValueFromString returns a StringValuable type given a StringValue.
+func (t RFC9557Type) ValueFromString(ctx context.Context, in basetypes.StringValue) (basetypes.StringValuable, diag.Diagnostics) {
+	return RFC9557{
+		StringValue: in,
+	}, nil
+}
+
+// ValueFromTerraform returns a Value given a tftypes.Value.  This is meant to convert the tftypes.Value into a more convenient Go type
+// for the provider to consume the data with.
+func (t RFC9557Type) ValueFromTerraform(ctx context.Context, in tftypes.Value) (attr.Value, error) {
+	attrValue, err := t.StringType.ValueFromTerraform(ctx, in)
+
+	if err != nil {
+		return nil, err
+	}
+
+	stringValue, ok := attrValue.(basetypes.StringValue)
+
+	if !ok {
+		return nil, fmt.Errorf("unexpected value type of %T", attrValue)
+	}
+
+	stringValuable, diags := t.ValueFromString(ctx, stringValue)
+
+	if diags.HasError() {
+		return nil, fmt.Errorf("unexpected error converting StringValue to StringValuable: %v", diags)
+	}
+
+	return stringValuable, nil
+}
@@ -0,0 +1,464 @@
+// Copyright IBM Corp. 2023, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package timetypes
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/attr/xattr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+)
+
+var (
+	_ basetypes.StringValuableWithSemanticEquals = (*RFC9557)(nil)
+	_ xattr.ValidateableAttribute                = (*RFC9557)(nil)
+	_ function.ValidateableParameter             = (*RFC9557)(nil)
+)
+
+// RFC9557 represents a valid RFC 9557 Internet Extended Date/Time Format (IXDTF) string, which is an RFC 3339
+// date-time followed by a bracketed time zone, such as `2026-10-16T12:00:00+02:00[Europe/Paris]`. The time zone is
+// either an IANA time zone database name or a UTC offset, such as `[+02:00]`, and may be followed by elective suffix
+// tags, such as `[u-ca=hebrew]`.
+//
+// The date-time is validated against the RFC 3339 grammar as with StrictRFC3339, and its UTC offset must be the offset
+// of the time zone at that instant. As RFC 9557 defines `Z` and `-00:00` to mean that the local offset is unknown, such
+// date-times are not checked against the time zone and the time zone determines the local offset. Suffix tags with the
+// critical flag, such as `[!u-ca=hebrew]`, are not supported and are considered invalid.
+//
+// See https://www.rfc-editor.org/rfc/rfc9557.html for more details on the string format.
+type RFC9557 struct {
+	basetypes.StringValue
+}
+
+// Type returns an RFC9557Type.
+func (v RFC9557) Type(_ context.Context) attr.Type {
+	return RFC9557Type{}
+}
+
+// Equal returns true if the given value is equivalent.
+func (v RFC9557) Equal(o attr.Value) bool {
+	other, ok := o.(RFC9557)
+
+	if !ok {
+		return false
+	}
+
+	return v.StringValue.Equal(other.StringValue)
+}
+
+// StringSemanticEquals returns true if the given RFC9557 or RFC3339 string value is semantically equal to the current
+// RFC9557 string value. An RFC9557 value is semantically equal when it represents the same instant with the same UTC
+// offset and time zone, where deprecated time zone aliases are considered equal to their canonical names. An RFC3339
+// value is semantically equal when it represents the same instant with the same UTC offset, which is the RFC3339
+// value corresponding to the date-time without its bracketed time zone. Elective suffix tags are ignored.
+//
+// Examples:
+//   - `2026-10-16T12:00:00+02:00[Europe/Paris]` is semantically equal to `2026-10-16t12:00:00.000+02:00[!Europe/Paris]`
+//   - `2026-10-16T12:00:00+02:00[Europe/Paris]` is semantically equal to the RFC3339 `2026-10-16T12:00:00+02:00`
+//   - `2026-10-16T10:00:00Z[Europe/Paris]` is semantically equal to `2026-10-16T10:00:00-00:00[Europe/Paris]`
+//   - `2026-10-16T12:00:00+00:00[Iceland]` is semantically equal to `2026-10-16T12:00:00+00:00[Atlantic/Reykjavik]`
+//
+// Counterexamples:
+//   - `2026-10-16T12:00:00+02:00[Europe/Paris]` is NOT semantically equal to `2026-10-16T12:00:00+02:00[Europe/Berlin]`.
+//   - `2026-10-16T12:00:00+02:00[Europe/Paris]` expresses the same time as `2026-10-16T10:00:00Z[Europe/Paris]` but is
+//     NOT considered to be semantically equal.
+func (v RFC9557) StringSemanticEquals(_ context.Context, newValuable basetypes.StringValuable) (bool, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	// RFC9557 strings are already validated at this point, ignoring errors
+	current, _ := parseRFC9557(v.ValueString())
+	_, currentOffset := current.dateTime.time.Zone()
+
+	switch newValue := newValuable.(type) {
+	case RFC9557:
+		given, _ := parseRFC9557(newValue.ValueString())
+		_, givenOffset := given.dateTime.time.Zone()
+
+		return current.dateTime.time.Equal(given.dateTime.time) &&
+			currentOffset == givenOffset &&
+			current.unknownLocalOffset() == given.unknownLocalOffset() &&
+			current.timeZone == given.timeZone, diags
+	case RFC3339:
+		// RFC3339 strings are already validated at this point, ignoring errors
+		given, _ := time.Parse(time.RFC3339, newValue.ValueString())
+		_, givenOffset := given.Zone()
+
+		return current.dateTime.time.Equal(given) && currentOffset == givenOffset, diags
+	default:
+		diags.AddError(
+			"Semantic Equality Check Error",
+			"An unexpected value type was received while performing semantic equality checks. "+
+				"Please report this to the provider developers.\n\n"+
+				"Expected Value Type: "+fmt.Sprintf("%T", v)+"\n"+
+				"Got Value Type: "+fmt.Sprintf("%T", newValuable),
+		)
+
+		return false, diags
+	}
+}
+
+// ValidateAttribute implements attribute value validation. This type requires the value to be a String value that
+// is a valid RFC 9557 date-time with a bracketed time zone, where the UTC offset is consistent with the time zone.
+func (v RFC9557) ValidateAttribute(ctx context.Context, req xattr.ValidateAttributeRequest, resp *xattr.ValidateAttributeResponse) {
+	if v.IsUnknown() || v.IsNull() {
+		return
+	}
+
+	if _, err := parseRFC9557(v.ValueString()); err != nil {
+		resp.Diagnostics.Append(diag.WithPath(req.Path, rfc9557InvalidStringDiagnostic(v.ValueString(), err)))
+
+		return
+	}
+}
+
+// ValidateParameter implements provider-defined function parameter value validation. This type requires the value to
+// be a String value that is a valid RFC 9557 date-time with a bracketed time zone, where the UTC offset is consistent
+// with the time zone.
+func (v RFC9557) ValidateParameter(ctx context.Context, req function.ValidateParameterRequest, resp *function.ValidateParameterResponse) {
+	if v.IsUnknown() || v.IsNull() {
+		return
+	}
+
+	if _, err := parseRFC9557(v.ValueString()); err != nil {
+		resp.Error = function.NewArgumentFuncError(
+			req.Position,
+			"Invalid RFC9557 String Value: "+
+				"A string value was provided that is not valid RFC 9557 date-time string format with a bracketed time zone, such as \"2026-10-16T12:00:00+02:00[Europe/Paris]\".\n\n"+
+				"Given Value: "+v.ValueString()+"\n"+
+				"Error: "+err.Error(),
+		)
+
+		return
+	}
+}
+
+// ValueRFC3339Time creates a new time.Time instance with the instant of the RFC9557 StringValue in its time zone, such
+// that the location of the time.Time is that returned by ValueLocation. Leap seconds are handled as described in
+// StrictRFC3339.ValueRFC3339Time. A null or unknown value will produce an error diagnostic.
+func (v RFC9557) ValueRFC3339Time() (time.Time, diag.Diagnostics) {
+	parsed, diags := v.valueRFC9557("ValueRFC3339Time")
+	if diags.HasError() {
+		return time.Time{}, diags
+	}
+
+	return parsed.dateTime.time.In(parsed.location), nil
+}
+
+// ValueLocation creates a new time.Location instance with the bracketed time zone of the RFC9557 StringValue. A time
+// zone name is loaded via time.LoadLocation, while a UTC offset is returned as a fixed time.Location. A null or unknown
+// value will produce an error diagnostic.
+func (v RFC9557) ValueLocation() (*time.Location, diag.Diagnostics) {
+	parsed, diags := v.valueRFC9557("ValueLocation")
+	if diags.HasError() {
+		return nil, diags
+	}
+
+	return parsed.location, nil
+}
+
+// ToRFC3339Value converts the RFC9557 to an RFC3339 value of the same instant with the UTC offset of its time zone,
+// such as `2026-10-16T12:00:00+02:00` for `2026-10-16T10:00:00Z[Europe/Paris]`. Fractional seconds are preserved. Null
+// and unknown values are converted to null and unknown RFC3339 values respectively.
+func (v RFC9557) ToRFC3339Value() (RFC3339, diag.Diagnostics) {
+	if v.IsNull() {
+		return NewRFC3339Null(), nil
+	}
+
+	if v.IsUnknown() {
+		return NewRFC3339Unknown(), nil
+	}
+
+	t, diags := v.ValueRFC3339Time()
+	if diags.HasError() {
+		return NewRFC3339Unknown(), diags
+	}
+
+	return newRFC3339TimeNanoValue(t), nil
+}
+
+// valueRFC9557 returns the parsed RFC9557, with error diagnostics summarized by the given accessor method name.
+func (v RFC9557) valueRFC9557(accessor string) (rfc9557, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	summary := "RFC9557 " + accessor + " Error"
+
+	if v.IsNull() {
+		diags.Append(diag.NewErrorDiagnostic(summary, "RFC9557 string value is null"))
+		return rfc9557{}, diags
+	}
+
+	if v.IsUnknown() {
+		diags.Append(diag.NewErrorDiagnostic(summary, "RFC9557 string value is unknown"))
+		return rfc9557{}, diags
+	}
+
+	parsed, err := parseRFC9557(v.ValueString())
+	if err != nil {
+		diags.Append(diag.NewErrorDiagnostic(summary, err.Error()))
+		return rfc9557{}, diags
+	}
+
+	return parsed, nil
+}
+
+// NewRFC9557Null creates an RFC9557 with a null value. Determine whether the value is null via IsNull method.
+func NewRFC9557Null() RFC9557 {
+	return RFC9557{
+		StringValue: basetypes.NewStringNull(),
+	}
+}
+
+// NewRFC9557Unknown creates an RFC9557 with an unknown value. Determine whether the value is unknown via IsUnknown method.
+func NewRFC9557Unknown() RFC9557 {
+	return RFC9557{
+		StringValue: basetypes.NewStringUnknown(),
+	}
+}
+
+// NewRFC9557TimeValue creates an RFC9557 with a known value, with the time zone taken from the name of the location of
+// the time.Time, or raises an error diagnostic if the location name is not in the time zone database, such as
+// time.Local. A location without a name, such as one created by time.FixedZone, uses its UTC offset as the time zone.
+// Fractional seconds are only included when non-zero.
+func NewRFC9557TimeValue(value time.Time) (RFC9557, diag.Diagnostics) {
+	timeZone := value.Location().String()
+
+	if timeZone == "" {
+		timeZone = value.Format("-07:00")
+	}
+
+	return NewRFC9557Value(value.Format(time.RFC3339Nano) + "[" + timeZone + "]")
+}
+
+// NewRFC9557TimePointerValue creates an RFC9557 with a null value if nil, a known
+// value, or raises an error diagnostic if the location name is not in the time zone
+// database. The time zone is determined as described in NewRFC9557TimeValue.
+func NewRFC9557TimePointerValue(value *time.Time) (RFC9557, diag.Diagnostics) {
+	if value == nil {
+		return NewRFC9557Null(), nil
+	}
+
+	return NewRFC9557TimeValue(*value)
+}
+
+// NewRFC9557Value creates an RFC9557 with a known value or raises an error
+// diagnostic if the string is not RFC 9557 format with a bracketed time zone.
+func NewRFC9557Value(value string) (RFC9557, diag.Diagnostics) {
+	_, err := parseRFC9557(value)
+
+	if err != nil {
+		// Returning an unknown value will guarantee that, as a last resort,
+		// Terraform will return an error if attempting to store into state.
+		return NewRFC9557Unknown(), diag.Diagnostics{rfc9557InvalidStringDiagnostic(value, err)}
+	}
+
+	return RFC9557{
+		StringValue: basetypes.NewStringValue(value),
+	}, nil
+}
+
+// NewRFC9557ValueMust creates an RFC9557 with a known value or raises a panic
+// if the string is not RFC 9557 format with a bracketed time zone.
+//
+// This creation function is only recommended to create RFC9557 values which
+// either will not potentially affect practitioners, such as testing, or within
+// exhaustively tested provider logic.
+func NewRFC9557ValueMust(value string) RFC9557 {
+	_, err := parseRFC9557(value)
+
+	if err != nil {
+		panic(fmt.Sprintf("Invalid RFC9557 String Value (%s): %s", value, err))
+	}
+
+	return RFC9557{
+		StringValue: basetypes.NewStringValue(value),
+	}
+}
+
+// NewRFC9557PointerValue creates an RFC9557 with a null value if nil, a known
+// value, or raises an error diagnostic if the string is not RFC 9557 format with
+// a bracketed time zone.
+func NewRFC9557PointerValue(value *string) (RFC9557, diag.Diagnostics) {
+	if value == nil {
+		return NewRFC9557Null(), nil
+	}
+
+	return NewRFC9557Value(*value)
+}
+
+// NewRFC9557PointerValueMust creates an RFC9557 with a null value if nil, a
+// known value, or raises a panic if the string is not RFC 9557 format with a
+// bracketed time zone.
+//
+// This creation function is only recommended to create RFC9557 values which
+// either will not potentially affect practitioners, such as testing, or within
+// exhaustively tested provider logic.
+func NewRFC9557PointerValueMust(value *string) RFC9557 {
+	if value == nil {
+		return NewRFC9557Null()
+	}
+
+	return NewRFC9557ValueMust(*value)
+}
+
+// rfc9557 is a parsed RFC 9557 date-time with a bracketed time zone.
+type rfc9557 struct {
+	// dateTime is the RFC 3339 date-time before the suffixes.
+	dateTime strictRFC3339
+
+	// zuluOffset is true if the date-time has the `Z` offset.
+	zuluOffset bool
+
+	// timeZone is the canonical time zone name, or the UTC offset in the `+hh:mm` format.
+	timeZone string
+
+	// location is the time.Location of the time zone.
+	location *time.Location
+}
+
+// unknownLocalOffset returns true if the date-time has the `Z` or `-00:00` offset, which RFC 9557 defines as the
+// local offset being unknown.
+func (r rfc9557) unknownLocalOffset() bool {
+	return r.zuluOffset || r.dateTime.unknownLocalOffset
+}
+
+// parseRFC9557 parses an RFC 9557 date-time with a bracketed time zone and optional elective suffix tags, such as
+// `2026-10-16T12:00:00+02:00[Europe/Paris][u-ca=hebrew]`, checking that the UTC offset is consistent with the time
+// zone.
+func parseRFC9557(value string) (rfc9557, error) {
+	var result rfc9557
+
+	i := strings.IndexByte(value, '[')
+	if i < 0 {
+		return result, errors.New(`expected a bracketed time zone after the date-time, such as "[Europe/Paris]"`)
+	}
+
+	dateTime, err := parseStrictRFC3339(value[:i])
+	if err != nil {
+		return result, err
+	}
+
+	result.dateTime = dateTime
+	result.zuluOffset = value[i-1] == 'Z' || value[i-1] == 'z'
+
+	rest := value[i:]
+
+	for rest != "" {
+		if rest[0] != '[' {
+			return result, fmt.Errorf("unexpected text after suffix: %q", rest)
+		}
+
+		end := strings.IndexByte(rest, ']')
+		if end < 0 {
+			return result, fmt.Errorf("expected ']' to close suffix %q", rest)
+		}
+
+		suffix := rest[1:end]
+		rest = rest[end+1:]
+
+		critical := strings.HasPrefix(suffix, "!")
+		suffix = strings.TrimPrefix(suffix, "!")
+
+		if result.location == nil {
+			if strings.Contains(suffix, "=") {
+				return result, fmt.Errorf("expected a bracketed time zone before suffix tag %q", suffix)
+			}
+
+			if err := result.parseTimeZone(suffix); err != nil {
+				return result, err
+			}
+
+			continue
+		}
+
+		key, values, ok := strings.Cut(suffix, "=")
+		if !ok {
+			return result, fmt.Errorf("unexpected second time zone %q", suffix)
+		}
+
+		if err := validateRFC9557SuffixTag(key, values); err != nil {
+			return result, err
+		}
+
+		if critical {
+			return result, fmt.Errorf("unsupported critical suffix tag %q", key)
+		}
+	}
+
+	if result.unknownLocalOffset() {
+		return result, nil
+	}
+
+	_, offset := dateTime.time.Zone()
+	inZone := dateTime.time.In(result.location)
+
+	if _, zoneOffset := inZone.Zone(); zoneOffset != offset {
+		return result, fmt.Errorf("UTC offset %s is inconsistent with time zone %q, which has UTC offset %s at that instant", dateTime.time.Format("-07:00"), result.timeZone, inZone.Format("-07:00"))
+	}
+
+	return result, nil
+}
+
+// parseTimeZone parses the time zone suffix, which is either an IANA time zone database name or a UTC offset.
+func (r *rfc9557) parseTimeZone(value string) error {
+	if value != "" && (value[0] == '+' || value[0] == '-') {
+		offset, err := parseUTCOffset(value)
+		if err != nil {
+			return err
+		}
+
+		r.location = utcOffsetLocation(offset)
+		r.timeZone = time.Time{}.In(r.location).Format("-07:00")
+
+		return nil
+	}
+
+	if err := validateTimeZoneName(value); err != nil {
+		return err
+	}
+
+	location, err := time.LoadLocation(value)
+	if err != nil {
+		return err
+	}
+
+	r.timeZone = canonicalTimeZoneName(value)
+	r.location = location
+
+	return nil
+}
+
+// validateRFC9557SuffixTag returns an error if the given suffix tag key and values do not match the RFC 9557 grammar.
+// Keys start with a lowercase letter or underscore, followed by lowercase letters, digits, underscores or hyphens.
+// Values are one or more alphanumeric strings separated by hyphens.
+func validateRFC9557SuffixTag(key string, values string) error {
+	for i, c := range key {
+		switch {
+		case c >= 'a' && c <= 'z', c == '_':
+		case i > 0 && (c >= '0' && c <= '9' || c == '-'):
+		default:
+			return fmt.Errorf("invalid suffix tag key %q", key)
+		}
+	}
+
+	if key == "" {
+		return errors.New("expected a suffix tag key before '='")
+	}
+
+	for _, v := range strings.Split(values, "-") {
+		if v == "" || strings.IndexFunc(v, func(c rune) bool {
+			return !(c >= 'a' && c <= 'z' || c >= 'A' && c <= 'Z' || c >= '0' && c <= '9')
+		}) >= 0 {
+			return fmt.Errorf("invalid suffix tag value %q for key %q", values, key)
+		}
+	}
+
+	return nil
+}
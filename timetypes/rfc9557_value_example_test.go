@@ -0,0 +1,37 @@
+// Copyright IBM Corp. 2023, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package timetypes_test
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework-timetypes/timetypes"
+)
+
+type FlightResourceModel struct {
+	DepartureTime timetypes.RFC9557 `tfsdk:"departure_time"`
+}
+
+func ExampleRFC9557_ValueRFC3339Time() {
+	// For example purposes, typically the data model would be populated automatically by Plugin Framework via Config, Plan or State.
+	// https://developer.hashicorp.com/terraform/plugin/framework/handling-data/accessing-values
+	data := FlightResourceModel{
+		DepartureTime: timetypes.NewRFC9557ValueMust("2026-10-16T12:00:00+02:00[Europe/Paris]"),
+	}
+
+	// Check that the RFC9557 data is known and able to be converted to time.Time
+	if !data.DepartureTime.IsNull() && !data.DepartureTime.IsUnknown() {
+		t, diags := data.DepartureTime.ValueRFC3339Time()
+		if diags.HasError() {
+			return
+		}
+
+		// The time.Time is in the bracketed time zone, so adding a day follows its daylight saving time rules.
+		// Output: 2026-10-16T12:00:00+02:00 Europe/Paris
+		// 2026-10-26T12:00:00+01:00
+		fmt.Println(t.Format(time.RFC3339), t.Location())
+		fmt.Println(t.AddDate(0, 0, 10).Format(time.RFC3339))
+	}
+}
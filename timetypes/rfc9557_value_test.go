@@ -0,0 +1,535 @@
+// Copyright IBM Corp. 2023, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package timetypes_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-framework/attr/xattr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+
+	"github.com/hashicorp/terraform-plugin-framework-timetypes/timetypes"
+)
+
+func TestRFC9557_StringSemanticEquals(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		currentRFC9557 timetypes.RFC9557
+		givenRFC9557   basetypes.StringValuable
+		expectedMatch  bool
+		expectedDiags  diag.Diagnostics
+	}{
+		"not equal - different time": {
+			currentRFC9557: timetypes.NewRFC9557ValueMust("2026-10-16T12:00:00+02:00[Europe/Paris]"),
+			givenRFC9557:   timetypes.NewRFC9557ValueMust("2026-10-16T12:00:01+02:00[Europe/Paris]"),
+			expectedMatch:  false,
+		},
+		"not equal - different time zone": {
+			currentRFC9557: timetypes.NewRFC9557ValueMust("2026-10-16T12:00:00+02:00[Europe/Paris]"),
+			givenRFC9557:   timetypes.NewRFC9557ValueMust("2026-10-16T12:00:00+02:00[Europe/Berlin]"),
+			expectedMatch:  false,
+		},
+		"not equal - known and unknown local offset": {
+			currentRFC9557: timetypes.NewRFC9557ValueMust("2026-10-16T12:00:00+02:00[Europe/Paris]"),
+			givenRFC9557:   timetypes.NewRFC9557ValueMust("2026-10-16T10:00:00Z[Europe/Paris]"),
+			expectedMatch:  false,
+		},
+		"not equal - RFC3339 with different offset": {
+			currentRFC9557: timetypes.NewRFC9557ValueMust("2026-10-16T12:00:00+02:00[Europe/Paris]"),
+			givenRFC9557:   timetypes.NewRFC3339ValueMust("2026-10-16T10:00:00Z"),
+			expectedMatch:  false,
+		},
+		"semantically equal - byte for byte match": {
+			currentRFC9557: timetypes.NewRFC9557ValueMust("2026-10-16T12:00:00+02:00[Europe/Paris]"),
+			givenRFC9557:   timetypes.NewRFC9557ValueMust("2026-10-16T12:00:00+02:00[Europe/Paris]"),
+			expectedMatch:  true,
+		},
+		"semantically equal - lowercase designator, fractional seconds and critical flag": {
+			currentRFC9557: timetypes.NewRFC9557ValueMust("2026-10-16T12:00:00+02:00[Europe/Paris]"),
+			givenRFC9557:   timetypes.NewRFC9557ValueMust("2026-10-16t12:00:00.000+02:00[!Europe/Paris]"),
+			expectedMatch:  true,
+		},
+		"semantically equal - deprecated time zone alias": {
+			currentRFC9557: timetypes.NewRFC9557ValueMust("2026-10-16T15:30:00+05:30[Asia/Kolkata]"),
+			givenRFC9557:   timetypes.NewRFC9557ValueMust("2026-10-16T15:30:00+05:30[Asia/Calcutta]"),
+			expectedMatch:  true,
+		},
+		"semantically equal - deprecated time zone alias of country zone link": {
+			currentRFC9557: timetypes.NewRFC9557ValueMust("2026-10-16T12:00:00+00:00[Iceland]"),
+			givenRFC9557:   timetypes.NewRFC9557ValueMust("2026-10-16T12:00:00+00:00[Atlantic/Reykjavik]"),
+			expectedMatch:  true,
+		},
+		"semantically equal - UTC and canonical time zone name": {
+			currentRFC9557: timetypes.NewRFC9557ValueMust("2026-10-16T12:00:00Z[UTC]"),
+			givenRFC9557:   timetypes.NewRFC9557ValueMust("2026-10-16T12:00:00Z[Etc/UTC]"),
+			expectedMatch:  true,
+		},
+		"not equal - deprecated time zone alias and zone it was merged into": {
+			currentRFC9557: timetypes.NewRFC9557ValueMust("2026-10-16T12:00:00+00:00[Iceland]"),
+			givenRFC9557:   timetypes.NewRFC9557ValueMust("2026-10-16T12:00:00+00:00[Africa/Abidjan]"),
+			expectedMatch:  false,
+		},
+		"semantically equal - Z and -00:00 offsets": {
+			currentRFC9557: timetypes.NewRFC9557ValueMust("2026-10-16T10:00:00Z[Europe/Paris]"),
+			givenRFC9557:   timetypes.NewRFC9557ValueMust("2026-10-16T10:00:00-00:00[Europe/Paris]"),
+			expectedMatch:  true,
+		},
+		"semantically equal - elective suffix tag": {
+			currentRFC9557: timetypes.NewRFC9557ValueMust("2026-10-16T12:00:00+02:00[Europe/Paris]"),
+			givenRFC9557:   timetypes.NewRFC9557ValueMust("2026-10-16T12:00:00+02:00[Europe/Paris][u-ca=hebrew]"),
+			expectedMatch:  true,
+		},
+		"semantically equal - RFC3339": {
+			currentRFC9557: timetypes.NewRFC9557ValueMust("2026-10-16T12:00:00+02:00[Europe/Paris]"),
+			givenRFC9557:   timetypes.NewRFC3339ValueMust("2026-10-16T12:00:00+02:00"),
+			expectedMatch:  true,
+		},
+		"error - not given RFC9557 or RFC3339 value": {
+			currentRFC9557: timetypes.NewRFC9557ValueMust("2026-10-16T12:00:00+02:00[Europe/Paris]"),
+			givenRFC9557:   basetypes.NewStringValue("2026-10-16T12:00:00+02:00[Europe/Paris]"),
+			expectedMatch:  false,
+			expectedDiags: diag.Diagnostics{
+				diag.NewErrorDiagnostic(
+					"Semantic Equality Check Error",
+					"An unexpected value type was received while performing semantic equality checks. "+
+						"Please report this to the provider developers.\n\n"+
+						"Expected Value Type: timetypes.RFC9557\n"+
+						"Got Value Type: basetypes.StringValue",
+				),
+			},
+		},
+	}
+	for name, testCase := range testCases {
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			match, diags := testCase.currentRFC9557.StringSemanticEquals(context.Background(), testCase.givenRFC9557)
+
+			if testCase.expectedMatch != match {
+				t.Errorf("Expected StringSemanticEquals to return: %t, but got: %t", testCase.expectedMatch, match)
+			}
+
+			if diff := cmp.Diff(diags, testCase.expectedDiags); diff != "" {
+				t.Errorf("Unexpected diagnostics (-got, +expected): %s", diff)
+			}
+		})
+	}
+}
+
+func TestRFC9557ValidateAttribute(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		rfc9557       timetypes.RFC9557
+		expectedDiags diag.Diagnostics
+	}{
+		"empty-struct": {
+			rfc9557: timetypes.RFC9557{},
+		},
+		"null": {
+			rfc9557: timetypes.NewRFC9557Null(),
+		},
+		"unknown": {
+			rfc9557: timetypes.NewRFC9557Unknown(),
+		},
+		"valid - time zone name": {
+			rfc9557: timetypes.NewRFC9557ValueMust("2026-10-16T12:00:00+02:00[Europe/Paris]"),
+		},
+		"valid - time zone name after daylight saving time": {
+			rfc9557: timetypes.NewRFC9557ValueMust("2026-11-16T12:00:00+01:00[Europe/Paris]"),
+		},
+		"valid - UTC offset": {
+			rfc9557: timetypes.NewRFC9557ValueMust("2026-10-16T12:00:00+02:00[+02:00]"),
+		},
+		"valid - unknown local offset": {
+			rfc9557: timetypes.NewRFC9557ValueMust("2026-10-16T10:00:00Z[Europe/Paris]"),
+		},
+		"valid - elective suffix tags": {
+			rfc9557: timetypes.NewRFC9557ValueMust("2026-10-16T12:00:00+02:00[Europe/Paris][u-ca=hebrew][_foo=bar-baz]"),
+		},
+		"invalid - inconsistent offset": {
+			rfc9557: timetypes.RFC9557{
+				StringValue: basetypes.NewStringValue("2026-10-16T12:00:00+01:00[Europe/Paris]"),
+			},
+			expectedDiags: diag.Diagnostics{
+				diag.NewAttributeErrorDiagnostic(
+					path.Root("test"),
+					"Invalid RFC9557 String Value",
+					"A string value was provided that is not valid RFC 9557 date-time string format with a bracketed time zone, such as \"2026-10-16T12:00:00+02:00[Europe/Paris]\".\n\n"+
+						"Given Value: 2026-10-16T12:00:00+01:00[Europe/Paris]\n"+
+						"Error: UTC offset +01:00 is inconsistent with time zone \"Europe/Paris\", which has UTC offset +02:00 at that instant",
+				),
+			},
+		},
+		"invalid - inconsistent +00:00 offset": {
+			rfc9557: timetypes.RFC9557{
+				StringValue: basetypes.NewStringValue("2026-10-16T10:00:00+00:00[+02:00]"),
+			},
+			expectedDiags: diag.Diagnostics{
+				diag.NewAttributeErrorDiagnostic(
+					path.Root("test"),
+					"Invalid RFC9557 String Value",
+					"A string value was provided that is not valid RFC 9557 date-time string format with a bracketed time zone, such as \"2026-10-16T12:00:00+02:00[Europe/Paris]\".\n\n"+
+						"Given Value: 2026-10-16T10:00:00+00:00[+02:00]\n"+
+						"Error: UTC offset +00:00 is inconsistent with time zone \"+02:00\", which has UTC offset +02:00 at that instant",
+				),
+			},
+		},
+		"invalid - missing time zone": {
+			rfc9557: timetypes.RFC9557{
+				StringValue: basetypes.NewStringValue("2026-10-16T12:00:00+02:00"),
+			},
+			expectedDiags: diag.Diagnostics{
+				diag.NewAttributeErrorDiagnostic(
+					path.Root("test"),
+					"Invalid RFC9557 String Value",
+					"A string value was provided that is not valid RFC 9557 date-time string format with a bracketed time zone, such as \"2026-10-16T12:00:00+02:00[Europe/Paris]\".\n\n"+
+						"Given Value: 2026-10-16T12:00:00+02:00\n"+
+						"Error: expected a bracketed time zone after the date-time, such as \"[Europe/Paris]\"",
+				),
+			},
+		},
+		"invalid - unknown time zone": {
+			rfc9557: timetypes.RFC9557{
+				StringValue: basetypes.NewStringValue("2026-10-16T12:00:00+02:00[Europe/Pariss]"),
+			},
+			expectedDiags: diag.Diagnostics{
+				diag.NewAttributeErrorDiagnostic(
+					path.Root("test"),
+					"Invalid RFC9557 String Value",
+					"A string value was provided that is not valid RFC 9557 date-time string format with a bracketed time zone, such as \"2026-10-16T12:00:00+02:00[Europe/Paris]\".\n\n"+
+						"Given Value: 2026-10-16T12:00:00+02:00[Europe/Pariss]\n"+
+						"Error: unknown time zone \"Europe/Pariss\", did you mean \"Europe/Paris\"?",
+				),
+			},
+		},
+		"invalid - suffix tag before time zone": {
+			rfc9557: timetypes.RFC9557{
+				StringValue: basetypes.NewStringValue("2026-10-16T12:00:00+02:00[u-ca=hebrew][Europe/Paris]"),
+			},
+			expectedDiags: diag.Diagnostics{
+				diag.NewAttributeErrorDiagnostic(
+					path.Root("test"),
+					"Invalid RFC9557 String Value",
+					"A string value was provided that is not valid RFC 9557 date-time string format with a bracketed time zone, such as \"2026-10-16T12:00:00+02:00[Europe/Paris]\".\n\n"+
+						"Given Value: 2026-10-16T12:00:00+02:00[u-ca=hebrew][Europe/Paris]\n"+
+						"Error: expected a bracketed time zone before suffix tag \"u-ca=hebrew\"",
+				),
+			},
+		},
+		"invalid - second time zone": {
+			rfc9557: timetypes.RFC9557{
+				StringValue: basetypes.NewStringValue("2026-10-16T12:00:00+02:00[Europe/Paris][Europe/Berlin]"),
+			},
+			expectedDiags: diag.Diagnostics{
+				diag.NewAttributeErrorDiagnostic(
+					path.Root("test"),
+					"Invalid RFC9557 String Value",
+					"A string value was provided that is not valid RFC 9557 date-time string format with a bracketed time zone, such as \"2026-10-16T12:00:00+02:00[Europe/Paris]\".\n\n"+
+						"Given Value: 2026-10-16T12:00:00+02:00[Europe/Paris][Europe/Berlin]\n"+
+						"Error: unexpected second time zone \"Europe/Berlin\"",
+				),
+			},
+		},
+		"invalid - critical suffix tag": {
+			rfc9557: timetypes.RFC9557{
+				StringValue: basetypes.NewStringValue("2026-10-16T12:00:00+02:00[Europe/Paris][!u-ca=hebrew]"),
+			},
+			expectedDiags: diag.Diagnostics{
+				diag.NewAttributeErrorDiagnostic(
+					path.Root("test"),
+					"Invalid RFC9557 String Value",
+					"A string value was provided that is not valid RFC 9557 date-time string format with a bracketed time zone, such as \"2026-10-16T12:00:00+02:00[Europe/Paris]\".\n\n"+
+						"Given Value: 2026-10-16T12:00:00+02:00[Europe/Paris][!u-ca=hebrew]\n"+
+						"Error: unsupported critical suffix tag \"u-ca\"",
+				),
+			},
+		},
+		"invalid - suffix tag key": {
+			rfc9557: timetypes.RFC9557{
+				StringValue: basetypes.NewStringValue("2026-10-16T12:00:00+02:00[Europe/Paris][U-CA=hebrew]"),
+			},
+			expectedDiags: diag.Diagnostics{
+				diag.NewAttributeErrorDiagnostic(
+					path.Root("test"),
+					"Invalid RFC9557 String Value",
+					"A string value was provided that is not valid RFC 9557 date-time string format with a bracketed time zone, such as \"2026-10-16T12:00:00+02:00[Europe/Paris]\".\n\n"+
+						"Given Value: 2026-10-16T12:00:00+02:00[Europe/Paris][U-CA=hebrew]\n"+
+						"Error: invalid suffix tag key \"U-CA\"",
+				),
+			},
+		},
+		"invalid - unterminated suffix": {
+			rfc9557: timetypes.RFC9557{
+				StringValue: basetypes.NewStringValue("2026-10-16T12:00:00+02:00[Europe/Paris"),
+			},
+			expectedDiags: diag.Diagnostics{
+				diag.NewAttributeErrorDiagnostic(
+					path.Root("test"),
+					"Invalid RFC9557 String Value",
+					"A string value was provided that is not valid RFC 9557 date-time string format with a bracketed time zone, such as \"2026-10-16T12:00:00+02:00[Europe/Paris]\".\n\n"+
+						"Given Value: 2026-10-16T12:00:00+02:00[Europe/Paris\n"+
+						"Error: expected ']' to close suffix \"[Europe/Paris\"",
+				),
+			},
+		},
+		"invalid - date-time": {
+			rfc9557: timetypes.RFC9557{
+				StringValue: basetypes.NewStringValue("2026-10-16T12:00:00[Europe/Paris]"),
+			},
+			expectedDiags: diag.Diagnostics{
+				diag.NewAttributeErrorDiagnostic(
+					path.Root("test"),
+					"Invalid RFC9557 String Value",
+					"A string value was provided that is not valid RFC 9557 date-time string format with a bracketed time zone, such as \"2026-10-16T12:00:00+02:00[Europe/Paris]\".\n\n"+
+						"Given Value: 2026-10-16T12:00:00[Europe/Paris]\n"+
+						"Error: expected a \"Z\" or \"+hh:mm\" UTC offset, got \"\"",
+				),
+			},
+		},
+	}
+	for name, testCase := range testCases {
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			resp := xattr.ValidateAttributeResponse{}
+
+			testCase.rfc9557.ValidateAttribute(
+				context.Background(),
+				xattr.ValidateAttributeRequest{
+					Path: path.Root("test"),
+				},
+				&resp,
+			)
+
+			if diff := cmp.Diff(resp.Diagnostics, testCase.expectedDiags); diff != "" {
+				t.Errorf("Unexpected diagnostics (-got, +expected): %s", diff)
+			}
+		})
+	}
+}
+
+func TestRFC9557ValidateParameter(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		rfc9557         timetypes.RFC9557
+		expectedFuncErr *function.FuncError
+	}{
+		"empty-struct": {
+			rfc9557: timetypes.RFC9557{},
+		},
+		"null": {
+			rfc9557: timetypes.NewRFC9557Null(),
+		},
+		"unknown": {
+			rfc9557: timetypes.NewRFC9557Unknown(),
+		},
+		"valid": {
+			rfc9557: timetypes.NewRFC9557ValueMust("2026-10-16T12:00:00+02:00[Europe/Paris]"),
+		},
+		"invalid": {
+			rfc9557: timetypes.RFC9557{
+				StringValue: basetypes.NewStringValue("2026-03-29T02:30:00+01:00[Europe/Paris]"),
+			},
+			expectedFuncErr: function.NewArgumentFuncError(
+				0,
+				"Invalid RFC9557 String Value: "+
+					"A string value was provided that is not valid RFC 9557 date-time string format with a bracketed time zone, such as \"2026-10-16T12:00:00+02:00[Europe/Paris]\".\n\n"+
+					"Given Value: 2026-03-29T02:30:00+01:00[Europe/Paris]\n"+
+					"Error: UTC offset +01:00 is inconsistent with time zone \"Europe/Paris\", which has UTC offset +02:00 at that instant",
+			),
+		},
+	}
+	for name, testCase := range testCases {
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			resp := function.ValidateParameterResponse{}
+
+			testCase.rfc9557.ValidateParameter(
+				context.Background(),
+				function.ValidateParameterRequest{
+					Position: int64(0),
+				},
+				&resp,
+			)
+
+			if diff := cmp.Diff(resp.Error, testCase.expectedFuncErr); diff != "" {
+				t.Errorf("Unexpected diagnostics (-got, +expected): %s", diff)
+			}
+		})
+	}
+}
+
+func TestRFC9557_ValueRFC3339Time_ValueLocation(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		rfc9557          timetypes.RFC9557
+		expectedTime     time.Time
+		expectedLocation string
+		expectedDiags    diag.Diagnostics
+	}{
+		"RFC9557 string value is null": {
+			rfc9557: timetypes.NewRFC9557Null(),
+			expectedDiags: diag.Diagnostics{
+				diag.NewErrorDiagnostic("RFC9557 ValueRFC3339Time Error", "RFC9557 string value is null"),
+				diag.NewErrorDiagnostic("RFC9557 ValueLocation Error", "RFC9557 string value is null"),
+			},
+		},
+		"RFC9557 string value is unknown": {
+			rfc9557: timetypes.NewRFC9557Unknown(),
+			expectedDiags: diag.Diagnostics{
+				diag.NewErrorDiagnostic("RFC9557 ValueRFC3339Time Error", "RFC9557 string value is unknown"),
+				diag.NewErrorDiagnostic("RFC9557 ValueLocation Error", "RFC9557 string value is unknown"),
+			},
+		},
+		"time zone name": {
+			rfc9557:          timetypes.NewRFC9557ValueMust("2026-10-16T12:00:00+02:00[Europe/Paris]"),
+			expectedTime:     time.Date(2026, time.October, 16, 10, 0, 0, 0, time.UTC),
+			expectedLocation: "Europe/Paris",
+		},
+		"unknown local offset": {
+			rfc9557:          timetypes.NewRFC9557ValueMust("2026-10-16T10:00:00Z[America/New_York]"),
+			expectedTime:     time.Date(2026, time.October, 16, 10, 0, 0, 0, time.UTC),
+			expectedLocation: "America/New_York",
+		},
+		"UTC offset": {
+			rfc9557:      timetypes.NewRFC9557ValueMust("2026-10-16T12:00:00.5+02:00[+02:00]"),
+			expectedTime: time.Date(2026, time.October, 16, 10, 0, 0, 500000000, time.UTC),
+		},
+	}
+	for name, testCase := range testCases {
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got, diags := testCase.rfc9557.ValueRFC3339Time()
+			loc, locDiags := testCase.rfc9557.ValueLocation()
+			diags.Append(locDiags...)
+
+			if !got.Equal(testCase.expectedTime) {
+				t.Errorf("Unexpected difference in time.Time, got: %s, expected: %s", got, testCase.expectedTime)
+			}
+
+			if loc != nil && got.Location().String() != loc.String() {
+				t.Errorf("Unexpected time.Time location, got: %s, expected: %s", got.Location(), loc)
+			}
+
+			if loc != nil && loc.String() != testCase.expectedLocation {
+				t.Errorf("Unexpected location, got: %s, expected: %s", loc, testCase.expectedLocation)
+			}
+
+			if diff := cmp.Diff(diags, testCase.expectedDiags); diff != "" {
+				t.Errorf("Unexpected diagnostics (-got, +expected): %s", diff)
+			}
+		})
+	}
+}
+
+func TestRFC9557_ToRFC3339Value(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		rfc9557       timetypes.RFC9557
+		expected      timetypes.RFC3339
+		expectedDiags diag.Diagnostics
+	}{
+		"null": {
+			rfc9557:  timetypes.NewRFC9557Null(),
+			expected: timetypes.NewRFC3339Null(),
+		},
+		"unknown": {
+			rfc9557:  timetypes.NewRFC9557Unknown(),
+			expected: timetypes.NewRFC3339Unknown(),
+		},
+		"unknown local offset": {
+			rfc9557:  timetypes.NewRFC9557ValueMust("2026-10-16T10:00:00Z[Europe/Paris]"),
+			expected: timetypes.NewRFC3339ValueMust("2026-10-16T12:00:00+02:00"),
+		},
+		"fractional seconds": {
+			rfc9557:  timetypes.NewRFC9557ValueMust("2026-10-16T12:00:00.123+02:00[Europe/Paris]"),
+			expected: timetypes.NewRFC3339ValueMust("2026-10-16T12:00:00.123+02:00"),
+		},
+	}
+	for name, testCase := range testCases {
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got, diags := testCase.rfc9557.ToRFC3339Value()
+
+			if !got.Equal(testCase.expected) {
+				t.Errorf("Expected %s, got %s", testCase.expected, got)
+			}
+
+			if diff := cmp.Diff(diags, testCase.expectedDiags); diff != "" {
+				t.Errorf("Unexpected diagnostics (-got, +expected): %s", diff)
+			}
+		})
+	}
+}
+
+func TestNewRFC9557TimeValue(t *testing.T) {
+	t.Parallel()
+
+	paris, err := time.LoadLocation("Europe/Paris")
+	if err != nil {
+		t.Fatalf("Unexpected error loading location: %s", err)
+	}
+
+	testCases := map[string]struct {
+		time          time.Time
+		expected      timetypes.RFC9557
+		expectedDiags diag.Diagnostics
+	}{
+		"time zone name": {
+			time:     time.Date(2026, time.October, 16, 12, 0, 0, 0, paris),
+			expected: timetypes.NewRFC9557ValueMust("2026-10-16T12:00:00+02:00[Europe/Paris]"),
+		},
+		"fixed zone": {
+			time:     time.Date(2026, time.October, 16, 12, 0, 0, 0, time.FixedZone("", -4*60*60)),
+			expected: timetypes.NewRFC9557ValueMust("2026-10-16T12:00:00-04:00[-04:00]"),
+		},
+		"unknown location name": {
+			time:     time.Date(2026, time.October, 16, 12, 0, 0, 0, time.FixedZone("Mars/Olympus_Mons", -5*60*60)),
+			expected: timetypes.NewRFC9557Unknown(),
+			expectedDiags: diag.Diagnostics{
+				diag.NewErrorDiagnostic(
+					"Invalid RFC9557 String Value",
+					"A string value was provided that is not valid RFC 9557 date-time string format with a bracketed time zone, such as \"2026-10-16T12:00:00+02:00[Europe/Paris]\".\n\n"+
+						"Given Value: 2026-10-16T12:00:00-05:00[Mars/Olympus_Mons]\n"+
+						"Error: unknown time zone \"Mars/Olympus_Mons\"",
+				),
+			},
+		},
+	}
+	for name, testCase := range testCases {
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got, diags := timetypes.NewRFC9557TimeValue(testCase.time)
+
+			if !got.Equal(testCase.expected) {
+				t.Errorf("Expected %s, got %s", testCase.expected, got)
+			}
+
+			if diff := cmp.Diff(diags, testCase.expectedDiags); diff != "" {
+				t.Errorf("Unexpected diagnostics (-got, +expected): %s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,124 @@
+// Copyright IBM Corp. 2023, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package timetypes
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+var (
+	_ basetypes.Int64Typable = (*SecondsDurationType)(nil)
+)
+
+// SecondsDurationUnit is the unit of time that a SecondsDuration counts.
+type SecondsDurationUnit int
+
+const (
+	// SecondsDurationUnitSeconds represents a duration in seconds. This is the default unit.
+	SecondsDurationUnitSeconds SecondsDurationUnit = iota
+
+	// SecondsDurationUnitMilliseconds represents a duration in milliseconds.
+	SecondsDurationUnitMilliseconds
+
+	// SecondsDurationUnitMinutes represents a duration in minutes.
+	SecondsDurationUnitMinutes
+)
+
+// String returns a human-readable name of the unit.
+func (u SecondsDurationUnit) String() string {
+	switch u {
+	case SecondsDurationUnitSeconds:
+		return "seconds"
+	case SecondsDurationUnitMilliseconds:
+		return "milliseconds"
+	case SecondsDurationUnitMinutes:
+		return "minutes"
+	default:
+		return fmt.Sprintf("SecondsDurationUnit(%d)", int(u))
+	}
+}
+
+// duration returns the time.Duration of a single unit.
+func (u SecondsDurationUnit) duration() time.Duration {
+	switch u {
+	case SecondsDurationUnitMilliseconds:
+		return time.Millisecond
+	case SecondsDurationUnitMinutes:
+		return time.Minute
+	default:
+		return time.Second
+	}
+}
+
+// SecondsDurationType is an attribute type that represents a valid duration integer, counting the number of
+// seconds, milliseconds or minutes, as configured by Unit. The zero value of SecondsDurationType represents a
+// duration in seconds.
+type SecondsDurationType struct {
+	basetypes.Int64Type
+
+	// Unit is the unit of time the duration counts.
+	Unit SecondsDurationUnit
+}
+
+// String returns a human-readable string of the type name.
+func (t SecondsDurationType) String() string {
+	return "timetypes.SecondsDurationType"
+}
+
+// ValueType returns the Value type.
+func (t SecondsDurationType) ValueType(ctx context.Context) attr.Value {
+	return SecondsDuration{
+		unit: t.Unit,
+	}
+}
+
+// Equal returns true if the given type is equivalent.
+func (t SecondsDurationType) Equal(o attr.Type) bool {
+	other, ok := o.(SecondsDurationType)
+
+	if !ok {
+		return false
+	}
+
+	return t.Unit == other.Unit && t.Int64Type.Equal(other.Int64Type)
+}
+
+// ValueFromInt64 returns an Int64Valuable type given an Int64Value.
+func (t SecondsDurationType) ValueFromInt64(ctx context.Context, in basetypes.Int64Value) (basetypes.Int64Valuable, diag.Diagnostics) {
+	return SecondsDuration{
+		Int64Value: in,
+		unit:       t.Unit,
+	}, nil
+}
+
+// ValueFromTerraform returns a Value given a tftypes.Value.  This is meant to convert the tftypes.Value into a more convenient Go type
+// for the provider to consume the data with.
+func (t SecondsDurationType) ValueFromTerraform(ctx context.Context, in tftypes.Value) (attr.Value, error) {
+	attrValue, err := t.Int64Type.ValueFromTerraform(ctx, in)
+
+	if err != nil {
+		return nil, err
+	}
+
+	int64Value, ok := attrValue.(basetypes.Int64Value)
+
+	if !ok {
+		return nil, fmt.Errorf("unexpected value type of %T", attrValue)
+	}
+
+	int64Valuable, diags := t.ValueFromInt64(ctx, int64Value)
+
+	if diags.HasError() {
+		return nil, fmt.Errorf("unexpected error converting Int64Value to Int64Valuable: %v", diags)
+	}
+
+	return int64Valuable, nil
+}
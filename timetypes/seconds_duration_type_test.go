@@ -0,0 +1,77 @@
+// Copyright IBM Corp. 2023, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package timetypes_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+
+	"github.com/hashicorp/terraform-plugin-framework-timetypes/timetypes"
+)
+
+func TestSecondsDurationTypeValueFromTerraform(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		typ         timetypes.SecondsDurationType
+		in          tftypes.Value
+		expectation attr.Value
+		expectedErr string
+	}{
+		"true": {
+			in:          tftypes.NewValue(tftypes.Number, 300),
+			expectation: timetypes.NewSecondsDurationValueMust(300, timetypes.SecondsDurationUnitSeconds),
+		},
+		"true - milliseconds": {
+			typ:         timetypes.SecondsDurationType{Unit: timetypes.SecondsDurationUnitMilliseconds},
+			in:          tftypes.NewValue(tftypes.Number, 90000),
+			expectation: timetypes.NewSecondsDurationValueMust(90000, timetypes.SecondsDurationUnitMilliseconds),
+		},
+		"unknown": {
+			in:          tftypes.NewValue(tftypes.Number, tftypes.UnknownValue),
+			expectation: timetypes.NewSecondsDurationUnknown(timetypes.SecondsDurationUnitSeconds),
+		},
+		"null": {
+			in:          tftypes.NewValue(tftypes.Number, nil),
+			expectation: timetypes.NewSecondsDurationNull(timetypes.SecondsDurationUnitSeconds),
+		},
+		"wrongType": {
+			in:          tftypes.NewValue(tftypes.String, "300"),
+			expectedErr: "can't unmarshal tftypes.String into *big.Float, expected *big.Float",
+		},
+	}
+	for name, testCase := range testCases {
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+			ctx := context.Background()
+
+			got, err := testCase.typ.ValueFromTerraform(ctx, testCase.in)
+			if err != nil {
+				if testCase.expectedErr == "" {
+					t.Fatalf("Unexpected error: %s", err)
+				}
+				if testCase.expectedErr != err.Error() {
+					t.Fatalf("Expected error to be %q, got %q", testCase.expectedErr, err.Error())
+				}
+				return
+			}
+			if err == nil && testCase.expectedErr != "" {
+				t.Fatalf("Expected error to be %q, didn't get an error", testCase.expectedErr)
+			}
+			if !got.Equal(testCase.expectation) {
+				t.Errorf("Expected %+v, got %+v", testCase.expectation, got)
+			}
+			if testCase.expectation.IsNull() != testCase.in.IsNull() {
+				t.Errorf("Expected null-ness match: expected %t, got %t", testCase.expectation.IsNull(), testCase.in.IsNull())
+			}
+			if testCase.expectation.IsUnknown() != !testCase.in.IsKnown() {
+				t.Errorf("Expected unknown-ness match: expected %t, got %t", testCase.expectation.IsUnknown(), !testCase.in.IsKnown())
+			}
+		})
+	}
+}
@@ -0,0 +1,275 @@
+// Copyright IBM Corp. 2023, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package timetypes
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"strconv"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/attr/xattr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+)
+
+var (
+	_ basetypes.Int64Valuable        = (*SecondsDuration)(nil)
+	_ xattr.ValidateableAttribute    = (*SecondsDuration)(nil)
+	_ function.ValidateableParameter = (*SecondsDuration)(nil)
+)
+
+// SecondsDuration represents a valid duration integer, counting the number of seconds, milliseconds or minutes, as
+// configured by the Unit of the SecondsDurationType, such as a timeout or time to live.
+//
+// Durations may be negative, but must be within the range of a time.Duration, which is approximately 292 years.
+type SecondsDuration struct {
+	basetypes.Int64Value
+
+	unit SecondsDurationUnit
+}
+
+// Type returns a SecondsDurationType with the unit of the SecondsDuration.
+func (v SecondsDuration) Type(_ context.Context) attr.Type {
+	return SecondsDurationType{
+		Unit: v.unit,
+	}
+}
+
+// Equal returns true if the given value is equivalent.
+func (v SecondsDuration) Equal(o attr.Value) bool {
+	other, ok := o.(SecondsDuration)
+
+	if !ok {
+		return false
+	}
+
+	return v.unit == other.unit && v.Int64Value.Equal(other.Int64Value)
+}
+
+// Unit returns the unit of time the SecondsDuration counts.
+func (v SecondsDuration) Unit() SecondsDurationUnit {
+	return v.unit
+}
+
+// ValidateAttribute implements attribute value validation. This type requires the value to be an Int64 value that
+// is within the range of a time.Duration in the unit of the SecondsDurationType.
+func (v SecondsDuration) ValidateAttribute(ctx context.Context, req xattr.ValidateAttributeRequest, resp *xattr.ValidateAttributeResponse) {
+	if v.IsUnknown() || v.IsNull() {
+		return
+	}
+
+	if err := validateSecondsDuration(v.ValueInt64(), v.unit); err != nil {
+		resp.Diagnostics.Append(diag.WithPath(req.Path, secondsDurationInvalidValueDiagnostic(v.ValueInt64(), err)))
+
+		return
+	}
+}
+
+// ValidateParameter implements provider-defined function parameter value validation. This type requires the value to
+// be an Int64 value that is within the range of a time.Duration in the unit of the SecondsDurationType.
+func (v SecondsDuration) ValidateParameter(ctx context.Context, req function.ValidateParameterRequest, resp *function.ValidateParameterResponse) {
+	if v.IsUnknown() || v.IsNull() {
+		return
+	}
+
+	if err := validateSecondsDuration(v.ValueInt64(), v.unit); err != nil {
+		resp.Error = function.NewArgumentFuncError(
+			req.Position,
+			"Invalid Seconds Duration Value: "+
+				"An integer value was provided that is not a valid duration. "+
+				"Durations must be within the range of a Go time duration, which is approximately 292 years.\n\n"+
+				"Given Value: "+strconv.FormatInt(v.ValueInt64(), 10)+"\n"+
+				"Error: "+err.Error(),
+		)
+
+		return
+	}
+}
+
+// ValueGoDuration creates a new time.Duration instance with the SecondsDuration Int64Value. A null or unknown value
+// will produce an error diagnostic.
+func (v SecondsDuration) ValueGoDuration() (time.Duration, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	if v.IsNull() {
+		diags.Append(diag.NewErrorDiagnostic("Seconds Duration ValueGoDuration Error", "Duration value is null"))
+		return time.Duration(0), diags
+	}
+
+	if v.IsUnknown() {
+		diags.Append(diag.NewErrorDiagnostic("Seconds Duration ValueGoDuration Error", "Duration value is unknown"))
+		return time.Duration(0), diags
+	}
+
+	if err := validateSecondsDuration(v.ValueInt64(), v.unit); err != nil {
+		diags.Append(diag.NewErrorDiagnostic("Seconds Duration ValueGoDuration Error", err.Error()))
+		return time.Duration(0), diags
+	}
+
+	return time.Duration(v.ValueInt64()) * v.unit.duration(), nil
+}
+
+// ToGoDurationValue converts the SecondsDuration to a GoDuration value, such as `1h30m0s` for 5400 seconds. Null and
+// unknown values are converted to null and unknown GoDuration values respectively.
+func (v SecondsDuration) ToGoDurationValue() (GoDuration, diag.Diagnostics) {
+	if v.IsNull() {
+		return NewGoDurationNull(), nil
+	}
+
+	if v.IsUnknown() {
+		return NewGoDurationUnknown(), nil
+	}
+
+	duration, diags := v.ValueGoDuration()
+	if diags.HasError() {
+		return NewGoDurationUnknown(), diags
+	}
+
+	return NewGoDurationValue(duration), nil
+}
+
+// NewSecondsDurationNull creates a SecondsDuration with a null value and the given unit. Determine whether the value
+// is null via IsNull method.
+func NewSecondsDurationNull(unit SecondsDurationUnit) SecondsDuration {
+	return SecondsDuration{
+		Int64Value: basetypes.NewInt64Null(),
+		unit:       unit,
+	}
+}
+
+// NewSecondsDurationUnknown creates a SecondsDuration with an unknown value and the given unit. Determine whether the
+// value is unknown via IsUnknown method.
+func NewSecondsDurationUnknown(unit SecondsDurationUnit) SecondsDuration {
+	return SecondsDuration{
+		Int64Value: basetypes.NewInt64Unknown(),
+		unit:       unit,
+	}
+}
+
+// NewSecondsDurationDurationValue creates a SecondsDuration with a known value of the time.Duration in the given unit
+// or raises an error diagnostic if the time.Duration is not a whole number of the unit.
+func NewSecondsDurationDurationValue(value time.Duration, unit SecondsDurationUnit) (SecondsDuration, diag.Diagnostics) {
+	if value%unit.duration() != 0 {
+		err := fmt.Errorf("duration %s is not a whole number of %s", value, unit)
+
+		// Returning an unknown value will guarantee that, as a last resort,
+		// Terraform will return an error if attempting to store into state.
+		return NewSecondsDurationUnknown(unit), diag.Diagnostics{secondsDurationInvalidDurationDiagnostic(value, err)}
+	}
+
+	return SecondsDuration{
+		Int64Value: basetypes.NewInt64Value(int64(value / unit.duration())),
+		unit:       unit,
+	}, nil
+}
+
+// NewSecondsDurationDurationPointerValue creates a SecondsDuration with a null
+// value if nil, a known value of the time.Duration in the given unit, or raises
+// an error diagnostic if the time.Duration is not a whole number of the unit.
+func NewSecondsDurationDurationPointerValue(value *time.Duration, unit SecondsDurationUnit) (SecondsDuration, diag.Diagnostics) {
+	if value == nil {
+		return NewSecondsDurationNull(unit), nil
+	}
+
+	return NewSecondsDurationDurationValue(*value, unit)
+}
+
+// NewSecondsDurationGoDurationValue creates a SecondsDuration from a GoDuration in the given unit or raises an error
+// diagnostic if the GoDuration is not a whole number of the unit. Null and unknown GoDuration values are converted to
+// null and unknown SecondsDuration values respectively.
+func NewSecondsDurationGoDurationValue(value GoDuration, unit SecondsDurationUnit) (SecondsDuration, diag.Diagnostics) {
+	if value.IsNull() {
+		return NewSecondsDurationNull(unit), nil
+	}
+
+	if value.IsUnknown() {
+		return NewSecondsDurationUnknown(unit), nil
+	}
+
+	duration, diags := value.ValueGoDuration()
+	if diags.HasError() {
+		return NewSecondsDurationUnknown(unit), diags
+	}
+
+	return NewSecondsDurationDurationValue(duration, unit)
+}
+
+// NewSecondsDurationValue creates a SecondsDuration with a known value in the
+// given unit or raises an error diagnostic if the value is out of range.
+func NewSecondsDurationValue(value int64, unit SecondsDurationUnit) (SecondsDuration, diag.Diagnostics) {
+	err := validateSecondsDuration(value, unit)
+
+	if err != nil {
+		// Returning an unknown value will guarantee that, as a last resort,
+		// Terraform will return an error if attempting to store into state.
+		return NewSecondsDurationUnknown(unit), diag.Diagnostics{secondsDurationInvalidValueDiagnostic(value, err)}
+	}
+
+	return SecondsDuration{
+		Int64Value: basetypes.NewInt64Value(value),
+		unit:       unit,
+	}, nil
+}
+
+// NewSecondsDurationValueMust creates a SecondsDuration with a known value in
+// the given unit or raises a panic if the value is out of range.
+//
+// This creation function is only recommended to create SecondsDuration values which
+// either will not potentially affect practitioners, such as testing, or within
+// exhaustively tested provider logic.
+func NewSecondsDurationValueMust(value int64, unit SecondsDurationUnit) SecondsDuration {
+	err := validateSecondsDuration(value, unit)
+
+	if err != nil {
+		panic(fmt.Sprintf("Invalid Seconds Duration Value (%d): %s", value, err))
+	}
+
+	return SecondsDuration{
+		Int64Value: basetypes.NewInt64Value(value),
+		unit:       unit,
+	}
+}
+
+// NewSecondsDurationPointerValue creates a SecondsDuration with a null value
+// if nil, a known value in the given unit, or raises an error diagnostic if the
+// value is out of range.
+func NewSecondsDurationPointerValue(value *int64, unit SecondsDurationUnit) (SecondsDuration, diag.Diagnostics) {
+	if value == nil {
+		return NewSecondsDurationNull(unit), nil
+	}
+
+	return NewSecondsDurationValue(*value, unit)
+}
+
+// NewSecondsDurationPointerValueMust creates a SecondsDuration with a null
+// value if nil, a known value in the given unit, or raises a panic if the value
+// is out of range.
+//
+// This creation function is only recommended to create SecondsDuration values which
+// either will not potentially affect practitioners, such as testing, or within
+// exhaustively tested provider logic.
+func NewSecondsDurationPointerValueMust(value *int64, unit SecondsDurationUnit) SecondsDuration {
+	if value == nil {
+		return NewSecondsDurationNull(unit)
+	}
+
+	return NewSecondsDurationValueMust(*value, unit)
+}
+
+// validateSecondsDuration returns an error if the given duration in the given unit is not within the range of a
+// time.Duration.
+func validateSecondsDuration(value int64, unit SecondsDurationUnit) error {
+	upperBound := int64(math.MaxInt64 / unit.duration())
+	lowerBound := int64(math.MinInt64 / unit.duration())
+
+	if value < lowerBound || value > upperBound {
+		return fmt.Errorf("value %d is out of range [%d, %d] for a duration in %s", value, lowerBound, upperBound, unit)
+	}
+
+	return nil
+}
@@ -0,0 +1,33 @@
+// Copyright IBM Corp. 2023, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package timetypes_test
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework-timetypes/timetypes"
+)
+
+type QueueResourceModel struct {
+	VisibilityTimeout timetypes.SecondsDuration `tfsdk:"visibility_timeout"`
+}
+
+func ExampleSecondsDuration_ValueGoDuration() {
+	// For example purposes, typically the data model would be populated automatically by Plugin Framework via Config, Plan or State.
+	// https://developer.hashicorp.com/terraform/plugin/framework/handling-data/accessing-values
+	data := QueueResourceModel{
+		VisibilityTimeout: timetypes.NewSecondsDurationValueMust(5400, timetypes.SecondsDurationUnitSeconds),
+	}
+
+	// Check that the duration data is known and able to be converted to time.Duration
+	if !data.VisibilityTimeout.IsNull() && !data.VisibilityTimeout.IsUnknown() {
+		d, diags := data.VisibilityTimeout.ValueGoDuration()
+		if diags.HasError() {
+			return
+		}
+
+		// Output: 1h30m0s
+		fmt.Println(d.String())
+	}
+}
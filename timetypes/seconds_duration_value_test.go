@@ -0,0 +1,348 @@
+// Copyright IBM Corp. 2023, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package timetypes_test
+
+import (
+	"context"
+	"math"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-framework/attr/xattr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+
+	"github.com/hashicorp/terraform-plugin-framework-timetypes/timetypes"
+)
+
+func TestSecondsDurationValidateAttribute(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		SecondsDuration timetypes.SecondsDuration
+		expectedDiags   diag.Diagnostics
+	}{
+		"empty-struct": {
+			SecondsDuration: timetypes.SecondsDuration{},
+		},
+		"null": {
+			SecondsDuration: timetypes.NewSecondsDurationNull(timetypes.SecondsDurationUnitSeconds),
+		},
+		"unknown": {
+			SecondsDuration: timetypes.NewSecondsDurationUnknown(timetypes.SecondsDurationUnitSeconds),
+		},
+		"valid seconds": {
+			SecondsDuration: timetypes.NewSecondsDurationValueMust(300, timetypes.SecondsDurationUnitSeconds),
+		},
+		"valid seconds - negative": {
+			SecondsDuration: timetypes.NewSecondsDurationValueMust(-300, timetypes.SecondsDurationUnitSeconds),
+		},
+		"valid seconds - upper bound": {
+			SecondsDuration: timetypes.NewSecondsDurationValueMust(9223372036, timetypes.SecondsDurationUnitSeconds),
+		},
+		"valid minutes - lower bound": {
+			SecondsDuration: timetypes.NewSecondsDurationValueMust(-153722867, timetypes.SecondsDurationUnitMinutes),
+		},
+		"invalid seconds - above upper bound": {
+			SecondsDuration: timetypes.SecondsDuration{
+				Int64Value: basetypes.NewInt64Value(9223372037),
+			},
+			expectedDiags: diag.Diagnostics{
+				diag.NewAttributeErrorDiagnostic(
+					path.Root("test"),
+					"Invalid Seconds Duration Value",
+					"An integer value was provided that is not a valid duration. "+
+						"Durations must be within the range of a Go time duration, which is approximately 292 years.\n\n"+
+						"Given Value: 9223372037\n"+
+						"Error: value 9223372037 is out of range [-9223372036, 9223372036] for a duration in seconds",
+				),
+			},
+		},
+		"invalid minutes - below lower bound": {
+			SecondsDuration: secondsDurationValueFromInt64(math.MinInt64, timetypes.SecondsDurationUnitMinutes),
+			expectedDiags: diag.Diagnostics{
+				diag.NewAttributeErrorDiagnostic(
+					path.Root("test"),
+					"Invalid Seconds Duration Value",
+					"An integer value was provided that is not a valid duration. "+
+						"Durations must be within the range of a Go time duration, which is approximately 292 years.\n\n"+
+						"Given Value: -9223372036854775808\n"+
+						"Error: value -9223372036854775808 is out of range [-153722867, 153722867] for a duration in minutes",
+				),
+			},
+		},
+	}
+	for name, testCase := range testCases {
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			resp := xattr.ValidateAttributeResponse{}
+
+			testCase.SecondsDuration.ValidateAttribute(
+				context.Background(),
+				xattr.ValidateAttributeRequest{
+					Path: path.Root("test"),
+				},
+				&resp,
+			)
+
+			if diff := cmp.Diff(resp.Diagnostics, testCase.expectedDiags); diff != "" {
+				t.Errorf("Unexpected diagnostics (-got, +expected): %s", diff)
+			}
+		})
+	}
+}
+
+func TestSecondsDurationValidateParameter(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		SecondsDuration timetypes.SecondsDuration
+		expectedFuncErr *function.FuncError
+	}{
+		"empty-struct": {
+			SecondsDuration: timetypes.SecondsDuration{},
+		},
+		"null": {
+			SecondsDuration: timetypes.NewSecondsDurationNull(timetypes.SecondsDurationUnitSeconds),
+		},
+		"unknown": {
+			SecondsDuration: timetypes.NewSecondsDurationUnknown(timetypes.SecondsDurationUnitSeconds),
+		},
+		"valid milliseconds": {
+			SecondsDuration: timetypes.NewSecondsDurationValueMust(math.MaxInt64/1000000, timetypes.SecondsDurationUnitMilliseconds),
+		},
+		"invalid milliseconds": {
+			SecondsDuration: secondsDurationValueFromInt64(math.MaxInt64, timetypes.SecondsDurationUnitMilliseconds),
+			expectedFuncErr: function.NewArgumentFuncError(
+				0,
+				"Invalid Seconds Duration Value: "+
+					"An integer value was provided that is not a valid duration. "+
+					"Durations must be within the range of a Go time duration, which is approximately 292 years.\n\n"+
+					"Given Value: 9223372036854775807\n"+
+					"Error: value 9223372036854775807 is out of range [-9223372036854, 9223372036854] for a duration in milliseconds",
+			),
+		},
+	}
+	for name, testCase := range testCases {
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			resp := function.ValidateParameterResponse{}
+
+			testCase.SecondsDuration.ValidateParameter(
+				context.Background(),
+				function.ValidateParameterRequest{
+					Position: int64(0),
+				},
+				&resp,
+			)
+
+			if diff := cmp.Diff(resp.Error, testCase.expectedFuncErr); diff != "" {
+				t.Errorf("Unexpected diagnostics (-got, +expected): %s", diff)
+			}
+		})
+	}
+}
+
+func TestSecondsDuration_ValueGoDuration(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		SecondsDuration  timetypes.SecondsDuration
+		expectedDuration time.Duration
+		expectedDiags    diag.Diagnostics
+	}{
+		"duration value is null ": {
+			SecondsDuration: timetypes.NewSecondsDurationNull(timetypes.SecondsDurationUnitSeconds),
+			expectedDiags: diag.Diagnostics{
+				diag.NewErrorDiagnostic(
+					"Seconds Duration ValueGoDuration Error",
+					"Duration value is null",
+				),
+			},
+		},
+		"duration value is unknown ": {
+			SecondsDuration: timetypes.NewSecondsDurationUnknown(timetypes.SecondsDurationUnitSeconds),
+			expectedDiags: diag.Diagnostics{
+				diag.NewErrorDiagnostic(
+					"Seconds Duration ValueGoDuration Error",
+					"Duration value is unknown",
+				),
+			},
+		},
+		"duration value is out of range ": {
+			SecondsDuration: timetypes.SecondsDuration{
+				Int64Value: basetypes.NewInt64Value(-9223372037),
+			},
+			expectedDiags: diag.Diagnostics{
+				diag.NewErrorDiagnostic(
+					"Seconds Duration ValueGoDuration Error",
+					"value -9223372037 is out of range [-9223372036, 9223372036] for a duration in seconds",
+				),
+			},
+		},
+		"seconds": {
+			SecondsDuration:  timetypes.NewSecondsDurationValueMust(5400, timetypes.SecondsDurationUnitSeconds),
+			expectedDuration: 90 * time.Minute,
+		},
+		"milliseconds": {
+			SecondsDuration:  timetypes.NewSecondsDurationValueMust(-1500, timetypes.SecondsDurationUnitMilliseconds),
+			expectedDuration: -1500 * time.Millisecond,
+		},
+		"minutes": {
+			SecondsDuration:  timetypes.NewSecondsDurationValueMust(1440, timetypes.SecondsDurationUnitMinutes),
+			expectedDuration: 24 * time.Hour,
+		},
+	}
+	for name, testCase := range testCases {
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got, diags := testCase.SecondsDuration.ValueGoDuration()
+
+			if got != testCase.expectedDuration {
+				t.Errorf("Unexpected duration, got: %s, expected: %s", got, testCase.expectedDuration)
+			}
+
+			if diff := cmp.Diff(diags, testCase.expectedDiags); diff != "" {
+				t.Errorf("Unexpected diagnostics (-got, +expected): %s", diff)
+			}
+		})
+	}
+}
+
+func TestSecondsDuration_ToGoDurationValue(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		SecondsDuration timetypes.SecondsDuration
+		expected        timetypes.GoDuration
+		expectedDiags   diag.Diagnostics
+	}{
+		"null": {
+			SecondsDuration: timetypes.NewSecondsDurationNull(timetypes.SecondsDurationUnitSeconds),
+			expected:        timetypes.NewGoDurationNull(),
+		},
+		"unknown": {
+			SecondsDuration: timetypes.NewSecondsDurationUnknown(timetypes.SecondsDurationUnitSeconds),
+			expected:        timetypes.NewGoDurationUnknown(),
+		},
+		"seconds": {
+			SecondsDuration: timetypes.NewSecondsDurationValueMust(5400, timetypes.SecondsDurationUnitSeconds),
+			expected:        timetypes.NewGoDurationValueFromStringMust("1h30m0s"),
+		},
+		"milliseconds": {
+			SecondsDuration: timetypes.NewSecondsDurationValueMust(250, timetypes.SecondsDurationUnitMilliseconds),
+			expected:        timetypes.NewGoDurationValueFromStringMust("250ms"),
+		},
+		"out of range": {
+			SecondsDuration: timetypes.SecondsDuration{
+				Int64Value: basetypes.NewInt64Value(9223372037),
+			},
+			expected: timetypes.NewGoDurationUnknown(),
+			expectedDiags: diag.Diagnostics{
+				diag.NewErrorDiagnostic(
+					"Seconds Duration ValueGoDuration Error",
+					"value 9223372037 is out of range [-9223372036, 9223372036] for a duration in seconds",
+				),
+			},
+		},
+	}
+	for name, testCase := range testCases {
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got, diags := testCase.SecondsDuration.ToGoDurationValue()
+
+			if !got.Equal(testCase.expected) {
+				t.Errorf("Expected %s, got %s", testCase.expected, got)
+			}
+
+			if diff := cmp.Diff(diags, testCase.expectedDiags); diff != "" {
+				t.Errorf("Unexpected diagnostics (-got, +expected): %s", diff)
+			}
+		})
+	}
+}
+
+func TestNewSecondsDurationGoDurationValue(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		goDuration    timetypes.GoDuration
+		unit          timetypes.SecondsDurationUnit
+		expected      timetypes.SecondsDuration
+		expectedDiags diag.Diagnostics
+	}{
+		"null": {
+			goDuration: timetypes.NewGoDurationNull(),
+			unit:       timetypes.SecondsDurationUnitMinutes,
+			expected:   timetypes.NewSecondsDurationNull(timetypes.SecondsDurationUnitMinutes),
+		},
+		"unknown": {
+			goDuration: timetypes.NewGoDurationUnknown(),
+			unit:       timetypes.SecondsDurationUnitMinutes,
+			expected:   timetypes.NewSecondsDurationUnknown(timetypes.SecondsDurationUnitMinutes),
+		},
+		"seconds": {
+			goDuration: timetypes.NewGoDurationValueFromStringMust("1h30m"),
+			unit:       timetypes.SecondsDurationUnitSeconds,
+			expected:   timetypes.NewSecondsDurationValueMust(5400, timetypes.SecondsDurationUnitSeconds),
+		},
+		"milliseconds": {
+			goDuration: timetypes.NewGoDurationValueFromStringMust("-1.5s"),
+			unit:       timetypes.SecondsDurationUnitMilliseconds,
+			expected:   timetypes.NewSecondsDurationValueMust(-1500, timetypes.SecondsDurationUnitMilliseconds),
+		},
+		"minutes": {
+			goDuration: timetypes.NewGoDurationValueFromStringMust("24h"),
+			unit:       timetypes.SecondsDurationUnitMinutes,
+			expected:   timetypes.NewSecondsDurationValueMust(1440, timetypes.SecondsDurationUnitMinutes),
+		},
+		"not a whole number": {
+			goDuration: timetypes.NewGoDurationValueFromStringMust("1m30s"),
+			unit:       timetypes.SecondsDurationUnitMinutes,
+			expected:   timetypes.NewSecondsDurationUnknown(timetypes.SecondsDurationUnitMinutes),
+			expectedDiags: diag.Diagnostics{
+				diag.NewErrorDiagnostic(
+					"Invalid Seconds Duration Conversion",
+					"A duration value was provided that cannot be represented as a whole number of the duration unit.\n\n"+
+						"Given Value: 1m30s\n"+
+						"Error: duration 1m30s is not a whole number of minutes",
+				),
+			},
+		},
+	}
+	for name, testCase := range testCases {
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got, diags := timetypes.NewSecondsDurationGoDurationValue(testCase.goDuration, testCase.unit)
+
+			if !got.Equal(testCase.expected) {
+				t.Errorf("Expected %s, got %s", testCase.expected, got)
+			}
+
+			if diff := cmp.Diff(diags, testCase.expectedDiags); diff != "" {
+				t.Errorf("Unexpected diagnostics (-got, +expected): %s", diff)
+			}
+		})
+	}
+}
+
+// secondsDurationValueFromInt64 returns a SecondsDuration in the given unit without validating the value.
+func secondsDurationValueFromInt64(value int64, unit timetypes.SecondsDurationUnit) timetypes.SecondsDuration {
+	valuable, _ := timetypes.SecondsDurationType{Unit: unit}.ValueFromInt64(context.Background(), basetypes.NewInt64Value(value))
+
+	return valuable.(timetypes.SecondsDuration)
+}
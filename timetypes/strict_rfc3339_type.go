@@ -0,0 +1,77 @@
+// Copyright IBM Corp. 2023, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package timetypes
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+var (
+	_ basetypes.StringTypable = (*StrictRFC3339Type)(nil)
+)
+
+// StrictRFC3339Type is an attribute type that represents a string which strictly conforms to the RFC 3339 date-time
+// grammar, such as `2026-10-16T20:43:16Z`. Semantic equality logic is defined for StrictRFC3339Type such that values
+// representing the same instant with the same UTC offset are considered equal.
+type StrictRFC3339Type struct {
+	basetypes.StringType
+}
+
+// String returns a human-readable string of the type name.
+func (t StrictRFC3339Type) String() string {
+	return "timetypes.StrictRFC3339Type"
+}
+
+// ValueType returns the Value type.
+func (t StrictRFC3339Type) ValueType(ctx context.Context) attr.Value {
+	return StrictRFC3339{}
+}
+
+// Equal returns true if the given type is equivalent.
+func (t StrictRFC3339Type) Equal(o attr.Type) bool {
+	other, ok := o.(StrictRFC3339Type)
+
+	if !ok {
+		return false
+	}
+
+	return t.StringType.Equal(other.StringType)
+}
+
+// ValueFromString returns a StringValuable type given a StringValue.
+func (t StrictRFC3339Type) ValueFromString(ctx context.Context, in basetypes.StringValue) (basetypes.StringValuable, diag.Diagnostics) {
+	return StrictRFC3339{
+		StringValue: in,
+	}, nil
+}
+
+// ValueFromTerraform returns a Value given a tftypes.Value.  This is meant to convert the tftypes.Value into a more convenient Go type
+// for the provider to consume the data with.
+func (t StrictRFC3339Type) ValueFromTerraform(ctx context.Context, in tftypes.Value) (attr.Value, error) {
+	attrValue, err := t.StringType.ValueFromTerraform(ctx, in)
+
+	if err != nil {
+		return nil, err
+	}
+
+	stringValue, ok := attrValue.(basetypes.StringValue)
+
+	if !ok {
+		return nil, fmt.Errorf("unexpected value type of %T", attrValue)
+	}
+
+	stringValuable, diags := t.ValueFromString(ctx, stringValue)
+
+	if diags.HasError() {
+		return nil, fmt.Errorf("unexpected error converting StringValue to StringValuable: %v", diags)
+	}
+
+	return stringValuable, nil
+}
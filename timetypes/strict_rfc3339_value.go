@@ -0,0 +1,443 @@
+// Copyright IBM Corp. 2023, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package timetypes
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/attr/xattr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+)
+
+var (
+	_ basetypes.StringValuableWithSemanticEquals = (*StrictRFC3339)(nil)
+	_ xattr.ValidateableAttribute                = (*StrictRFC3339)(nil)
+	_ function.ValidateableParameter             = (*StrictRFC3339)(nil)
+)
+
+// StrictRFC3339 represents a string which strictly conforms to the RFC 3339 date-time grammar, such as
+// `2026-10-16T20:43:16Z`. Unlike RFC3339, which relies on time.Parse, values are validated against the RFC 3339 ABNF
+// exactly. This rejects strings that the Go `time` library over-accepts, such as a comma decimal separator in
+// fractional seconds, while accepting strings the RFC allows that time.Parse does not, such as a lowercase `t` or `z`,
+// or a leap second at `23:59:60` UTC on the last day of a month. The `-00:00` unknown local offset is also preserved
+// rather than being treated as `Z`.
+//
+// See https://www.rfc-editor.org/rfc/rfc3339.html#section-5.6 for more details on the string format.
+type StrictRFC3339 struct {
+	basetypes.StringValue
+}
+
+// Type returns a StrictRFC3339Type.
+func (v StrictRFC3339) Type(_ context.Context) attr.Type {
+	return StrictRFC3339Type{}
+}
+
+// Equal returns true if the given value is equivalent.
+func (v StrictRFC3339) Equal(o attr.Value) bool {
+	other, ok := o.(StrictRFC3339)
+
+	if !ok {
+		return false
+	}
+
+	return v.StringValue.Equal(other.StringValue)
+}
+
+// StringSemanticEquals returns true if the given strict RFC 3339 string value is semantically equal to the current
+// strict RFC 3339 string value, which is when both values represent the same instant with the same UTC offset.
+//
+// Examples:
+//   - `2026-10-16t20:43:16z` is semantically equal to `2026-10-16T20:43:16+00:00`
+//   - `2026-10-16T20:43:16.500Z` is semantically equal to `2026-10-16T20:43:16.5Z`
+//
+// Counterexamples:
+//   - `2026-10-16T20:43:16-00:00` is NOT semantically equal to `2026-10-16T20:43:16Z`, as RFC 3339 defines the
+//     unknown local offset to be different from an offset of `Z`.
+//   - `2026-10-16T23:43:16+03:00` expresses the same time as `2026-10-16T20:43:16Z` but is NOT considered to be
+//     semantically equal.
+//   - `2016-12-31T23:59:60Z` is NOT semantically equal to `2017-01-01T00:00:00Z`.
+func (v StrictRFC3339) StringSemanticEquals(_ context.Context, newValuable basetypes.StringValuable) (bool, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	newValue, ok := newValuable.(StrictRFC3339)
+	if !ok {
+		diags.AddError(
+			"Semantic Equality Check Error",
+			"An unexpected value type was received while performing semantic equality checks. "+
+				"Please report this to the provider developers.\n\n"+
+				"Expected Value Type: "+fmt.Sprintf("%T", v)+"\n"+
+				"Got Value Type: "+fmt.Sprintf("%T", newValuable),
+		)
+
+		return false, diags
+	}
+
+	// Strict RFC3339 strings are already validated at this point, ignoring errors
+	current, _ := parseStrictRFC3339(v.ValueString())
+	given, _ := parseStrictRFC3339(newValue.ValueString())
+
+	_, currentOffset := current.time.Zone()
+	_, givenOffset := given.time.Zone()
+
+	return current.time.Equal(given.time) &&
+		currentOffset == givenOffset &&
+		current.leapSecond == given.leapSecond &&
+		current.unknownLocalOffset == given.unknownLocalOffset, diags
+}
+
+// ValidateAttribute implements attribute value validation. This type requires the value to be a String value that
+// strictly conforms to the RFC 3339 date-time grammar.
+func (v StrictRFC3339) ValidateAttribute(ctx context.Context, req xattr.ValidateAttributeRequest, resp *xattr.ValidateAttributeResponse) {
+	if v.IsUnknown() || v.IsNull() {
+		return
+	}
+
+	if _, err := parseStrictRFC3339(v.ValueString()); err != nil {
+		resp.Diagnostics.Append(diag.WithPath(req.Path, strictRFC3339InvalidStringDiagnostic(v.ValueString(), err)))
+
+		return
+	}
+}
+
+// ValidateParameter implements provider-defined function parameter value validation. This type requires the value to
+// be a String value that strictly conforms to the RFC 3339 date-time grammar.
+func (v StrictRFC3339) ValidateParameter(ctx context.Context, req function.ValidateParameterRequest, resp *function.ValidateParameterResponse) {
+	if v.IsUnknown() || v.IsNull() {
+		return
+	}
+
+	if _, err := parseStrictRFC3339(v.ValueString()); err != nil {
+		resp.Error = function.NewArgumentFuncError(
+			req.Position,
+			"Invalid Strict RFC3339 String Value: "+
+				"A string value was provided that is not valid RFC 3339 date-time string format, such as \"2026-10-16T20:43:16Z\".\n\n"+
+				"Given Value: "+v.ValueString()+"\n"+
+				"Error: "+err.Error(),
+		)
+
+		return
+	}
+}
+
+// ValueRFC3339Time creates a new time.Time instance with the StrictRFC3339 StringValue in its UTC offset. As time.Time
+// cannot represent leap seconds, a leap second such as `2016-12-31T23:59:60Z` is returned as the following instant,
+// `2017-01-01T00:00:00Z`. The `-00:00` unknown local offset is returned in UTC, use ValueUnknownLocalOffset to
+// distinguish it. A null or unknown value will produce an error diagnostic.
+func (v StrictRFC3339) ValueRFC3339Time() (time.Time, diag.Diagnostics) {
+	strict, diags := v.valueStrictRFC3339("ValueRFC3339Time")
+	if diags.HasError() {
+		return time.Time{}, diags
+	}
+
+	return strict.time, nil
+}
+
+// ValueUnknownLocalOffset returns true if the StrictRFC3339 StringValue has the `-00:00` offset, which RFC 3339 defines
+// as the time being in UTC while the local offset is unknown. A null or unknown value will produce an error diagnostic.
+func (v StrictRFC3339) ValueUnknownLocalOffset() (bool, diag.Diagnostics) {
+	strict, diags := v.valueStrictRFC3339("ValueUnknownLocalOffset")
+	if diags.HasError() {
+		return false, diags
+	}
+
+	return strict.unknownLocalOffset, nil
+}
+
+// ToRFC3339Value converts the StrictRFC3339 to an RFC3339 value of the same instant in the same UTC offset. Fractional
+// seconds are preserved, leap seconds are converted as described in ValueRFC3339Time, and the `-00:00` unknown local
+// offset is converted to `Z`. Null and unknown values are converted to null and unknown RFC3339 values respectively.
+func (v StrictRFC3339) ToRFC3339Value() (RFC3339, diag.Diagnostics) {
+	if v.IsNull() {
+		return NewRFC3339Null(), nil
+	}
+
+	if v.IsUnknown() {
+		return NewRFC3339Unknown(), nil
+	}
+
+	t, diags := v.ValueRFC3339Time()
+	if diags.HasError() {
+		return NewRFC3339Unknown(), diags
+	}
+
+	return newRFC3339TimeNanoValue(t), nil
+}
+
+// valueStrictRFC3339 returns the parsed StrictRFC3339, with error diagnostics summarized by the given accessor method
+// name.
+func (v StrictRFC3339) valueStrictRFC3339(accessor string) (strictRFC3339, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	summary := "StrictRFC3339 " + accessor + " Error"
+
+	if v.IsNull() {
+		diags.Append(diag.NewErrorDiagnostic(summary, "Strict RFC3339 string value is null"))
+		return strictRFC3339{}, diags
+	}
+
+	if v.IsUnknown() {
+		diags.Append(diag.NewErrorDiagnostic(summary, "Strict RFC3339 string value is unknown"))
+		return strictRFC3339{}, diags
+	}
+
+	strict, err := parseStrictRFC3339(v.ValueString())
+	if err != nil {
+		diags.Append(diag.NewErrorDiagnostic(summary, err.Error()))
+		return strictRFC3339{}, diags
+	}
+
+	return strict, nil
+}
+
+// NewStrictRFC3339Null creates a StrictRFC3339 with a null value. Determine whether the value is null via IsNull method.
+func NewStrictRFC3339Null() StrictRFC3339 {
+	return StrictRFC3339{
+		StringValue: basetypes.NewStringNull(),
+	}
+}
+
+// NewStrictRFC3339Unknown creates a StrictRFC3339 with an unknown value. Determine whether the value is unknown via IsUnknown method.
+func NewStrictRFC3339Unknown() StrictRFC3339 {
+	return StrictRFC3339{
+		StringValue: basetypes.NewStringUnknown(),
+	}
+}
+
+// NewStrictRFC3339TimeValue creates a StrictRFC3339 with a known value. Fractional seconds are only included when
+// non-zero.
+func NewStrictRFC3339TimeValue(value time.Time) StrictRFC3339 {
+	return StrictRFC3339{
+		StringValue: basetypes.NewStringValue(value.Format(time.RFC3339Nano)),
+	}
+}
+
+// NewStrictRFC3339TimePointerValue creates a StrictRFC3339 with a null value if nil or
+// a known value. Fractional seconds are only included when non-zero.
+func NewStrictRFC3339TimePointerValue(value *time.Time) StrictRFC3339 {
+	if value == nil {
+		return NewStrictRFC3339Null()
+	}
+
+	return NewStrictRFC3339TimeValue(*value)
+}
+
+// NewStrictRFC3339Value creates a StrictRFC3339 with a known value or raises an error
+// diagnostic if the string does not conform to the RFC 3339 date-time grammar.
+func NewStrictRFC3339Value(value string) (StrictRFC3339, diag.Diagnostics) {
+	_, err := parseStrictRFC3339(value)
+
+	if err != nil {
+		// Returning an unknown value will guarantee that, as a last resort,
+		// Terraform will return an error if attempting to store into state.
+		return NewStrictRFC3339Unknown(), diag.Diagnostics{strictRFC3339InvalidStringDiagnostic(value, err)}
+	}
+
+	return StrictRFC3339{
+		StringValue: basetypes.NewStringValue(value),
+	}, nil
+}
+
+// NewStrictRFC3339ValueMust creates a StrictRFC3339 with a known value or raises a panic
+// if the string does not conform to the RFC 3339 date-time grammar.
+//
+// This creation function is only recommended to create StrictRFC3339 values which
+// either will not potentially affect practitioners, such as testing, or within
+// exhaustively tested provider logic.
+func NewStrictRFC3339ValueMust(value string) StrictRFC3339 {
+	_, err := parseStrictRFC3339(value)
+
+	if err != nil {
+		panic(fmt.Sprintf("Invalid Strict RFC3339 String Value (%s): %s", value, err))
+	}
+
+	return StrictRFC3339{
+		StringValue: basetypes.NewStringValue(value),
+	}
+}
+
+// NewStrictRFC3339PointerValue creates a StrictRFC3339 with a null value if nil, a known
+// value, or raises an error diagnostic if the string does not conform to the RFC 3339
+// date-time grammar.
+func NewStrictRFC3339PointerValue(value *string) (StrictRFC3339, diag.Diagnostics) {
+	if value == nil {
+		return NewStrictRFC3339Null(), nil
+	}
+
+	return NewStrictRFC3339Value(*value)
+}
+
+// NewStrictRFC3339PointerValueMust creates a StrictRFC3339 with a null value if nil, a
+// known value, or raises a panic if the string does not conform to the RFC 3339
+// date-time grammar.
+//
+// This creation function is only recommended to create StrictRFC3339 values which
+// either will not potentially affect practitioners, such as testing, or within
+// exhaustively tested provider logic.
+func NewStrictRFC3339PointerValueMust(value *string) StrictRFC3339 {
+	if value == nil {
+		return NewStrictRFC3339Null()
+	}
+
+	return NewStrictRFC3339ValueMust(*value)
+}
+
+// strictRFC3339 is a parsed RFC 3339 date-time, keeping the details which time.Time cannot represent.
+type strictRFC3339 struct {
+	// time is the instant in its UTC offset, with a leap second normalized to the following instant.
+	time time.Time
+
+	// leapSecond is true if the seconds were `60`.
+	leapSecond bool
+
+	// unknownLocalOffset is true if the offset was `-00:00`.
+	unknownLocalOffset bool
+}
+
+// parseStrictRFC3339 parses a string according to the RFC 3339 date-time ABNF:
+//
+//	date-time = full-date "T" full-time
+//	full-date = date-fullyear "-" date-month "-" date-mday
+//	full-time = partial-time time-offset
+//	partial-time = time-hour ":" time-minute ":" time-second [time-secfrac]
+//	time-secfrac = "." 1*DIGIT
+//	time-offset = "Z" / time-numoffset
+//	time-numoffset = ("+" / "-") time-hour ":" time-minute
+//
+// The `T` and `Z` characters may also be lowercase, as permitted by RFC 3339 section 5.6. Fractional seconds beyond
+// nanosecond precision are accepted, but truncated.
+func parseStrictRFC3339(value string) (strictRFC3339, error) {
+	var result strictRFC3339
+
+	year, month, day, rest, err := parseCalendarDate(value)
+	if err != nil {
+		return result, err
+	}
+
+	if rest == "" || (rest[0] != 'T' && rest[0] != 't') {
+		return result, fmt.Errorf("expected 'T' after day, got %q", rest)
+	}
+
+	hour, rest, err := parseFixedDigits(rest[1:], 2, "hour", 0, 23)
+	if err != nil {
+		return result, err
+	}
+
+	if rest, err = parseSeparator(rest, ':', "hour"); err != nil {
+		return result, err
+	}
+
+	minute, rest, err := parseFixedDigits(rest, 2, "minute", 0, 59)
+	if err != nil {
+		return result, err
+	}
+
+	if rest, err = parseSeparator(rest, ':', "minute"); err != nil {
+		return result, err
+	}
+
+	second, rest, err := parseFixedDigits(rest, 2, "second", 0, 60)
+	if err != nil {
+		return result, err
+	}
+
+	nanosecond := 0
+
+	if rest != "" && rest[0] == '.' {
+		i := 1
+
+		for i < len(rest) && rest[i] >= '0' && rest[i] <= '9' {
+			if i <= 9 {
+				nanosecond = nanosecond*10 + int(rest[i]-'0')
+			}
+
+			i++
+		}
+
+		if i == 1 {
+			return result, fmt.Errorf("expected digits after decimal separator in fractional seconds, got %q", rest[1:])
+		}
+
+		for digits := i - 1; digits < 9; digits++ {
+			nanosecond *= 10
+		}
+
+		rest = rest[i:]
+	}
+
+	offset := 0
+
+	if rest != "" && (rest[0] == 'Z' || rest[0] == 'z') {
+		if rest[1:] != "" {
+			return result, fmt.Errorf("unexpected text after UTC offset: %q", rest[1:])
+		}
+	} else if offset, err = parseUTCOffset(rest); err != nil {
+		return result, err
+	}
+
+	loc := utcOffsetLocation(offset)
+
+	if second == 60 {
+		// Leap seconds are only ever inserted as the last second of a month in UTC.
+		utc := time.Date(year, month, day, hour, minute, 59, 0, loc).UTC()
+
+		if utc.Hour() != 23 || utc.Minute() != 59 || utc.AddDate(0, 0, 1).Day() != 1 {
+			return result, errors.New("leap second is only allowed at 23:59:60 UTC on the last day of a month")
+		}
+
+		result.leapSecond = true
+	}
+
+	result.time = time.Date(year, month, day, hour, minute, second, nanosecond, loc)
+	result.unknownLocalOffset = rest == "-00:00"
+
+	return result, nil
+}
+
+// parseCalendarDate parses the `YYYY-MM-DD` prefix of a string, including a valid day for the given month, returning
+// the date and the remainder of the string.
+func parseCalendarDate(value string) (int, time.Month, int, string, error) {
+	year, rest, err := parseFixedDigits(value, 4, "year", 0, 9999)
+	if err != nil {
+		return 0, 0, 0, rest, err
+	}
+
+	if rest, err = parseSeparator(rest, '-', "year"); err != nil {
+		return 0, 0, 0, rest, err
+	}
+
+	month, rest, err := parseFixedDigits(rest, 2, "month", 1, 12)
+	if err != nil {
+		return 0, 0, 0, rest, err
+	}
+
+	if rest, err = parseSeparator(rest, '-', "month"); err != nil {
+		return 0, 0, 0, rest, err
+	}
+
+	day, rest, err := parseFixedDigits(rest, 2, "day", 1, 31)
+	if err != nil {
+		return 0, 0, 0, rest, err
+	}
+
+	if err := checkDayOfMonth(year, month, day); err != nil {
+		return 0, 0, 0, rest, err
+	}
+
+	return year, time.Month(month), day, rest, nil
+}
+
+// checkDayOfMonth returns an error if the day is beyond the number of days in the given year and month.
+func checkDayOfMonth(year int, month int, day int) error {
+	if days := time.Date(year, time.Month(month)+1, 0, 0, 0, 0, 0, time.UTC).Day(); day > days {
+		return fmt.Errorf("day %d is out of range for %04d-%02d, which has %d days", day, year, month, days)
+	}
+
+	return nil
+}
@@ -0,0 +1,34 @@
+// Copyright IBM Corp. 2023, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package timetypes_test
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework-timetypes/timetypes"
+)
+
+type AuditEventResourceModel struct {
+	OccurredAt timetypes.StrictRFC3339 `tfsdk:"occurred_at"`
+}
+
+func ExampleStrictRFC3339_ValueRFC3339Time() {
+	// For example purposes, typically the data model would be populated automatically by Plugin Framework via Config, Plan or State.
+	// https://developer.hashicorp.com/terraform/plugin/framework/handling-data/accessing-values
+	data := AuditEventResourceModel{
+		OccurredAt: timetypes.NewStrictRFC3339ValueMust("2026-10-16t20:43:16.5z"),
+	}
+
+	// Check that the strict RFC3339 data is known and able to be converted to time.Time
+	if !data.OccurredAt.IsNull() && !data.OccurredAt.IsUnknown() {
+		t, diags := data.OccurredAt.ValueRFC3339Time()
+		if diags.HasError() {
+			return
+		}
+
+		// Output: 2026-10-16T20:43:16.5Z
+		fmt.Println(t.Format(time.RFC3339Nano))
+	}
+}
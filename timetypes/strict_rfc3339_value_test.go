@@ -0,0 +1,483 @@
+// Copyright IBM Corp. 2023, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package timetypes_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-framework/attr/xattr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+
+	"github.com/hashicorp/terraform-plugin-framework-timetypes/timetypes"
+)
+
+func TestStrictRFC3339_StringSemanticEquals(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		currentStrictRFC3339 timetypes.StrictRFC3339
+		givenStrictRFC3339   basetypes.StringValuable
+		expectedMatch        bool
+		expectedDiags        diag.Diagnostics
+	}{
+		"not equal - different time": {
+			currentStrictRFC3339: timetypes.NewStrictRFC3339ValueMust("2026-10-16T20:43:16Z"),
+			givenStrictRFC3339:   timetypes.NewStrictRFC3339ValueMust("2026-10-16T20:43:17Z"),
+			expectedMatch:        false,
+		},
+		"not equal - same instant with different offset": {
+			currentStrictRFC3339: timetypes.NewStrictRFC3339ValueMust("2026-10-16T20:43:16Z"),
+			givenStrictRFC3339:   timetypes.NewStrictRFC3339ValueMust("2026-10-16T23:43:16+03:00"),
+			expectedMatch:        false,
+		},
+		"not equal - unknown local offset": {
+			currentStrictRFC3339: timetypes.NewStrictRFC3339ValueMust("2026-10-16T20:43:16Z"),
+			givenStrictRFC3339:   timetypes.NewStrictRFC3339ValueMust("2026-10-16T20:43:16-00:00"),
+			expectedMatch:        false,
+		},
+		"not equal - leap second": {
+			currentStrictRFC3339: timetypes.NewStrictRFC3339ValueMust("2016-12-31T23:59:60Z"),
+			givenStrictRFC3339:   timetypes.NewStrictRFC3339ValueMust("2017-01-01T00:00:00Z"),
+			expectedMatch:        false,
+		},
+		"semantically equal - byte for byte match": {
+			currentStrictRFC3339: timetypes.NewStrictRFC3339ValueMust("2026-10-16T20:43:16Z"),
+			givenStrictRFC3339:   timetypes.NewStrictRFC3339ValueMust("2026-10-16T20:43:16Z"),
+			expectedMatch:        true,
+		},
+		"semantically equal - lowercase designators": {
+			currentStrictRFC3339: timetypes.NewStrictRFC3339ValueMust("2026-10-16T20:43:16Z"),
+			givenStrictRFC3339:   timetypes.NewStrictRFC3339ValueMust("2026-10-16t20:43:16z"),
+			expectedMatch:        true,
+		},
+		"semantically equal - Z suffix and +00:00 offset": {
+			currentStrictRFC3339: timetypes.NewStrictRFC3339ValueMust("2026-10-16T20:43:16Z"),
+			givenStrictRFC3339:   timetypes.NewStrictRFC3339ValueMust("2026-10-16T20:43:16+00:00"),
+			expectedMatch:        true,
+		},
+		"semantically equal - fractional seconds trailing zeros": {
+			currentStrictRFC3339: timetypes.NewStrictRFC3339ValueMust("2026-10-16T20:43:16.500+02:00"),
+			givenStrictRFC3339:   timetypes.NewStrictRFC3339ValueMust("2026-10-16T20:43:16.5+02:00"),
+			expectedMatch:        true,
+		},
+		"semantically equal - unknown local offset": {
+			currentStrictRFC3339: timetypes.NewStrictRFC3339ValueMust("2026-10-16T20:43:16-00:00"),
+			givenStrictRFC3339:   timetypes.NewStrictRFC3339ValueMust("2026-10-16t20:43:16-00:00"),
+			expectedMatch:        true,
+		},
+		"error - not given StrictRFC3339 value": {
+			currentStrictRFC3339: timetypes.NewStrictRFC3339ValueMust("2026-10-16T20:43:16Z"),
+			givenStrictRFC3339:   basetypes.NewStringValue("2026-10-16T20:43:16Z"),
+			expectedMatch:        false,
+			expectedDiags: diag.Diagnostics{
+				diag.NewErrorDiagnostic(
+					"Semantic Equality Check Error",
+					"An unexpected value type was received while performing semantic equality checks. "+
+						"Please report this to the provider developers.\n\n"+
+						"Expected Value Type: timetypes.StrictRFC3339\n"+
+						"Got Value Type: basetypes.StringValue",
+				),
+			},
+		},
+	}
+	for name, testCase := range testCases {
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			match, diags := testCase.currentStrictRFC3339.StringSemanticEquals(context.Background(), testCase.givenStrictRFC3339)
+
+			if testCase.expectedMatch != match {
+				t.Errorf("Expected StringSemanticEquals to return: %t, but got: %t", testCase.expectedMatch, match)
+			}
+
+			if diff := cmp.Diff(diags, testCase.expectedDiags); diff != "" {
+				t.Errorf("Unexpected diagnostics (-got, +expected): %s", diff)
+			}
+		})
+	}
+}
+
+func TestStrictRFC3339ValidateAttribute(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		strictRFC3339 timetypes.StrictRFC3339
+		expectedDiags diag.Diagnostics
+	}{
+		"empty-struct": {
+			strictRFC3339: timetypes.StrictRFC3339{},
+		},
+		"null": {
+			strictRFC3339: timetypes.NewStrictRFC3339Null(),
+		},
+		"unknown": {
+			strictRFC3339: timetypes.NewStrictRFC3339Unknown(),
+		},
+		"valid - Z suffix": {
+			strictRFC3339: timetypes.NewStrictRFC3339ValueMust("2026-10-16T20:43:16Z"),
+		},
+		"valid - lowercase designators": {
+			strictRFC3339: timetypes.NewStrictRFC3339ValueMust("2026-10-16t20:43:16z"),
+		},
+		"valid - unknown local offset": {
+			strictRFC3339: timetypes.NewStrictRFC3339ValueMust("2026-10-16T20:43:16-00:00"),
+		},
+		"valid - fractional seconds beyond nanoseconds": {
+			strictRFC3339: timetypes.NewStrictRFC3339ValueMust("2026-10-16T20:43:16.1234567891+05:30"),
+		},
+		"valid - leap second": {
+			strictRFC3339: timetypes.NewStrictRFC3339ValueMust("2016-12-31T23:59:60Z"),
+		},
+		"valid - leap second with offset": {
+			strictRFC3339: timetypes.NewStrictRFC3339ValueMust("1990-12-31T15:59:60-08:00"),
+		},
+		"invalid - leap second not at end of month": {
+			strictRFC3339: timetypes.StrictRFC3339{
+				StringValue: basetypes.NewStringValue("2026-10-16T20:43:60Z"),
+			},
+			expectedDiags: diag.Diagnostics{
+				diag.NewAttributeErrorDiagnostic(
+					path.Root("test"),
+					"Invalid Strict RFC3339 String Value",
+					"A string value was provided that is not valid RFC 3339 date-time string format, such as \"2026-10-16T20:43:16Z\".\n\n"+
+						"Given Value: 2026-10-16T20:43:60Z\n"+
+						"Error: leap second is only allowed at 23:59:60 UTC on the last day of a month",
+				),
+			},
+		},
+		"invalid - comma decimal separator": {
+			strictRFC3339: timetypes.StrictRFC3339{
+				StringValue: basetypes.NewStringValue("2026-10-16T20:43:16,5Z"),
+			},
+			expectedDiags: diag.Diagnostics{
+				diag.NewAttributeErrorDiagnostic(
+					path.Root("test"),
+					"Invalid Strict RFC3339 String Value",
+					"A string value was provided that is not valid RFC 3339 date-time string format, such as \"2026-10-16T20:43:16Z\".\n\n"+
+						"Given Value: 2026-10-16T20:43:16,5Z\n"+
+						"Error: expected a \"Z\" or \"+hh:mm\" UTC offset, got \",5Z\"",
+				),
+			},
+		},
+		"invalid - offset hour out of range": {
+			strictRFC3339: timetypes.StrictRFC3339{
+				StringValue: basetypes.NewStringValue("2026-10-16T20:43:16+24:00"),
+			},
+			expectedDiags: diag.Diagnostics{
+				diag.NewAttributeErrorDiagnostic(
+					path.Root("test"),
+					"Invalid Strict RFC3339 String Value",
+					"A string value was provided that is not valid RFC 3339 date-time string format, such as \"2026-10-16T20:43:16Z\".\n\n"+
+						"Given Value: 2026-10-16T20:43:16+24:00\n"+
+						"Error: offset hour \"24\" is out of range [0, 23]",
+				),
+			},
+		},
+		"invalid - day out of range for month": {
+			strictRFC3339: timetypes.StrictRFC3339{
+				StringValue: basetypes.NewStringValue("2026-02-29T20:43:16Z"),
+			},
+			expectedDiags: diag.Diagnostics{
+				diag.NewAttributeErrorDiagnostic(
+					path.Root("test"),
+					"Invalid Strict RFC3339 String Value",
+					"A string value was provided that is not valid RFC 3339 date-time string format, such as \"2026-10-16T20:43:16Z\".\n\n"+
+						"Given Value: 2026-02-29T20:43:16Z\n"+
+						"Error: day 29 is out of range for 2026-02, which has 28 days",
+				),
+			},
+		},
+		"invalid - space separator": {
+			strictRFC3339: timetypes.StrictRFC3339{
+				StringValue: basetypes.NewStringValue("2026-10-16 20:43:16Z"),
+			},
+			expectedDiags: diag.Diagnostics{
+				diag.NewAttributeErrorDiagnostic(
+					path.Root("test"),
+					"Invalid Strict RFC3339 String Value",
+					"A string value was provided that is not valid RFC 3339 date-time string format, such as \"2026-10-16T20:43:16Z\".\n\n"+
+						"Given Value: 2026-10-16 20:43:16Z\n"+
+						"Error: expected 'T' after day, got \" 20:43:16Z\"",
+				),
+			},
+		},
+		"invalid - missing seconds": {
+			strictRFC3339: timetypes.StrictRFC3339{
+				StringValue: basetypes.NewStringValue("2026-10-16T20:43Z"),
+			},
+			expectedDiags: diag.Diagnostics{
+				diag.NewAttributeErrorDiagnostic(
+					path.Root("test"),
+					"Invalid Strict RFC3339 String Value",
+					"A string value was provided that is not valid RFC 3339 date-time string format, such as \"2026-10-16T20:43:16Z\".\n\n"+
+						"Given Value: 2026-10-16T20:43Z\n"+
+						"Error: expected ':' after minute, got \"Z\"",
+				),
+			},
+		},
+		"invalid - empty fractional seconds": {
+			strictRFC3339: timetypes.StrictRFC3339{
+				StringValue: basetypes.NewStringValue("2026-10-16T20:43:16.Z"),
+			},
+			expectedDiags: diag.Diagnostics{
+				diag.NewAttributeErrorDiagnostic(
+					path.Root("test"),
+					"Invalid Strict RFC3339 String Value",
+					"A string value was provided that is not valid RFC 3339 date-time string format, such as \"2026-10-16T20:43:16Z\".\n\n"+
+						"Given Value: 2026-10-16T20:43:16.Z\n"+
+						"Error: expected digits after decimal separator in fractional seconds, got \"Z\"",
+				),
+			},
+		},
+		"invalid - trailing text": {
+			strictRFC3339: timetypes.StrictRFC3339{
+				StringValue: basetypes.NewStringValue("2026-10-16T20:43:16z[UTC]"),
+			},
+			expectedDiags: diag.Diagnostics{
+				diag.NewAttributeErrorDiagnostic(
+					path.Root("test"),
+					"Invalid Strict RFC3339 String Value",
+					"A string value was provided that is not valid RFC 3339 date-time string format, such as \"2026-10-16T20:43:16Z\".\n\n"+
+						"Given Value: 2026-10-16T20:43:16z[UTC]\n"+
+						"Error: unexpected text after UTC offset: \"[UTC]\"",
+				),
+			},
+		},
+	}
+	for name, testCase := range testCases {
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			resp := xattr.ValidateAttributeResponse{}
+
+			testCase.strictRFC3339.ValidateAttribute(
+				context.Background(),
+				xattr.ValidateAttributeRequest{
+					Path: path.Root("test"),
+				},
+				&resp,
+			)
+
+			if diff := cmp.Diff(resp.Diagnostics, testCase.expectedDiags); diff != "" {
+				t.Errorf("Unexpected diagnostics (-got, +expected): %s", diff)
+			}
+		})
+	}
+}
+
+func TestStrictRFC3339ValidateParameter(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		strictRFC3339   timetypes.StrictRFC3339
+		expectedFuncErr *function.FuncError
+	}{
+		"empty-struct": {
+			strictRFC3339: timetypes.StrictRFC3339{},
+		},
+		"null": {
+			strictRFC3339: timetypes.NewStrictRFC3339Null(),
+		},
+		"unknown": {
+			strictRFC3339: timetypes.NewStrictRFC3339Unknown(),
+		},
+		"valid": {
+			strictRFC3339: timetypes.NewStrictRFC3339ValueMust("2026-10-16T20:43:16Z"),
+		},
+		"invalid": {
+			strictRFC3339: timetypes.StrictRFC3339{
+				StringValue: basetypes.NewStringValue("2026-10-16T24:00:00Z"),
+			},
+			expectedFuncErr: function.NewArgumentFuncError(
+				0,
+				"Invalid Strict RFC3339 String Value: "+
+					"A string value was provided that is not valid RFC 3339 date-time string format, such as \"2026-10-16T20:43:16Z\".\n\n"+
+					"Given Value: 2026-10-16T24:00:00Z\n"+
+					"Error: hour \"24\" is out of range [0, 23]",
+			),
+		},
+	}
+	for name, testCase := range testCases {
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			resp := function.ValidateParameterResponse{}
+
+			testCase.strictRFC3339.ValidateParameter(
+				context.Background(),
+				function.ValidateParameterRequest{
+					Position: int64(0),
+				},
+				&resp,
+			)
+
+			if diff := cmp.Diff(resp.Error, testCase.expectedFuncErr); diff != "" {
+				t.Errorf("Unexpected diagnostics (-got, +expected): %s", diff)
+			}
+		})
+	}
+}
+
+func TestStrictRFC3339_ValueRFC3339Time(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		strictRFC3339 timetypes.StrictRFC3339
+		expectedTime  time.Time
+		expectedDiags diag.Diagnostics
+	}{
+		"strict RFC3339 string value is null": {
+			strictRFC3339: timetypes.NewStrictRFC3339Null(),
+			expectedDiags: diag.Diagnostics{
+				diag.NewErrorDiagnostic("StrictRFC3339 ValueRFC3339Time Error", "Strict RFC3339 string value is null"),
+			},
+		},
+		"strict RFC3339 string value is unknown": {
+			strictRFC3339: timetypes.NewStrictRFC3339Unknown(),
+			expectedDiags: diag.Diagnostics{
+				diag.NewErrorDiagnostic("StrictRFC3339 ValueRFC3339Time Error", "Strict RFC3339 string value is unknown"),
+			},
+		},
+		"valid - offset": {
+			strictRFC3339: timetypes.NewStrictRFC3339ValueMust("2026-10-16t22:43:16.25+02:00"),
+			expectedTime:  time.Date(2026, time.October, 16, 22, 43, 16, 250000000, time.FixedZone("", 2*60*60)),
+		},
+		"valid - unknown local offset": {
+			strictRFC3339: timetypes.NewStrictRFC3339ValueMust("2026-10-16T20:43:16-00:00"),
+			expectedTime:  time.Date(2026, time.October, 16, 20, 43, 16, 0, time.UTC),
+		},
+		"valid - fractional seconds truncated": {
+			strictRFC3339: timetypes.NewStrictRFC3339ValueMust("2026-10-16T20:43:16.1234567899Z"),
+			expectedTime:  time.Date(2026, time.October, 16, 20, 43, 16, 123456789, time.UTC),
+		},
+		"valid - leap second": {
+			strictRFC3339: timetypes.NewStrictRFC3339ValueMust("2016-12-31T23:59:60.5Z"),
+			expectedTime:  time.Date(2017, time.January, 1, 0, 0, 0, 500000000, time.UTC),
+		},
+	}
+	for name, testCase := range testCases {
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got, diags := testCase.strictRFC3339.ValueRFC3339Time()
+
+			if !got.Equal(testCase.expectedTime) || got.Format(time.RFC3339Nano) != testCase.expectedTime.Format(time.RFC3339Nano) {
+				t.Errorf("Unexpected difference in time.Time, got: %s, expected: %s", got, testCase.expectedTime)
+			}
+
+			if diff := cmp.Diff(diags, testCase.expectedDiags); diff != "" {
+				t.Errorf("Unexpected diagnostics (-got, +expected): %s", diff)
+			}
+		})
+	}
+}
+
+func TestStrictRFC3339_ValueUnknownLocalOffset(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		strictRFC3339 timetypes.StrictRFC3339
+		expected      bool
+		expectedDiags diag.Diagnostics
+	}{
+		"strict RFC3339 string value is null": {
+			strictRFC3339: timetypes.NewStrictRFC3339Null(),
+			expectedDiags: diag.Diagnostics{
+				diag.NewErrorDiagnostic("StrictRFC3339 ValueUnknownLocalOffset Error", "Strict RFC3339 string value is null"),
+			},
+		},
+		"Z suffix": {
+			strictRFC3339: timetypes.NewStrictRFC3339ValueMust("2026-10-16T20:43:16Z"),
+			expected:      false,
+		},
+		"+00:00 offset": {
+			strictRFC3339: timetypes.NewStrictRFC3339ValueMust("2026-10-16T20:43:16+00:00"),
+			expected:      false,
+		},
+		"-00:00 offset": {
+			strictRFC3339: timetypes.NewStrictRFC3339ValueMust("2026-10-16T20:43:16-00:00"),
+			expected:      true,
+		},
+	}
+	for name, testCase := range testCases {
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got, diags := testCase.strictRFC3339.ValueUnknownLocalOffset()
+
+			if got != testCase.expected {
+				t.Errorf("Expected %t, got %t", testCase.expected, got)
+			}
+
+			if diff := cmp.Diff(diags, testCase.expectedDiags); diff != "" {
+				t.Errorf("Unexpected diagnostics (-got, +expected): %s", diff)
+			}
+		})
+	}
+}
+
+func TestStrictRFC3339_ToRFC3339Value(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		strictRFC3339 timetypes.StrictRFC3339
+		expected      timetypes.RFC3339
+		expectedDiags diag.Diagnostics
+	}{
+		"null": {
+			strictRFC3339: timetypes.NewStrictRFC3339Null(),
+			expected:      timetypes.NewRFC3339Null(),
+		},
+		"unknown": {
+			strictRFC3339: timetypes.NewStrictRFC3339Unknown(),
+			expected:      timetypes.NewRFC3339Unknown(),
+		},
+		"lowercase designators": {
+			strictRFC3339: timetypes.NewStrictRFC3339ValueMust("2026-10-16t22:43:16.25+02:00"),
+			expected:      timetypes.NewRFC3339ValueMust("2026-10-16T22:43:16.25+02:00"),
+		},
+		"leap second": {
+			strictRFC3339: timetypes.NewStrictRFC3339ValueMust("2016-12-31T23:59:60z"),
+			expected:      timetypes.NewRFC3339ValueMust("2017-01-01T00:00:00Z"),
+		},
+	}
+	for name, testCase := range testCases {
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got, diags := testCase.strictRFC3339.ToRFC3339Value()
+
+			if !got.Equal(testCase.expected) {
+				t.Errorf("Expected %s, got %s", testCase.expected, got)
+			}
+
+			if diff := cmp.Diff(diags, testCase.expectedDiags); diff != "" {
+				t.Errorf("Unexpected diagnostics (-got, +expected): %s", diff)
+			}
+		})
+	}
+}
+
+func TestNewStrictRFC3339TimeValue(t *testing.T) {
+	t.Parallel()
+
+	got := timetypes.NewStrictRFC3339TimeValue(time.Date(2026, time.October, 16, 20, 43, 16, 500000000, time.FixedZone("", -4*60*60)))
+	expected := timetypes.NewStrictRFC3339ValueMust("2026-10-16T20:43:16.5-04:00")
+
+	if !got.Equal(expected) {
+		t.Errorf("Expected %s, got %s", expected, got)
+	}
+}
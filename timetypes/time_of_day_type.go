@@ -0,0 +1,77 @@
+// Copyright IBM Corp. 2023, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package timetypes
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+var (
+	_ basetypes.StringTypable = (*TimeOfDayType)(nil)
+)
+
+// TimeOfDayType is an attribute type that represents a valid time of day string, such as `15:04`, `15:04:05` or
+// `15:04:05.123`, with an optional UTC offset. Semantic equality logic is defined for TimeOfDayType such that
+// omitted seconds and fractional seconds are considered equal to zero, e.g. `09:00` and `09:00:00` are equal.
+type TimeOfDayType struct {
+	basetypes.StringType
+}
+
+// String returns a human-readable string of the type name.
+func (t TimeOfDayType) String() string {
+	return "timetypes.TimeOfDayType"
+}
+
+// ValueType returns the Value type.
+func (t TimeOfDayType) ValueType(ctx context.Context) attr.Value {
+	return TimeOfDay{}
+}
+
+// Equal returns true if the given type is equivalent.
+func (t TimeOfDayType) Equal(o attr.Type) bool {
+	other, ok := o.(TimeOfDayType)
+
+	if !ok {
+		return false
+	}
+
+	return t.StringType.Equal(other.StringType)
+}
+
+// ValueFromString returns a StringValuable type given a StringValue.
+func (t TimeOfDayType) ValueFromString(ctx context.Context, in basetypes.StringValue) (basetypes.StringValuable, diag.Diagnostics) {
+	return TimeOfDay{
+		StringValue: in,
+	}, nil
+}
+
+// ValueFromTerraform returns a Value given a tftypes.Value.  This is meant to convert the tftypes.Value into a more convenient Go type
+// for the provider to consume the data with.
+func (t TimeOfDayType) ValueFromTerraform(ctx context.Context, in tftypes.Value) (attr.Value, error) {
+	attrValue, err := t.StringType.ValueFromTerraform(ctx, in)
+
+	if err != nil {
+		return nil, err
+	}
+
+	stringValue, ok := attrValue.(basetypes.StringValue)
+
+	if !ok {
+		return nil, fmt.Errorf("unexpected value type of %T", attrValue)
+	}
+
+	stringValuable, diags := t.ValueFromString(ctx, stringValue)
+
+	if diags.HasError() {
+		return nil, fmt.Errorf("unexpected error converting StringValue to StringValuable: %v", diags)
+	}
+
+	return stringValuable, nil
+}
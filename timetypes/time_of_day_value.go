@@ -0,0 +1,442 @@
+// Copyright IBM Corp. 2023, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package timetypes
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/attr/xattr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+)
+
+var (
+	_ basetypes.StringValuableWithSemanticEquals = (*TimeOfDay)(nil)
+	_ xattr.ValidateableAttribute                = (*TimeOfDay)(nil)
+	_ function.ValidateableParameter             = (*TimeOfDay)(nil)
+)
+
+// TimeOfDay represents a valid time of day string in the format `hh:mm`, `hh:mm:ss` or `hh:mm:ss.fff`, where the
+// fractional seconds may contain up to 9 digits. The time may be followed by an optional UTC offset of either `Z`
+// or `+hh:mm`/`-hh:mm`, in the style of an RFC 3339 partial-time or full-time.
+//
+// Semantic equality logic is defined for TimeOfDay such that omitted seconds and fractional seconds are considered
+// equal to zero and inconsequential differences between the `Z` suffix and a `00:00` UTC offset are ignored.
+type TimeOfDay struct {
+	basetypes.StringValue
+}
+
+// TimeOfDayComponents represents the parts of a time of day.
+type TimeOfDayComponents struct {
+	// Hour is the hour of the day, in the range [0, 23].
+	Hour int
+
+	// Minute is the minute of the hour, in the range [0, 59].
+	Minute int
+
+	// Second is the second of the minute, in the range [0, 59].
+	Second int
+
+	// Nanosecond is the fractional second, in the range [0, 999999999].
+	Nanosecond int
+
+	// HasOffset is true if the time of day included a UTC offset.
+	HasOffset bool
+
+	// Offset is the UTC offset in seconds east of UTC. It is only meaningful if HasOffset is true.
+	Offset int
+}
+
+// Location returns a fixed time.Location for the UTC offset of the time of day, or nil if no offset was given. A zero
+// offset is returned as time.UTC.
+func (c TimeOfDayComponents) Location() *time.Location {
+	if !c.HasOffset {
+		return nil
+	}
+
+	return utcOffsetLocation(c.Offset)
+}
+
+// On returns the time of day on the date of the given time.Time. If the time of day has a UTC offset, the returned
+// time.Time uses that offset, otherwise the location of the given time.Time is used.
+func (c TimeOfDayComponents) On(date time.Time) time.Time {
+	loc := date.Location()
+
+	if c.HasOffset {
+		loc = c.Location()
+	}
+
+	return time.Date(date.Year(), date.Month(), date.Day(), c.Hour, c.Minute, c.Second, c.Nanosecond, loc)
+}
+
+// Type returns a TimeOfDayType.
+func (v TimeOfDay) Type(_ context.Context) attr.Type {
+	return TimeOfDayType{}
+}
+
+// Equal returns true if the given value is equivalent.
+func (v TimeOfDay) Equal(o attr.Value) bool {
+	other, ok := o.(TimeOfDay)
+
+	if !ok {
+		return false
+	}
+
+	return v.StringValue.Equal(other.StringValue)
+}
+
+// StringSemanticEquals returns true if the given TimeOfDay string value is semantically equal to the current TimeOfDay
+// string value. Both values are parsed into their components, with omitted seconds and fractional seconds treated as
+// zero, and then compared.
+//
+// Examples:
+//   - `09:00` is semantically equal to `09:00:00`
+//   - `09:00:00.500` is semantically equal to `09:00:00.5`
+//   - `09:00Z` is semantically equal to `09:00:00+00:00`
+//
+// Counterexamples:
+//   - `09:00` is NOT semantically equal to `09:00Z`, as the first does not define a UTC offset.
+//   - `09:00+02:00` expresses the same time as `07:00Z` but is NOT considered to be semantically equal.
+func (v TimeOfDay) StringSemanticEquals(_ context.Context, newValuable basetypes.StringValuable) (bool, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	newValue, ok := newValuable.(TimeOfDay)
+	if !ok {
+		diags.AddError(
+			"Semantic Equality Check Error",
+			"An unexpected value type was received while performing semantic equality checks. "+
+				"Please report this to the provider developers.\n\n"+
+				"Expected Value Type: "+fmt.Sprintf("%T", v)+"\n"+
+				"Got Value Type: "+fmt.Sprintf("%T", newValuable),
+		)
+
+		return false, diags
+	}
+
+	// Time of day strings are already validated at this point, ignoring errors
+	currentComponents, _ := parseTimeOfDay(v.ValueString())
+	newComponents, _ := parseTimeOfDay(newValue.ValueString())
+
+	return currentComponents == newComponents, diags
+}
+
+// ValidateAttribute implements attribute value validation. This type requires the value to be a String value that
+// is a valid time of day.
+func (v TimeOfDay) ValidateAttribute(ctx context.Context, req xattr.ValidateAttributeRequest, resp *xattr.ValidateAttributeResponse) {
+	if v.IsUnknown() || v.IsNull() {
+		return
+	}
+
+	if _, err := parseTimeOfDay(v.ValueString()); err != nil {
+		resp.Diagnostics.Append(diag.WithPath(req.Path, timeOfDayInvalidStringDiagnostic(v.ValueString(), err)))
+
+		return
+	}
+}
+
+// ValidateParameter implements provider-defined function parameter value validation. This type requires the value to
+// be a String value that is a valid time of day.
+func (v TimeOfDay) ValidateParameter(ctx context.Context, req function.ValidateParameterRequest, resp *function.ValidateParameterResponse) {
+	if v.IsUnknown() || v.IsNull() {
+		return
+	}
+
+	if _, err := parseTimeOfDay(v.ValueString()); err != nil {
+		resp.Error = function.NewArgumentFuncError(
+			req.Position,
+			"Invalid Time of Day String Value: "+
+				"A string value was provided that is not valid time of day string format. "+
+				`A time of day has the format "15:04", "15:04:05" or "15:04:05.123", optionally followed by a "Z" or "+07:00" UTC offset.`+"\n\n"+
+				"Given Value: "+v.ValueString()+"\n"+
+				"Error: "+err.Error(),
+		)
+
+		return
+	}
+}
+
+// ValueTimeOfDay creates a new TimeOfDayComponents instance with the TimeOfDay StringValue. A null or unknown value
+// will produce an error diagnostic.
+func (v TimeOfDay) ValueTimeOfDay() (TimeOfDayComponents, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	if v.IsNull() {
+		diags.Append(diag.NewErrorDiagnostic("Time of Day ValueTimeOfDay Error", "Time of day string value is null"))
+		return TimeOfDayComponents{}, diags
+	}
+
+	if v.IsUnknown() {
+		diags.Append(diag.NewErrorDiagnostic("Time of Day ValueTimeOfDay Error", "Time of day string value is unknown"))
+		return TimeOfDayComponents{}, diags
+	}
+
+	components, err := parseTimeOfDay(v.ValueString())
+	if err != nil {
+		diags.Append(diag.NewErrorDiagnostic("Time of Day ValueTimeOfDay Error", err.Error()))
+		return TimeOfDayComponents{}, diags
+	}
+
+	return components, nil
+}
+
+// NewTimeOfDayNull creates a TimeOfDay with a null value. Determine whether the value is null via IsNull method.
+func NewTimeOfDayNull() TimeOfDay {
+	return TimeOfDay{
+		StringValue: basetypes.NewStringNull(),
+	}
+}
+
+// NewTimeOfDayUnknown creates a TimeOfDay with an unknown value. Determine whether the value is unknown via IsUnknown method.
+func NewTimeOfDayUnknown() TimeOfDay {
+	return TimeOfDay{
+		StringValue: basetypes.NewStringUnknown(),
+	}
+}
+
+// NewTimeOfDayTimeValue creates a TimeOfDay with a known value. The wall clock time of the time.Time in its own
+// location is used, including any fractional seconds, and no UTC offset is included.
+func NewTimeOfDayTimeValue(value time.Time) TimeOfDay {
+	return TimeOfDay{
+		StringValue: basetypes.NewStringValue(value.Format("15:04:05.999999999")),
+	}
+}
+
+// NewTimeOfDayTimePointerValue creates a TimeOfDay with a null value if nil or
+// a known value. The wall clock time of the time.Time in its own location is
+// used, including any fractional seconds, and no UTC offset is included.
+func NewTimeOfDayTimePointerValue(value *time.Time) TimeOfDay {
+	if value == nil {
+		return NewTimeOfDayNull()
+	}
+
+	return NewTimeOfDayTimeValue(*value)
+}
+
+// NewTimeOfDayValue creates a TimeOfDay with a known value or raises an error
+// diagnostic if the string is not time of day format.
+func NewTimeOfDayValue(value string) (TimeOfDay, diag.Diagnostics) {
+	_, err := parseTimeOfDay(value)
+
+	if err != nil {
+		// Returning an unknown value will guarantee that, as a last resort,
+		// Terraform will return an error if attempting to store into state.
+		return NewTimeOfDayUnknown(), diag.Diagnostics{timeOfDayInvalidStringDiagnostic(value, err)}
+	}
+
+	return TimeOfDay{
+		StringValue: basetypes.NewStringValue(value),
+	}, nil
+}
+
+// NewTimeOfDayValueMust creates a TimeOfDay with a known value or raises a panic
+// if the string is not time of day format.
+//
+// This creation function is only recommended to create TimeOfDay values which
+// either will not potentially affect practitioners, such as testing, or within
+// exhaustively tested provider logic.
+func NewTimeOfDayValueMust(value string) TimeOfDay {
+	_, err := parseTimeOfDay(value)
+
+	if err != nil {
+		panic(fmt.Sprintf("Invalid Time of Day String Value (%s): %s", value, err))
+	}
+
+	return TimeOfDay{
+		StringValue: basetypes.NewStringValue(value),
+	}
+}
+
+// NewTimeOfDayPointerValue creates a TimeOfDay with a null value if nil, a known
+// value, or raises an error diagnostic if the string is not time of day format.
+func NewTimeOfDayPointerValue(value *string) (TimeOfDay, diag.Diagnostics) {
+	if value == nil {
+		return NewTimeOfDayNull(), nil
+	}
+
+	return NewTimeOfDayValue(*value)
+}
+
+// NewTimeOfDayPointerValueMust creates a TimeOfDay with a null value if nil, a
+// known value, or raises a panic if the string is not time of day format.
+//
+// This creation function is only recommended to create TimeOfDay values which
+// either will not potentially affect practitioners, such as testing, or within
+// exhaustively tested provider logic.
+func NewTimeOfDayPointerValueMust(value *string) TimeOfDay {
+	if value == nil {
+		return NewTimeOfDayNull()
+	}
+
+	return NewTimeOfDayValueMust(*value)
+}
+
+// parseTimeOfDay parses a time of day string of the format `hh:mm[:ss[.fff]][offset]` into its components.
+func parseTimeOfDay(value string) (TimeOfDayComponents, error) {
+	var components TimeOfDayComponents
+
+	clock, rest, err := parseClock(value)
+	if err != nil {
+		return components, err
+	}
+
+	components.Hour = clock.Hour
+	components.Minute = clock.Minute
+	components.Second = clock.Second
+	components.Nanosecond = clock.Nanosecond
+
+	if rest == "" {
+		return components, nil
+	}
+
+	offset, err := parseUTCOffset(rest)
+	if err != nil {
+		return components, err
+	}
+
+	components.HasOffset = true
+	components.Offset = offset
+
+	return components, nil
+}
+
+// parseClock parses the `hh:mm[:ss[.fff]]` prefix of a string, returning the parsed clock components (without an
+// offset) and the remainder of the string.
+func parseClock(value string) (TimeOfDayComponents, string, error) {
+	var components TimeOfDayComponents
+	var err error
+
+	if components.Hour, value, err = parseFixedDigits(value, 2, "hour", 0, 23); err != nil {
+		return components, value, err
+	}
+
+	if value, err = parseSeparator(value, ':', "hour"); err != nil {
+		return components, value, err
+	}
+
+	if components.Minute, value, err = parseFixedDigits(value, 2, "minute", 0, 59); err != nil {
+		return components, value, err
+	}
+
+	if !strings.HasPrefix(value, ":") {
+		return components, value, nil
+	}
+
+	if components.Second, value, err = parseFixedDigits(value[1:], 2, "second", 0, 59); err != nil {
+		return components, value, err
+	}
+
+	if !strings.HasPrefix(value, ".") {
+		return components, value, nil
+	}
+
+	i := 1
+
+	for i < len(value) && value[i] >= '0' && value[i] <= '9' {
+		i++
+	}
+
+	switch {
+	case i == 1:
+		return components, value, errors.New("expected digits after decimal separator in fractional seconds")
+	case i > 10:
+		return components, value, errors.New("fractional seconds must not have more than 9 digits")
+	}
+
+	for j := 1; j < 10; j++ {
+		components.Nanosecond *= 10
+
+		if j < i {
+			components.Nanosecond += int(value[j] - '0')
+		}
+	}
+
+	return components, value[i:], nil
+}
+
+// parseUTCOffset parses a UTC offset string of either `Z` or `+hh:mm`/`-hh:mm`, returning the offset in seconds east
+// of UTC.
+func parseUTCOffset(value string) (int, error) {
+	if value == "Z" {
+		return 0, nil
+	}
+
+	if value == "" || (value[0] != '+' && value[0] != '-') {
+		return 0, fmt.Errorf(`expected a "Z" or "+hh:mm" UTC offset, got %q`, value)
+	}
+
+	sign := 1
+
+	if value[0] == '-' {
+		sign = -1
+	}
+
+	hours, rest, err := parseFixedDigits(value[1:], 2, "offset hour", 0, 23)
+	if err != nil {
+		return 0, err
+	}
+
+	if rest, err = parseSeparator(rest, ':', "offset hour"); err != nil {
+		return 0, err
+	}
+
+	minutes, rest, err := parseFixedDigits(rest, 2, "offset minute", 0, 59)
+	if err != nil {
+		return 0, err
+	}
+
+	if rest != "" {
+		return 0, fmt.Errorf("unexpected text after UTC offset: %q", rest)
+	}
+
+	return sign * (hours*60*60 + minutes*60), nil
+}
+
+// utcOffsetLocation returns a fixed time.Location for the given UTC offset in seconds, or time.UTC if zero.
+func utcOffsetLocation(offset int) *time.Location {
+	if offset == 0 {
+		return time.UTC
+	}
+
+	return time.FixedZone("", offset)
+}
+
+// parseFixedDigits parses exactly n leading digits of a string as a number within the range [lowerBound, upperBound],
+// returning the number and the remainder of the string. The name is used in returned errors.
+func parseFixedDigits(value string, n int, name string, lowerBound int, upperBound int) (int, string, error) {
+	if len(value) < n {
+		return 0, value, fmt.Errorf("expected %d digit %s, got %q", n, name, value)
+	}
+
+	number := 0
+
+	for i := 0; i < n; i++ {
+		if value[i] < '0' || value[i] > '9' {
+			return 0, value, fmt.Errorf("expected %d digit %s, got %q", n, name, value[:n])
+		}
+
+		number = number*10 + int(value[i]-'0')
+	}
+
+	if number < lowerBound || number > upperBound {
+		return 0, value, fmt.Errorf("%s %q is out of range [%d, %d]", name, value[:n], lowerBound, upperBound)
+	}
+
+	return number, value[n:], nil
+}
+
+// parseSeparator removes the given separator from the start of a string, returning an error mentioning the preceding
+// component name if it is missing.
+func parseSeparator(value string, separator byte, after string) (string, error) {
+	if value == "" || value[0] != separator {
+		return value, fmt.Errorf("expected %q after %s, got %q", separator, after, value)
+	}
+
+	return value[1:], nil
+}
@@ -0,0 +1,36 @@
+// Copyright IBM Corp. 2023, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package timetypes_test
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework-timetypes/timetypes"
+)
+
+type MaintenanceWindowResourceModel struct {
+	StartTime timetypes.TimeOfDay `tfsdk:"start_time"`
+}
+
+func ExampleTimeOfDay_ValueTimeOfDay() {
+	// For example purposes, typically the data model would be populated automatically by Plugin Framework via Config, Plan or State.
+	// https://developer.hashicorp.com/terraform/plugin/framework/handling-data/accessing-values
+	data := MaintenanceWindowResourceModel{
+		StartTime: timetypes.NewTimeOfDayValueMust("03:30"),
+	}
+
+	// Check that the time of day data is known and able to be converted to its components
+	if !data.StartTime.IsNull() && !data.StartTime.IsUnknown() {
+		t, diags := data.StartTime.ValueTimeOfDay()
+		if diags.HasError() {
+			return
+		}
+
+		date := time.Date(2023, time.July, 25, 0, 0, 0, 0, time.UTC)
+
+		// Output: 2023-07-25T03:30:00Z
+		fmt.Println(t.On(date).Format(time.RFC3339))
+	}
+}
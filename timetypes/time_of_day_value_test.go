@@ -0,0 +1,403 @@
+// Copyright IBM Corp. 2023, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package timetypes_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-framework/attr/xattr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+
+	"github.com/hashicorp/terraform-plugin-framework-timetypes/timetypes"
+)
+
+func TestTimeOfDay_StringSemanticEquals(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		currentTimeOfDay timetypes.TimeOfDay
+		givenTimeOfDay   basetypes.StringValuable
+		expectedMatch    bool
+		expectedDiags    diag.Diagnostics
+	}{
+		"not equal - different times": {
+			currentTimeOfDay: timetypes.NewTimeOfDayValueMust("09:00"),
+			givenTimeOfDay:   timetypes.NewTimeOfDayValueMust("09:01"),
+			expectedMatch:    false,
+		},
+		"not equal - fractional seconds": {
+			currentTimeOfDay: timetypes.NewTimeOfDayValueMust("09:00:00"),
+			givenTimeOfDay:   timetypes.NewTimeOfDayValueMust("09:00:00.001"),
+			expectedMatch:    false,
+		},
+		"not equal - offset and no offset": {
+			currentTimeOfDay: timetypes.NewTimeOfDayValueMust("09:00"),
+			givenTimeOfDay:   timetypes.NewTimeOfDayValueMust("09:00Z"),
+			expectedMatch:    false,
+		},
+		"not equal - UTC time and local time": {
+			currentTimeOfDay: timetypes.NewTimeOfDayValueMust("07:00Z"),
+			givenTimeOfDay:   timetypes.NewTimeOfDayValueMust("09:00+02:00"),
+			expectedMatch:    false,
+		},
+		"semantically equal - omitted seconds": {
+			currentTimeOfDay: timetypes.NewTimeOfDayValueMust("09:00"),
+			givenTimeOfDay:   timetypes.NewTimeOfDayValueMust("09:00:00"),
+			expectedMatch:    true,
+		},
+		"semantically equal - trailing zeros": {
+			currentTimeOfDay: timetypes.NewTimeOfDayValueMust("09:00:00.500"),
+			givenTimeOfDay:   timetypes.NewTimeOfDayValueMust("09:00:00.5"),
+			expectedMatch:    true,
+		},
+		"semantically equal - Z suffix and zero num offset": {
+			currentTimeOfDay: timetypes.NewTimeOfDayValueMust("09:00Z"),
+			givenTimeOfDay:   timetypes.NewTimeOfDayValueMust("09:00:00-00:00"),
+			expectedMatch:    true,
+		},
+		"error - not given TimeOfDay value": {
+			currentTimeOfDay: timetypes.NewTimeOfDayValueMust("09:00"),
+			givenTimeOfDay:   basetypes.NewStringValue("09:00"),
+			expectedMatch:    false,
+			expectedDiags: diag.Diagnostics{
+				diag.NewErrorDiagnostic(
+					"Semantic Equality Check Error",
+					"An unexpected value type was received while performing semantic equality checks. "+
+						"Please report this to the provider developers.\n\n"+
+						"Expected Value Type: timetypes.TimeOfDay\n"+
+						"Got Value Type: basetypes.StringValue",
+				),
+			},
+		},
+	}
+	for name, testCase := range testCases {
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			match, diags := testCase.currentTimeOfDay.StringSemanticEquals(context.Background(), testCase.givenTimeOfDay)
+
+			if testCase.expectedMatch != match {
+				t.Errorf("Expected StringSemanticEquals to return: %t, but got: %t", testCase.expectedMatch, match)
+			}
+
+			if diff := cmp.Diff(diags, testCase.expectedDiags); diff != "" {
+				t.Errorf("Unexpected diagnostics (-got, +expected): %s", diff)
+			}
+		})
+	}
+}
+
+func TestTimeOfDayValidateAttribute(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		TimeOfDay     timetypes.TimeOfDay
+		expectedDiags diag.Diagnostics
+	}{
+		"empty-struct": {
+			TimeOfDay: timetypes.TimeOfDay{},
+		},
+		"null": {
+			TimeOfDay: timetypes.NewTimeOfDayNull(),
+		},
+		"unknown": {
+			TimeOfDay: timetypes.NewTimeOfDayUnknown(),
+		},
+		"valid - hours and minutes": {
+			TimeOfDay: timetypes.NewTimeOfDayValueMust("15:04"),
+		},
+		"valid - seconds": {
+			TimeOfDay: timetypes.NewTimeOfDayValueMust("15:04:05"),
+		},
+		"valid - fractional seconds": {
+			TimeOfDay: timetypes.NewTimeOfDayValueMust("15:04:05.123456789"),
+		},
+		"valid - offset": {
+			TimeOfDay: timetypes.NewTimeOfDayValueMust("15:04-07:00"),
+		},
+		"invalid - single digit hour": {
+			TimeOfDay: timetypes.TimeOfDay{
+				StringValue: basetypes.NewStringValue("9:00"),
+			},
+			expectedDiags: diag.Diagnostics{
+				diag.NewAttributeErrorDiagnostic(
+					path.Root("test"),
+					"Invalid Time of Day String Value",
+					"A string value was provided that is not valid time of day string format. "+
+						`A time of day has the format "15:04", "15:04:05" or "15:04:05.123", optionally followed by a "Z" or "+07:00" UTC offset.`+"\n\n"+
+						"Given Value: 9:00\n"+
+						"Error: expected 2 digit hour, got \"9:\"",
+				),
+			},
+		},
+		"invalid - hour out of range": {
+			TimeOfDay: timetypes.TimeOfDay{
+				StringValue: basetypes.NewStringValue("24:00"),
+			},
+			expectedDiags: diag.Diagnostics{
+				diag.NewAttributeErrorDiagnostic(
+					path.Root("test"),
+					"Invalid Time of Day String Value",
+					"A string value was provided that is not valid time of day string format. "+
+						`A time of day has the format "15:04", "15:04:05" or "15:04:05.123", optionally followed by a "Z" or "+07:00" UTC offset.`+"\n\n"+
+						"Given Value: 24:00\n"+
+						"Error: hour \"24\" is out of range [0, 23]",
+				),
+			},
+		},
+		"invalid - too many fractional digits": {
+			TimeOfDay: timetypes.TimeOfDay{
+				StringValue: basetypes.NewStringValue("15:04:05.1234567890"),
+			},
+			expectedDiags: diag.Diagnostics{
+				diag.NewAttributeErrorDiagnostic(
+					path.Root("test"),
+					"Invalid Time of Day String Value",
+					"A string value was provided that is not valid time of day string format. "+
+						`A time of day has the format "15:04", "15:04:05" or "15:04:05.123", optionally followed by a "Z" or "+07:00" UTC offset.`+"\n\n"+
+						"Given Value: 15:04:05.1234567890\n"+
+						"Error: fractional seconds must not have more than 9 digits",
+				),
+			},
+		},
+		"invalid - offset": {
+			TimeOfDay: timetypes.TimeOfDay{
+				StringValue: basetypes.NewStringValue("15:04 PST"),
+			},
+			expectedDiags: diag.Diagnostics{
+				diag.NewAttributeErrorDiagnostic(
+					path.Root("test"),
+					"Invalid Time of Day String Value",
+					"A string value was provided that is not valid time of day string format. "+
+						`A time of day has the format "15:04", "15:04:05" or "15:04:05.123", optionally followed by a "Z" or "+07:00" UTC offset.`+"\n\n"+
+						"Given Value: 15:04 PST\n"+
+						"Error: expected a \"Z\" or \"+hh:mm\" UTC offset, got \" PST\"",
+				),
+			},
+		},
+	}
+	for name, testCase := range testCases {
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			resp := xattr.ValidateAttributeResponse{}
+
+			testCase.TimeOfDay.ValidateAttribute(
+				context.Background(),
+				xattr.ValidateAttributeRequest{
+					Path: path.Root("test"),
+				},
+				&resp,
+			)
+
+			if diff := cmp.Diff(resp.Diagnostics, testCase.expectedDiags); diff != "" {
+				t.Errorf("Unexpected diagnostics (-got, +expected): %s", diff)
+			}
+		})
+	}
+}
+
+func TestTimeOfDayValidateParameter(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		TimeOfDay       timetypes.TimeOfDay
+		expectedFuncErr *function.FuncError
+	}{
+		"empty-struct": {
+			TimeOfDay: timetypes.TimeOfDay{},
+		},
+		"null": {
+			TimeOfDay: timetypes.NewTimeOfDayNull(),
+		},
+		"unknown": {
+			TimeOfDay: timetypes.NewTimeOfDayUnknown(),
+		},
+		"valid": {
+			TimeOfDay: timetypes.NewTimeOfDayValueMust("15:04:05Z"),
+		},
+		"invalid - minute out of range": {
+			TimeOfDay: timetypes.TimeOfDay{
+				StringValue: basetypes.NewStringValue("15:60"),
+			},
+			expectedFuncErr: function.NewArgumentFuncError(
+				0,
+				"Invalid Time of Day String Value: "+
+					"A string value was provided that is not valid time of day string format. "+
+					`A time of day has the format "15:04", "15:04:05" or "15:04:05.123", optionally followed by a "Z" or "+07:00" UTC offset.`+"\n\n"+
+					"Given Value: 15:60\n"+
+					"Error: minute \"60\" is out of range [0, 59]",
+			),
+		},
+	}
+	for name, testCase := range testCases {
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			resp := function.ValidateParameterResponse{}
+
+			testCase.TimeOfDay.ValidateParameter(
+				context.Background(),
+				function.ValidateParameterRequest{
+					Position: int64(0),
+				},
+				&resp,
+			)
+
+			if diff := cmp.Diff(resp.Error, testCase.expectedFuncErr); diff != "" {
+				t.Errorf("Unexpected diagnostics (-got, +expected): %s", diff)
+			}
+		})
+	}
+}
+
+func TestTimeOfDay_ValueTimeOfDay(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		TimeOfDay          timetypes.TimeOfDay
+		expectedComponents timetypes.TimeOfDayComponents
+		expectedDiags      diag.Diagnostics
+	}{
+		"Time of day string value is null ": {
+			TimeOfDay: timetypes.NewTimeOfDayNull(),
+			expectedDiags: diag.Diagnostics{
+				diag.NewErrorDiagnostic(
+					"Time of Day ValueTimeOfDay Error",
+					"Time of day string value is null",
+				),
+			},
+		},
+		"Time of day string value is unknown ": {
+			TimeOfDay: timetypes.NewTimeOfDayUnknown(),
+			expectedDiags: diag.Diagnostics{
+				diag.NewErrorDiagnostic(
+					"Time of Day ValueTimeOfDay Error",
+					"Time of day string value is unknown",
+				),
+			},
+		},
+		"valid - hours and minutes": {
+			TimeOfDay: timetypes.NewTimeOfDayValueMust("15:04"),
+			expectedComponents: timetypes.TimeOfDayComponents{
+				Hour:   15,
+				Minute: 4,
+			},
+		},
+		"valid - fractional seconds and offset": {
+			TimeOfDay: timetypes.NewTimeOfDayValueMust("15:04:05.123-05:30"),
+			expectedComponents: timetypes.TimeOfDayComponents{
+				Hour:       15,
+				Minute:     4,
+				Second:     5,
+				Nanosecond: 123000000,
+				HasOffset:  true,
+				Offset:     -(5*60*60 + 30*60),
+			},
+		},
+	}
+	for name, testCase := range testCases {
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			components, diags := testCase.TimeOfDay.ValueTimeOfDay()
+
+			if diff := cmp.Diff(components, testCase.expectedComponents); diff != "" {
+				t.Errorf("Unexpected difference in components (-got, +expected): %s", diff)
+			}
+
+			if diff := cmp.Diff(diags, testCase.expectedDiags); diff != "" {
+				t.Errorf("Unexpected diagnostics (-got, +expected): %s", diff)
+			}
+		})
+	}
+}
+
+func TestTimeOfDayComponents_On(t *testing.T) {
+	t.Parallel()
+
+	date := time.Date(2023, time.July, 25, 23, 43, 16, 0, time.FixedZone("", -4*60*60))
+
+	testCases := map[string]struct {
+		components timetypes.TimeOfDayComponents
+		expected   string
+	}{
+		"no offset": {
+			components: timetypes.TimeOfDayComponents{
+				Hour:   9,
+				Minute: 30,
+			},
+			expected: "2023-07-25T09:30:00-04:00",
+		},
+		"offset": {
+			components: timetypes.TimeOfDayComponents{
+				Hour:      9,
+				Minute:    30,
+				HasOffset: true,
+				Offset:    2 * 60 * 60,
+			},
+			expected: "2023-07-25T09:30:00+02:00",
+		},
+		"zero offset": {
+			components: timetypes.TimeOfDayComponents{
+				Hour:      9,
+				Minute:    30,
+				HasOffset: true,
+			},
+			expected: "2023-07-25T09:30:00Z",
+		},
+	}
+	for name, testCase := range testCases {
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got := testCase.components.On(date).Format(time.RFC3339)
+
+			if got != testCase.expected {
+				t.Errorf("Expected %q, got %q", testCase.expected, got)
+			}
+		})
+	}
+}
+
+func TestNewTimeOfDayTimeValue(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		time     time.Time
+		expected timetypes.TimeOfDay
+	}{
+		"whole seconds": {
+			time:     time.Date(2023, time.July, 25, 9, 0, 0, 0, time.UTC),
+			expected: timetypes.NewTimeOfDayValueMust("09:00:00"),
+		},
+		"fractional seconds": {
+			time:     time.Date(2023, time.July, 25, 9, 0, 0, 500000000, time.UTC),
+			expected: timetypes.NewTimeOfDayValueMust("09:00:00.5"),
+		},
+	}
+	for name, testCase := range testCases {
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got := timetypes.NewTimeOfDayTimeValue(testCase.time)
+
+			if !got.Equal(testCase.expected) {
+				t.Errorf("Expected %s, got %s", testCase.expected, got)
+			}
+		})
+	}
+}
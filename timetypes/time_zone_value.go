@@ -0,0 +1,360 @@
+// Copyright IBM Corp. 2023, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package timetypes
+
+//go:generate go run generate_time_zones.go
+
+import (
+	"cmp"
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+
+	// The time zone database is embedded so that time zone names can always be
+	// loaded, even on machines without a system time zone database.
+	_ "time/tzdata"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/attr/xattr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+)
+
+var (
+	_ basetypes.StringValuableWithSemanticEquals = (*TimeZone)(nil)
+	_ xattr.ValidateableAttribute                = (*TimeZone)(nil)
+	_ function.ValidateableParameter             = (*TimeZone)(nil)
+)
+
+// maxTimeZoneSuggestions is the maximum number of similar time zone names suggested for an invalid time zone name.
+const maxTimeZoneSuggestions = 3
+
+// maxTimeZoneSuggestionDistance is the maximum edit distance between an invalid time zone name and a suggested
+// time zone name. Shorter names are limited to a distance of a third of their length.
+const maxTimeZoneSuggestionDistance = 3
+
+// TimeZone represents a valid IANA time zone database name, such as `Europe/Berlin` or `America/New_York`.
+//
+// Time zone names are validated against the list of names in the time zone database embedded by the time/tzdata
+// package, rather than the time zone database of the machine running the provider, so validation is consistent
+// across machines. Names are case-sensitive.
+//
+// Deprecated aliases from the time zone database, such as `US/Pacific` or `Asia/Calcutta`, are valid but raise a
+// warning diagnostic and are considered semantically equal to their canonical names. The `UTC` and `GMT` names are
+// not deprecated, but are also considered semantically equal to `Etc/UTC` and `Etc/GMT`.
+//
+// See https://www.iana.org/time-zones for more details on the time zone database.
+type TimeZone struct {
+	basetypes.StringValue
+}
+
+// Type returns a TimeZoneType.
+func (v TimeZone) Type(_ context.Context) attr.Type {
+	return TimeZoneType{}
+}
+
+// Equal returns true if the given value is equivalent.
+func (v TimeZone) Equal(o attr.Value) bool {
+	other, ok := o.(TimeZone)
+
+	if !ok {
+		return false
+	}
+
+	return v.StringValue.Equal(other.StringValue)
+}
+
+// StringSemanticEquals returns true if the given time zone string value is semantically equal to the current time
+// zone string value. Deprecated time zone aliases and the equivalent `UTC` and `GMT` names are replaced with their
+// canonical names before comparison, as remote systems commonly return the canonical name of a time zone.
+//
+// Examples:
+//   - `US/Pacific` is semantically equal to `America/Los_Angeles`
+//   - `Asia/Calcutta` is semantically equal to `Asia/Kolkata`
+//   - `Iceland` is semantically equal to `Atlantic/Reykjavik`
+//   - `UTC` is semantically equal to `Etc/UTC`
+//
+// Counterexamples:
+//   - `Iceland` is NOT semantically equal to `Africa/Abidjan`, even though the time zone database links it there.
+//   - `Europe/Berlin` is NOT semantically equal to `Europe/Paris`, even though both currently observe the same offsets.
+func (v TimeZone) StringSemanticEquals(_ context.Context, newValuable basetypes.StringValuable) (bool, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	newValue, ok := newValuable.(TimeZone)
+	if !ok {
+		diags.AddError(
+			"Semantic Equality Check Error",
+			"An unexpected value type was received while performing semantic equality checks. "+
+				"Please report this to the provider developers.\n\n"+
+				"Expected Value Type: "+fmt.Sprintf("%T", v)+"\n"+
+				"Got Value Type: "+fmt.Sprintf("%T", newValuable),
+		)
+
+		return false, diags
+	}
+
+	return canonicalTimeZoneName(v.ValueString()) == canonicalTimeZoneName(newValue.ValueString()), diags
+}
+
+// ValidateAttribute implements attribute value validation. This type requires the value to be a String value that
+// is a valid IANA time zone database name. If the name is not valid, similar time zone names are suggested in the
+// error diagnostic. If the name is a deprecated alias, a warning diagnostic with the canonical name is raised.
+func (v TimeZone) ValidateAttribute(ctx context.Context, req xattr.ValidateAttributeRequest, resp *xattr.ValidateAttributeResponse) {
+	if v.IsUnknown() || v.IsNull() {
+		return
+	}
+
+	if err := validateTimeZoneName(v.ValueString()); err != nil {
+		resp.Diagnostics.Append(diag.WithPath(req.Path, timeZoneInvalidStringDiagnostic(v.ValueString(), err)))
+
+		return
+	}
+
+	if canonical, ok := timeZoneAliases[v.ValueString()]; ok {
+		resp.Diagnostics.Append(diag.WithPath(req.Path, timeZoneDeprecatedAliasDiagnostic(v.ValueString(), canonical)))
+	}
+}
+
+// ValidateParameter implements provider-defined function parameter value validation. This type requires the value to
+// be a String value that is a valid IANA time zone database name. If the name is not valid, similar time zone names
+// are suggested in the error.
+func (v TimeZone) ValidateParameter(ctx context.Context, req function.ValidateParameterRequest, resp *function.ValidateParameterResponse) {
+	if v.IsUnknown() || v.IsNull() {
+		return
+	}
+
+	if err := validateTimeZoneName(v.ValueString()); err != nil {
+		resp.Error = function.NewArgumentFuncError(
+			req.Position,
+			"Invalid Time Zone String Value: "+
+				"A string value was provided that is not a valid IANA time zone database name, such as \"Europe/Berlin\" or \"America/New_York\".\n\n"+
+				"Given Value: "+v.ValueString()+"\n"+
+				"Error: "+err.Error(),
+		)
+
+		return
+	}
+}
+
+// ValueLocation creates a new time.Location instance with the TimeZone StringValue via time.LoadLocation. A null or
+// unknown value will produce an error diagnostic.
+func (v TimeZone) ValueLocation() (*time.Location, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	if v.IsNull() {
+		diags.Append(diag.NewErrorDiagnostic("Time Zone ValueLocation Error", "Time zone string value is null"))
+		return nil, diags
+	}
+
+	if v.IsUnknown() {
+		diags.Append(diag.NewErrorDiagnostic("Time Zone ValueLocation Error", "Time zone string value is unknown"))
+		return nil, diags
+	}
+
+	if err := validateTimeZoneName(v.ValueString()); err != nil {
+		diags.Append(diag.NewErrorDiagnostic("Time Zone ValueLocation Error", err.Error()))
+		return nil, diags
+	}
+
+	loc, err := time.LoadLocation(v.ValueString())
+	if err != nil {
+		diags.Append(diag.NewErrorDiagnostic("Time Zone ValueLocation Error", err.Error()))
+		return nil, diags
+	}
+
+	return loc, nil
+}
+
+// NewTimeZoneNull creates a TimeZone with a null value. Determine whether the value is null via IsNull method.
+func NewTimeZoneNull() TimeZone {
+	return TimeZone{
+		StringValue: basetypes.NewStringNull(),
+	}
+}
+
+// NewTimeZoneUnknown creates a TimeZone with an unknown value. Determine whether the value is unknown via IsUnknown method.
+func NewTimeZoneUnknown() TimeZone {
+	return TimeZone{
+		StringValue: basetypes.NewStringUnknown(),
+	}
+}
+
+// NewTimeZoneLocationValue creates a TimeZone with a null value if nil or a known value of the time.Location name.
+// The time.Location is expected to have been loaded from the time zone database, such as via time.LoadLocation.
+func NewTimeZoneLocationValue(value *time.Location) TimeZone {
+	if value == nil {
+		return NewTimeZoneNull()
+	}
+
+	return TimeZone{
+		StringValue: basetypes.NewStringValue(value.String()),
+	}
+}
+
+// NewTimeZoneValue creates a TimeZone with a known value or raises an error
+// diagnostic if the string is not a valid time zone name.
+func NewTimeZoneValue(value string) (TimeZone, diag.Diagnostics) {
+	err := validateTimeZoneName(value)
+
+	if err != nil {
+		// Returning an unknown value will guarantee that, as a last resort,
+		// Terraform will return an error if attempting to store into state.
+		return NewTimeZoneUnknown(), diag.Diagnostics{timeZoneInvalidStringDiagnostic(value, err)}
+	}
+
+	return TimeZone{
+		StringValue: basetypes.NewStringValue(value),
+	}, nil
+}
+
+// NewTimeZoneValueMust creates a TimeZone with a known value or raises a panic
+// if the string is not a valid time zone name.
+//
+// This creation function is only recommended to create TimeZone values which
+// either will not potentially affect practitioners, such as testing, or within
+// exhaustively tested provider logic.
+func NewTimeZoneValueMust(value string) TimeZone {
+	err := validateTimeZoneName(value)
+
+	if err != nil {
+		panic(fmt.Sprintf("Invalid Time Zone String Value (%s): %s", value, err))
+	}
+
+	return TimeZone{
+		StringValue: basetypes.NewStringValue(value),
+	}
+}
+
+// NewTimeZonePointerValue creates a TimeZone with a null value if nil, a known
+// value, or raises an error diagnostic if the string is not a valid time zone name.
+func NewTimeZonePointerValue(value *string) (TimeZone, diag.Diagnostics) {
+	if value == nil {
+		return NewTimeZoneNull(), nil
+	}
+
+	return NewTimeZoneValue(*value)
+}
+
+// NewTimeZonePointerValueMust creates a TimeZone with a null value if nil, a
+// known value, or raises a panic if the string is not a valid time zone name.
+//
+// This creation function is only recommended to create TimeZone values which
+// either will not potentially affect practitioners, such as testing, or within
+// exhaustively tested provider logic.
+func NewTimeZonePointerValueMust(value *string) TimeZone {
+	if value == nil {
+		return NewTimeZoneNull()
+	}
+
+	return NewTimeZoneValueMust(*value)
+}
+
+// validateTimeZoneName returns an error if the given name is not in the time zone database, suggesting similar names
+// where possible.
+func validateTimeZoneName(name string) error {
+	if _, found := slices.BinarySearch(timeZoneNames, name); found {
+		return nil
+	}
+
+	suggestions := timeZoneSuggestions(name)
+
+	switch len(suggestions) {
+	case 0:
+		return fmt.Errorf("unknown time zone %q", name)
+	case 1:
+		return fmt.Errorf("unknown time zone %q, did you mean %q?", name, suggestions[0])
+	default:
+		quoted := make([]string, 0, len(suggestions))
+
+		for _, suggestion := range suggestions {
+			quoted = append(quoted, fmt.Sprintf("%q", suggestion))
+		}
+
+		return fmt.Errorf("unknown time zone %q, did you mean one of %s?", name, strings.Join(quoted, ", "))
+	}
+}
+
+// canonicalTimeZoneName returns the canonical time zone name for the given name if it is a deprecated alias or an
+// equivalent name, such as `UTC`, otherwise the name is returned unchanged.
+func canonicalTimeZoneName(name string) string {
+	if canonical, ok := timeZoneAliases[name]; ok {
+		return canonical
+	}
+
+	if canonical, ok := timeZoneEquivalents[name]; ok {
+		return canonical
+	}
+
+	return name
+}
+
+// timeZoneSuggestions returns the time zone names closest to the given name by case-insensitive edit distance. If
+// any names only differ by case, only those are returned.
+func timeZoneSuggestions(name string) []string {
+	type candidate struct {
+		name     string
+		distance int
+	}
+
+	var candidates []candidate
+
+	lowerName := strings.ToLower(name)
+	maxDistance := min(maxTimeZoneSuggestionDistance, len(name)/3)
+
+	for _, timeZoneName := range timeZoneNames {
+		distance := levenshteinDistance(lowerName, strings.ToLower(timeZoneName))
+
+		if distance <= maxDistance {
+			candidates = append(candidates, candidate{name: timeZoneName, distance: distance})
+		}
+	}
+
+	slices.SortStableFunc(candidates, func(a, b candidate) int {
+		return cmp.Compare(a.distance, b.distance)
+	})
+
+	suggestions := make([]string, 0, maxTimeZoneSuggestions)
+
+	for i := 0; i < len(candidates) && i < maxTimeZoneSuggestions; i++ {
+		if candidates[0].distance == 0 && candidates[i].distance > 0 {
+			break
+		}
+
+		suggestions = append(suggestions, candidates[i].name)
+	}
+
+	return suggestions
+}
+
+// levenshteinDistance returns the minimum number of single byte insertions, deletions and substitutions required to
+// change string a into string b.
+func levenshteinDistance(a string, b string) int {
+	previous := make([]int, len(b)+1)
+	current := make([]int, len(b)+1)
+
+	for j := range previous {
+		previous[j] = j
+	}
+
+	for i := 1; i <= len(a); i++ {
+		current[0] = i
+
+		for j := 1; j <= len(b); j++ {
+			cost := 1
+
+			if a[i-1] == b[j-1] {
+				cost = 0
+			}
+
+			current[j] = min(previous[j]+1, current[j-1]+1, previous[j-1]+cost)
+		}
+
+		previous, current = current, previous
+	}
+
+	return previous[len(b)]
+}
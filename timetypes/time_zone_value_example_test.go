@@ -0,0 +1,36 @@
+// Copyright IBM Corp. 2023, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package timetypes_test
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework-timetypes/timetypes"
+)
+
+type ScheduleResourceModel struct {
+	TimeZone timetypes.TimeZone `tfsdk:"time_zone"`
+}
+
+func ExampleTimeZone_ValueLocation() {
+	// For example purposes, typically the data model would be populated automatically by Plugin Framework via Config, Plan or State.
+	// https://developer.hashicorp.com/terraform/plugin/framework/handling-data/accessing-values
+	data := ScheduleResourceModel{
+		TimeZone: timetypes.NewTimeZoneValueMust("Europe/Berlin"),
+	}
+
+	// Check that the time zone data is known and able to be converted to time.Location
+	if !data.TimeZone.IsNull() && !data.TimeZone.IsUnknown() {
+		loc, diags := data.TimeZone.ValueLocation()
+		if diags.HasError() {
+			return
+		}
+
+		t := time.Date(2023, time.July, 25, 12, 0, 0, 0, time.UTC)
+
+		// Output: 2023-07-25T14:00:00+02:00
+		fmt.Println(t.In(loc).Format(time.RFC3339))
+	}
+}
@@ -0,0 +1,448 @@
+// Copyright IBM Corp. 2023, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package timetypes_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-framework/attr/xattr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+
+	"github.com/hashicorp/terraform-plugin-framework-timetypes/timetypes"
+)
+
+func TestTimeZone_StringSemanticEquals(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		currentTimeZone timetypes.TimeZone
+		givenTimeZone   basetypes.StringValuable
+		expectedMatch   bool
+		expectedDiags   diag.Diagnostics
+	}{
+		"not equal - different time zones": {
+			currentTimeZone: timetypes.NewTimeZoneValueMust("Europe/Berlin"),
+			givenTimeZone:   timetypes.NewTimeZoneValueMust("Europe/Paris"),
+			expectedMatch:   false,
+		},
+		"not equal - different aliases": {
+			currentTimeZone: timetypes.NewTimeZoneValueMust("US/Pacific"),
+			givenTimeZone:   timetypes.NewTimeZoneValueMust("US/Eastern"),
+			expectedMatch:   false,
+		},
+		"semantically equal - byte for byte match": {
+			currentTimeZone: timetypes.NewTimeZoneValueMust("Europe/Berlin"),
+			givenTimeZone:   timetypes.NewTimeZoneValueMust("Europe/Berlin"),
+			expectedMatch:   true,
+		},
+		"semantically equal - alias and canonical name": {
+			currentTimeZone: timetypes.NewTimeZoneValueMust("US/Pacific"),
+			givenTimeZone:   timetypes.NewTimeZoneValueMust("America/Los_Angeles"),
+			expectedMatch:   true,
+		},
+		"semantically equal - canonical name and alias": {
+			currentTimeZone: timetypes.NewTimeZoneValueMust("Asia/Kolkata"),
+			givenTimeZone:   timetypes.NewTimeZoneValueMust("Asia/Calcutta"),
+			expectedMatch:   true,
+		},
+		"semantically equal - aliases of the same time zone": {
+			currentTimeZone: timetypes.NewTimeZoneValueMust("Etc/Zulu"),
+			givenTimeZone:   timetypes.NewTimeZoneValueMust("Universal"),
+			expectedMatch:   true,
+		},
+		"semantically equal - alias and country zone link": {
+			currentTimeZone: timetypes.NewTimeZoneValueMust("Iceland"),
+			givenTimeZone:   timetypes.NewTimeZoneValueMust("Atlantic/Reykjavik"),
+			expectedMatch:   true,
+		},
+		"semantically equal - alias and country zone link - Africa/Asmera": {
+			currentTimeZone: timetypes.NewTimeZoneValueMust("Africa/Asmera"),
+			givenTimeZone:   timetypes.NewTimeZoneValueMust("Africa/Asmara"),
+			expectedMatch:   true,
+		},
+		"semantically equal - alias and country zone link - Pacific/Truk": {
+			currentTimeZone: timetypes.NewTimeZoneValueMust("Pacific/Truk"),
+			givenTimeZone:   timetypes.NewTimeZoneValueMust("Pacific/Chuuk"),
+			expectedMatch:   true,
+		},
+		"semantically equal - alias and country zone link - Pacific/Yap": {
+			currentTimeZone: timetypes.NewTimeZoneValueMust("Pacific/Yap"),
+			givenTimeZone:   timetypes.NewTimeZoneValueMust("Pacific/Chuuk"),
+			expectedMatch:   true,
+		},
+		"semantically equal - alias and country zone link - Pacific/Ponape": {
+			currentTimeZone: timetypes.NewTimeZoneValueMust("Pacific/Ponape"),
+			givenTimeZone:   timetypes.NewTimeZoneValueMust("Pacific/Pohnpei"),
+			expectedMatch:   true,
+		},
+		"semantically equal - alias and country zone link - Atlantic/Jan_Mayen": {
+			currentTimeZone: timetypes.NewTimeZoneValueMust("Atlantic/Jan_Mayen"),
+			givenTimeZone:   timetypes.NewTimeZoneValueMust("Arctic/Longyearbyen"),
+			expectedMatch:   true,
+		},
+		"semantically equal - alias and country zone link - America/Coral_Harbour": {
+			currentTimeZone: timetypes.NewTimeZoneValueMust("America/Coral_Harbour"),
+			givenTimeZone:   timetypes.NewTimeZoneValueMust("America/Atikokan"),
+			expectedMatch:   true,
+		},
+		"semantically equal - alias and country zone link - Antarctica/South_Pole": {
+			currentTimeZone: timetypes.NewTimeZoneValueMust("Antarctica/South_Pole"),
+			givenTimeZone:   timetypes.NewTimeZoneValueMust("Antarctica/McMurdo"),
+			expectedMatch:   true,
+		},
+		"semantically equal - alias and country zone link - Africa/Timbuktu": {
+			currentTimeZone: timetypes.NewTimeZoneValueMust("Africa/Timbuktu"),
+			givenTimeZone:   timetypes.NewTimeZoneValueMust("Africa/Bamako"),
+			expectedMatch:   true,
+		},
+		"semantically equal - alias and country zone link - America/Virgin": {
+			currentTimeZone: timetypes.NewTimeZoneValueMust("America/Virgin"),
+			givenTimeZone:   timetypes.NewTimeZoneValueMust("America/St_Thomas"),
+			expectedMatch:   true,
+		},
+		"semantically equal - UTC and canonical name": {
+			currentTimeZone: timetypes.NewTimeZoneValueMust("UTC"),
+			givenTimeZone:   timetypes.NewTimeZoneValueMust("Etc/UTC"),
+			expectedMatch:   true,
+		},
+		"semantically equal - GMT and canonical name": {
+			currentTimeZone: timetypes.NewTimeZoneValueMust("Etc/GMT"),
+			givenTimeZone:   timetypes.NewTimeZoneValueMust("GMT"),
+			expectedMatch:   true,
+		},
+		"semantically equal - UTC and alias": {
+			currentTimeZone: timetypes.NewTimeZoneValueMust("UTC"),
+			givenTimeZone:   timetypes.NewTimeZoneValueMust("Zulu"),
+			expectedMatch:   true,
+		},
+		"not equal - UTC and GMT": {
+			currentTimeZone: timetypes.NewTimeZoneValueMust("UTC"),
+			givenTimeZone:   timetypes.NewTimeZoneValueMust("GMT"),
+			expectedMatch:   false,
+		},
+		"not equal - alias and zone it was merged into": {
+			currentTimeZone: timetypes.NewTimeZoneValueMust("Iceland"),
+			givenTimeZone:   timetypes.NewTimeZoneValueMust("Africa/Abidjan"),
+			expectedMatch:   false,
+		},
+		"error - not given TimeZone value": {
+			currentTimeZone: timetypes.NewTimeZoneValueMust("Europe/Berlin"),
+			givenTimeZone:   basetypes.NewStringValue("Europe/Berlin"),
+			expectedMatch:   false,
+			expectedDiags: diag.Diagnostics{
+				diag.NewErrorDiagnostic(
+					"Semantic Equality Check Error",
+					"An unexpected value type was received while performing semantic equality checks. "+
+						"Please report this to the provider developers.\n\n"+
+						"Expected Value Type: timetypes.TimeZone\n"+
+						"Got Value Type: basetypes.StringValue",
+				),
+			},
+		},
+	}
+	for name, testCase := range testCases {
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			match, diags := testCase.currentTimeZone.StringSemanticEquals(context.Background(), testCase.givenTimeZone)
+
+			if testCase.expectedMatch != match {
+				t.Errorf("Expected StringSemanticEquals to return: %t, but got: %t", testCase.expectedMatch, match)
+			}
+
+			if diff := cmp.Diff(diags, testCase.expectedDiags); diff != "" {
+				t.Errorf("Unexpected diagnostics (-got, +expected): %s", diff)
+			}
+		})
+	}
+}
+
+func TestTimeZoneValidateAttribute(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		TimeZone      timetypes.TimeZone
+		expectedDiags diag.Diagnostics
+	}{
+		"empty-struct": {
+			TimeZone: timetypes.TimeZone{},
+		},
+		"null": {
+			TimeZone: timetypes.NewTimeZoneNull(),
+		},
+		"unknown": {
+			TimeZone: timetypes.NewTimeZoneUnknown(),
+		},
+		"valid time zone": {
+			TimeZone: timetypes.NewTimeZoneValueMust("Europe/Berlin"),
+		},
+		"valid time zone - UTC": {
+			TimeZone: timetypes.NewTimeZoneValueMust("UTC"),
+		},
+		"valid time zone - GMT": {
+			TimeZone: timetypes.NewTimeZoneValueMust("GMT"),
+		},
+		"valid time zone - current country zone link": {
+			TimeZone: timetypes.NewTimeZoneValueMust("Europe/Bratislava"),
+		},
+		"deprecated time zone alias": {
+			TimeZone: timetypes.NewTimeZoneValueMust("US/Pacific"),
+			expectedDiags: diag.Diagnostics{
+				diag.NewAttributeWarningDiagnostic(
+					path.Root("test"),
+					"Deprecated Time Zone Name",
+					"A string value was provided that is a deprecated alias in the IANA time zone database. "+
+						"Remote systems may report the canonical time zone name instead.\n\n"+
+						"Given Value: US/Pacific\n"+
+						"Canonical Value: America/Los_Angeles",
+				),
+			},
+		},
+		"deprecated time zone alias - country zone link": {
+			TimeZone: timetypes.NewTimeZoneValueMust("Iceland"),
+			expectedDiags: diag.Diagnostics{
+				diag.NewAttributeWarningDiagnostic(
+					path.Root("test"),
+					"Deprecated Time Zone Name",
+					"A string value was provided that is a deprecated alias in the IANA time zone database. "+
+						"Remote systems may report the canonical time zone name instead.\n\n"+
+						"Given Value: Iceland\n"+
+						"Canonical Value: Atlantic/Reykjavik",
+				),
+			},
+		},
+		"invalid time zone - typo": {
+			TimeZone: timetypes.TimeZone{
+				StringValue: basetypes.NewStringValue("Europe/Berln"),
+			},
+			expectedDiags: diag.Diagnostics{
+				diag.NewAttributeErrorDiagnostic(
+					path.Root("test"),
+					"Invalid Time Zone String Value",
+					"A string value was provided that is not a valid IANA time zone database name, such as \"Europe/Berlin\" or \"America/New_York\".\n\n"+
+						"Given Value: Europe/Berln\n"+
+						"Error: unknown time zone \"Europe/Berln\", did you mean \"Europe/Berlin\"?",
+				),
+			},
+		},
+		"invalid time zone - case": {
+			TimeZone: timetypes.TimeZone{
+				StringValue: basetypes.NewStringValue("europe/berlin"),
+			},
+			expectedDiags: diag.Diagnostics{
+				diag.NewAttributeErrorDiagnostic(
+					path.Root("test"),
+					"Invalid Time Zone String Value",
+					"A string value was provided that is not a valid IANA time zone database name, such as \"Europe/Berlin\" or \"America/New_York\".\n\n"+
+						"Given Value: europe/berlin\n"+
+						"Error: unknown time zone \"europe/berlin\", did you mean \"Europe/Berlin\"?",
+				),
+			},
+		},
+		"invalid time zone - multiple suggestions": {
+			TimeZone: timetypes.TimeZone{
+				StringValue: basetypes.NewStringValue("Asia/Aman"),
+			},
+			expectedDiags: diag.Diagnostics{
+				diag.NewAttributeErrorDiagnostic(
+					path.Root("test"),
+					"Invalid Time Zone String Value",
+					"A string value was provided that is not a valid IANA time zone database name, such as \"Europe/Berlin\" or \"America/New_York\".\n\n"+
+						"Given Value: Asia/Aman\n"+
+						"Error: unknown time zone \"Asia/Aman\", did you mean one of \"Asia/Amman\", \"Asia/Aden\", \"Asia/Almaty\"?",
+				),
+			},
+		},
+		"invalid time zone - local": {
+			TimeZone: timetypes.TimeZone{
+				StringValue: basetypes.NewStringValue("Local"),
+			},
+			expectedDiags: diag.Diagnostics{
+				diag.NewAttributeErrorDiagnostic(
+					path.Root("test"),
+					"Invalid Time Zone String Value",
+					"A string value was provided that is not a valid IANA time zone database name, such as \"Europe/Berlin\" or \"America/New_York\".\n\n"+
+						"Given Value: Local\n"+
+						"Error: unknown time zone \"Local\"",
+				),
+			},
+		},
+	}
+	for name, testCase := range testCases {
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			resp := xattr.ValidateAttributeResponse{}
+
+			testCase.TimeZone.ValidateAttribute(
+				context.Background(),
+				xattr.ValidateAttributeRequest{
+					Path: path.Root("test"),
+				},
+				&resp,
+			)
+
+			if diff := cmp.Diff(resp.Diagnostics, testCase.expectedDiags); diff != "" {
+				t.Errorf("Unexpected diagnostics (-got, +expected): %s", diff)
+			}
+		})
+	}
+}
+
+func TestTimeZoneValidateParameter(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		TimeZone        timetypes.TimeZone
+		expectedFuncErr *function.FuncError
+	}{
+		"empty-struct": {
+			TimeZone: timetypes.TimeZone{},
+		},
+		"null": {
+			TimeZone: timetypes.NewTimeZoneNull(),
+		},
+		"unknown": {
+			TimeZone: timetypes.NewTimeZoneUnknown(),
+		},
+		"valid time zone": {
+			TimeZone: timetypes.NewTimeZoneValueMust("America/New_York"),
+		},
+		"deprecated time zone alias": {
+			TimeZone: timetypes.NewTimeZoneValueMust("Asia/Calcutta"),
+		},
+		"invalid time zone": {
+			TimeZone: timetypes.TimeZone{
+				StringValue: basetypes.NewStringValue("America/New York"),
+			},
+			expectedFuncErr: function.NewArgumentFuncError(
+				0,
+				"Invalid Time Zone String Value: "+
+					"A string value was provided that is not a valid IANA time zone database name, such as \"Europe/Berlin\" or \"America/New_York\".\n\n"+
+					"Given Value: America/New York\n"+
+					"Error: unknown time zone \"America/New York\", did you mean \"America/New_York\"?",
+			),
+		},
+	}
+	for name, testCase := range testCases {
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			resp := function.ValidateParameterResponse{}
+
+			testCase.TimeZone.ValidateParameter(
+				context.Background(),
+				function.ValidateParameterRequest{
+					Position: int64(0),
+				},
+				&resp,
+			)
+
+			if diff := cmp.Diff(resp.Error, testCase.expectedFuncErr); diff != "" {
+				t.Errorf("Unexpected diagnostics (-got, +expected): %s", diff)
+			}
+		})
+	}
+}
+
+func TestTimeZone_ValueLocation(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		TimeZone         timetypes.TimeZone
+		expectedLocation string
+		expectedDiags    diag.Diagnostics
+	}{
+		"Time zone string value is null ": {
+			TimeZone: timetypes.NewTimeZoneNull(),
+			expectedDiags: diag.Diagnostics{
+				diag.NewErrorDiagnostic(
+					"Time Zone ValueLocation Error",
+					"Time zone string value is null",
+				),
+			},
+		},
+		"Time zone string value is unknown ": {
+			TimeZone: timetypes.NewTimeZoneUnknown(),
+			expectedDiags: diag.Diagnostics{
+				diag.NewErrorDiagnostic(
+					"Time Zone ValueLocation Error",
+					"Time zone string value is unknown",
+				),
+			},
+		},
+		"valid time zone": {
+			TimeZone:         timetypes.NewTimeZoneValueMust("Europe/Berlin"),
+			expectedLocation: "Europe/Berlin",
+		},
+	}
+	for name, testCase := range testCases {
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			loc, diags := testCase.TimeZone.ValueLocation()
+
+			if testCase.expectedLocation == "" && loc != nil {
+				t.Errorf("Expected nil location, got: %s", loc)
+			}
+
+			if testCase.expectedLocation != "" && (loc == nil || loc.String() != testCase.expectedLocation) {
+				t.Errorf("Unexpected location, got: %s, expected: %s", loc, testCase.expectedLocation)
+			}
+
+			if diff := cmp.Diff(diags, testCase.expectedDiags); diff != "" {
+				t.Errorf("Unexpected diagnostics (-got, +expected): %s", diff)
+			}
+		})
+	}
+}
+
+func TestNewTimeZoneLocationValue(t *testing.T) {
+	t.Parallel()
+
+	loc, err := time.LoadLocation("America/New_York")
+	if err != nil {
+		t.Fatalf("Unexpected error loading location: %s", err)
+	}
+
+	testCases := map[string]struct {
+		location *time.Location
+		expected timetypes.TimeZone
+	}{
+		"nil": {
+			location: nil,
+			expected: timetypes.NewTimeZoneNull(),
+		},
+		"UTC": {
+			location: time.UTC,
+			expected: timetypes.NewTimeZoneValueMust("UTC"),
+		},
+		"location": {
+			location: loc,
+			expected: timetypes.NewTimeZoneValueMust("America/New_York"),
+		},
+	}
+	for name, testCase := range testCases {
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got := timetypes.NewTimeZoneLocationValue(testCase.location)
+
+			if !got.Equal(testCase.expected) {
+				t.Errorf("Expected %s, got %s", testCase.expected, got)
+			}
+		})
+	}
+}
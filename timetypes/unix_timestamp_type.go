@@ -0,0 +1,117 @@
+// Copyright IBM Corp. 2023, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package timetypes
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+var (
+	_ basetypes.Int64Typable = (*UnixTimestampType)(nil)
+)
+
+// UnixTimestampUnit is the unit of time that a UnixTimestamp counts since the Unix epoch.
+type UnixTimestampUnit int
+
+const (
+	// UnixTimestampUnitSeconds represents a Unix timestamp in seconds. This is the default unit.
+	UnixTimestampUnitSeconds UnixTimestampUnit = iota
+
+	// UnixTimestampUnitMilliseconds represents a Unix timestamp in milliseconds.
+	UnixTimestampUnitMilliseconds
+
+	// UnixTimestampUnitMicroseconds represents a Unix timestamp in microseconds.
+	UnixTimestampUnitMicroseconds
+
+	// UnixTimestampUnitNanoseconds represents a Unix timestamp in nanoseconds.
+	UnixTimestampUnitNanoseconds
+)
+
+// String returns a human-readable name of the unit.
+func (u UnixTimestampUnit) String() string {
+	switch u {
+	case UnixTimestampUnitSeconds:
+		return "seconds"
+	case UnixTimestampUnitMilliseconds:
+		return "milliseconds"
+	case UnixTimestampUnitMicroseconds:
+		return "microseconds"
+	case UnixTimestampUnitNanoseconds:
+		return "nanoseconds"
+	default:
+		return fmt.Sprintf("UnixTimestampUnit(%d)", int(u))
+	}
+}
+
+// UnixTimestampType is an attribute type that represents a valid Unix timestamp integer, counting the number of
+// seconds, milliseconds, microseconds or nanoseconds since 1970-01-01T00:00:00Z, as configured by Unit. The zero
+// value of UnixTimestampType represents a Unix timestamp in seconds.
+type UnixTimestampType struct {
+	basetypes.Int64Type
+
+	// Unit is the unit of time the Unix timestamp counts since the Unix epoch. Values are invalid if Unit is not one
+	// of the UnixTimestampUnit constants.
+	Unit UnixTimestampUnit
+}
+
+// String returns a human-readable string of the type name.
+func (t UnixTimestampType) String() string {
+	return "timetypes.UnixTimestampType"
+}
+
+// ValueType returns the Value type.
+func (t UnixTimestampType) ValueType(ctx context.Context) attr.Value {
+	return UnixTimestamp{
+		unit: t.Unit,
+	}
+}
+
+// Equal returns true if the given type is equivalent.
+func (t UnixTimestampType) Equal(o attr.Type) bool {
+	other, ok := o.(UnixTimestampType)
+
+	if !ok {
+		return false
+	}
+
+	return t.Unit == other.Unit && t.Int64Type.Equal(other.Int64Type)
+}
+
+// ValueFromInt64 returns an Int64Valuable type given an Int64Value.
+func (t UnixTimestampType) ValueFromInt64(ctx context.Context, in basetypes.Int64Value) (basetypes.Int64Valuable, diag.Diagnostics) {
+	return UnixTimestamp{
+		Int64Value: in,
+		unit:       t.Unit,
+	}, nil
+}
+
+// ValueFromTerraform returns a Value given a tftypes.Value.  This is meant to convert the tftypes.Value into a more convenient Go type
+// for the provider to consume the data with.
+func (t UnixTimestampType) ValueFromTerraform(ctx context.Context, in tftypes.Value) (attr.Value, error) {
+	attrValue, err := t.Int64Type.ValueFromTerraform(ctx, in)
+
+	if err != nil {
+		return nil, err
+	}
+
+	int64Value, ok := attrValue.(basetypes.Int64Value)
+
+	if !ok {
+		return nil, fmt.Errorf("unexpected value type of %T", attrValue)
+	}
+
+	int64Valuable, diags := t.ValueFromInt64(ctx, int64Value)
+
+	if diags.HasError() {
+		return nil, fmt.Errorf("unexpected error converting Int64Value to Int64Valuable: %v", diags)
+	}
+
+	return int64Valuable, nil
+}
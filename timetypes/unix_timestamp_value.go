@@ -0,0 +1,334 @@
+// Copyright IBM Corp. 2023, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package timetypes
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"strconv"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/attr/xattr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+)
+
+var (
+	_ basetypes.Int64Valuable        = (*UnixTimestamp)(nil)
+	_ xattr.ValidateableAttribute    = (*UnixTimestamp)(nil)
+	_ function.ValidateableParameter = (*UnixTimestamp)(nil)
+)
+
+const (
+	// minUnixTimestampSeconds is the Unix timestamp in seconds of 0000-01-01T00:00:00Z.
+	minUnixTimestampSeconds int64 = -62167219200
+
+	// maxUnixTimestampSeconds is the Unix timestamp in seconds of 9999-12-31T23:59:59Z.
+	maxUnixTimestampSeconds int64 = 253402300799
+)
+
+// UnixTimestamp represents a valid Unix timestamp integer, counting the number of seconds, milliseconds, microseconds
+// or nanoseconds since 1970-01-01T00:00:00Z, as configured by the Unit of the UnixTimestampType.
+//
+// Unix timestamps must represent a time between 0000-01-01T00:00:00Z and 9999-12-31T23:59:59Z inclusive, which is
+// the range of time.Time values that can be represented as an RFC 3339 string.
+type UnixTimestamp struct {
+	basetypes.Int64Value
+
+	unit UnixTimestampUnit
+}
+
+// Type returns a UnixTimestampType with the unit of the UnixTimestamp.
+func (v UnixTimestamp) Type(_ context.Context) attr.Type {
+	return UnixTimestampType{
+		Unit: v.unit,
+	}
+}
+
+// Equal returns true if the given value is equivalent.
+func (v UnixTimestamp) Equal(o attr.Value) bool {
+	other, ok := o.(UnixTimestamp)
+
+	if !ok {
+		return false
+	}
+
+	return v.unit == other.unit && v.Int64Value.Equal(other.Int64Value)
+}
+
+// Unit returns the unit of time the UnixTimestamp counts since the Unix epoch.
+func (v UnixTimestamp) Unit() UnixTimestampUnit {
+	return v.unit
+}
+
+// ValidateAttribute implements attribute value validation. This type requires the value to be an Int64 value that
+// represents a time between 0000-01-01T00:00:00Z and 9999-12-31T23:59:59Z in the unit of the UnixTimestampType.
+func (v UnixTimestamp) ValidateAttribute(ctx context.Context, req xattr.ValidateAttributeRequest, resp *xattr.ValidateAttributeResponse) {
+	if v.IsUnknown() || v.IsNull() {
+		return
+	}
+
+	if err := validateUnixTimestamp(v.ValueInt64(), v.unit); err != nil {
+		resp.Diagnostics.Append(diag.WithPath(req.Path, unixTimestampInvalidValueDiagnostic(v.ValueInt64(), err)))
+
+		return
+	}
+}
+
+// ValidateParameter implements provider-defined function parameter value validation. This type requires the value to
+// be an Int64 value that represents a time between 0000-01-01T00:00:00Z and 9999-12-31T23:59:59Z in the unit of the
+// UnixTimestampType.
+func (v UnixTimestamp) ValidateParameter(ctx context.Context, req function.ValidateParameterRequest, resp *function.ValidateParameterResponse) {
+	if v.IsUnknown() || v.IsNull() {
+		return
+	}
+
+	if err := validateUnixTimestamp(v.ValueInt64(), v.unit); err != nil {
+		resp.Error = function.NewArgumentFuncError(
+			req.Position,
+			"Invalid Unix Timestamp Value: "+
+				"An integer value was provided that is not a valid Unix timestamp. "+
+				"Unix timestamps must represent a time between 0000-01-01T00:00:00Z and 9999-12-31T23:59:59Z.\n\n"+
+				"Given Value: "+strconv.FormatInt(v.ValueInt64(), 10)+"\n"+
+				"Error: "+err.Error(),
+		)
+
+		return
+	}
+}
+
+// ValueTime creates a new time.Time instance in UTC with the UnixTimestamp Int64Value. A null or unknown value will
+// produce an error diagnostic.
+func (v UnixTimestamp) ValueTime() (time.Time, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	if v.IsNull() {
+		diags.Append(diag.NewErrorDiagnostic("Unix Timestamp ValueTime Error", "Unix timestamp value is null"))
+		return time.Time{}, diags
+	}
+
+	if v.IsUnknown() {
+		diags.Append(diag.NewErrorDiagnostic("Unix Timestamp ValueTime Error", "Unix timestamp value is unknown"))
+		return time.Time{}, diags
+	}
+
+	if err := validateUnixTimestamp(v.ValueInt64(), v.unit); err != nil {
+		diags.Append(diag.NewErrorDiagnostic("Unix Timestamp ValueTime Error", err.Error()))
+		return time.Time{}, diags
+	}
+
+	return unixTimestampTime(v.ValueInt64(), v.unit), nil
+}
+
+// ToRFC3339Value converts the UnixTimestamp to an RFC3339 value in UTC, preserving any fractional seconds. Null and
+// unknown values are converted to null and unknown RFC3339 values respectively.
+func (v UnixTimestamp) ToRFC3339Value() (RFC3339, diag.Diagnostics) {
+	if v.IsNull() {
+		return NewRFC3339Null(), nil
+	}
+
+	if v.IsUnknown() {
+		return NewRFC3339Unknown(), nil
+	}
+
+	t, diags := v.ValueTime()
+	if diags.HasError() {
+		return NewRFC3339Unknown(), diags
+	}
+
+	return newRFC3339TimeNanoValue(t), nil
+}
+
+// NewUnixTimestampNull creates a UnixTimestamp with a null value and the given unit. Determine whether the value is
+// null via IsNull method.
+func NewUnixTimestampNull(unit UnixTimestampUnit) UnixTimestamp {
+	return UnixTimestamp{
+		Int64Value: basetypes.NewInt64Null(),
+		unit:       unit,
+	}
+}
+
+// NewUnixTimestampUnknown creates a UnixTimestamp with an unknown value and the given unit. Determine whether the
+// value is unknown via IsUnknown method.
+func NewUnixTimestampUnknown(unit UnixTimestampUnit) UnixTimestamp {
+	return UnixTimestamp{
+		Int64Value: basetypes.NewInt64Unknown(),
+		unit:       unit,
+	}
+}
+
+// NewUnixTimestampTimeValue creates a UnixTimestamp with a known value of the time.Time in the given unit or raises
+// an error diagnostic if the time.Time cannot be represented. Any precision smaller than the unit is truncated.
+func NewUnixTimestampTimeValue(value time.Time, unit UnixTimestampUnit) (UnixTimestamp, diag.Diagnostics) {
+	unixTimestamp, err := timeUnixTimestamp(value, unit)
+
+	if err != nil {
+		// Returning an unknown value will guarantee that, as a last resort,
+		// Terraform will return an error if attempting to store into state.
+		return NewUnixTimestampUnknown(unit), diag.Diagnostics{unixTimestampInvalidTimeDiagnostic(value, err)}
+	}
+
+	return UnixTimestamp{
+		Int64Value: basetypes.NewInt64Value(unixTimestamp),
+		unit:       unit,
+	}, nil
+}
+
+// NewUnixTimestampTimePointerValue creates a UnixTimestamp with a null value
+// if nil, a known value of the time.Time in the given unit, or raises an error
+// diagnostic if the time.Time cannot be represented.
+func NewUnixTimestampTimePointerValue(value *time.Time, unit UnixTimestampUnit) (UnixTimestamp, diag.Diagnostics) {
+	if value == nil {
+		return NewUnixTimestampNull(unit), nil
+	}
+
+	return NewUnixTimestampTimeValue(*value, unit)
+}
+
+// NewUnixTimestampValue creates a UnixTimestamp with a known value in the given
+// unit or raises an error diagnostic if the value is out of range.
+func NewUnixTimestampValue(value int64, unit UnixTimestampUnit) (UnixTimestamp, diag.Diagnostics) {
+	err := validateUnixTimestamp(value, unit)
+
+	if err != nil {
+		// Returning an unknown value will guarantee that, as a last resort,
+		// Terraform will return an error if attempting to store into state.
+		return NewUnixTimestampUnknown(unit), diag.Diagnostics{unixTimestampInvalidValueDiagnostic(value, err)}
+	}
+
+	return UnixTimestamp{
+		Int64Value: basetypes.NewInt64Value(value),
+		unit:       unit,
+	}, nil
+}
+
+// NewUnixTimestampValueMust creates a UnixTimestamp with a known value in the
+// given unit or raises a panic if the value is out of range.
+//
+// This creation function is only recommended to create UnixTimestamp values which
+// either will not potentially affect practitioners, such as testing, or within
+// exhaustively tested provider logic.
+func NewUnixTimestampValueMust(value int64, unit UnixTimestampUnit) UnixTimestamp {
+	err := validateUnixTimestamp(value, unit)
+
+	if err != nil {
+		panic(fmt.Sprintf("Invalid Unix Timestamp Value (%d): %s", value, err))
+	}
+
+	return UnixTimestamp{
+		Int64Value: basetypes.NewInt64Value(value),
+		unit:       unit,
+	}
+}
+
+// NewUnixTimestampPointerValue creates a UnixTimestamp with a null value if
+// nil, a known value in the given unit, or raises an error diagnostic if the
+// value is out of range.
+func NewUnixTimestampPointerValue(value *int64, unit UnixTimestampUnit) (UnixTimestamp, diag.Diagnostics) {
+	if value == nil {
+		return NewUnixTimestampNull(unit), nil
+	}
+
+	return NewUnixTimestampValue(*value, unit)
+}
+
+// NewUnixTimestampPointerValueMust creates a UnixTimestamp with a null value
+// if nil, a known value in the given unit, or raises a panic if the value is
+// out of range.
+//
+// This creation function is only recommended to create UnixTimestamp values which
+// either will not potentially affect practitioners, such as testing, or within
+// exhaustively tested provider logic.
+func NewUnixTimestampPointerValueMust(value *int64, unit UnixTimestampUnit) UnixTimestamp {
+	if value == nil {
+		return NewUnixTimestampNull(unit)
+	}
+
+	return NewUnixTimestampValueMust(*value, unit)
+}
+
+// validateUnixTimestamp returns an error if the given Unix timestamp in the given unit does not represent a time
+// between 0000-01-01T00:00:00Z and 9999-12-31T23:59:59Z.
+func validateUnixTimestamp(value int64, unit UnixTimestampUnit) error {
+	if err := validateUnixTimestampUnit(unit); err != nil {
+		return err
+	}
+
+	lowerBound, upperBound := unixTimestampBounds(unit)
+
+	if value < lowerBound || value > upperBound {
+		return fmt.Errorf("value %d is out of range [%d, %d] for a Unix timestamp in %s", value, lowerBound, upperBound, unit)
+	}
+
+	return nil
+}
+
+// validateUnixTimestampUnit returns an error if the given unit is not one of the UnixTimestampUnit constants, rather
+// than interpreting the Unix timestamp in a different unit.
+func validateUnixTimestampUnit(unit UnixTimestampUnit) error {
+	switch unit {
+	case UnixTimestampUnitSeconds, UnixTimestampUnitMilliseconds, UnixTimestampUnitMicroseconds, UnixTimestampUnitNanoseconds:
+		return nil
+	default:
+		return fmt.Errorf("unknown unit %s, expected seconds, milliseconds, microseconds or nanoseconds", unit)
+	}
+}
+
+// unixTimestampBounds returns the inclusive range of valid Unix timestamps in the given unit.
+func unixTimestampBounds(unit UnixTimestampUnit) (int64, int64) {
+	switch unit {
+	case UnixTimestampUnitMilliseconds:
+		return minUnixTimestampSeconds * 1e3, maxUnixTimestampSeconds*1e3 + (1e3 - 1)
+	case UnixTimestampUnitMicroseconds:
+		return minUnixTimestampSeconds * 1e6, maxUnixTimestampSeconds*1e6 + (1e6 - 1)
+	case UnixTimestampUnitNanoseconds:
+		// All nanosecond Unix timestamps are within the years 1677 to 2262.
+		return math.MinInt64, math.MaxInt64
+	default:
+		return minUnixTimestampSeconds, maxUnixTimestampSeconds
+	}
+}
+
+// timeUnixTimestamp returns the Unix timestamp in the given unit of the given time.Time, truncating any precision
+// smaller than the unit, or an error if the time.Time cannot be represented.
+func timeUnixTimestamp(value time.Time, unit UnixTimestampUnit) (int64, error) {
+	if err := validateUnixTimestampUnit(unit); err != nil {
+		return 0, err
+	}
+
+	// UnixNano results are undefined outside of the range of an int64.
+	outOfNanoRange := value.Before(time.Unix(0, math.MinInt64)) || value.After(time.Unix(0, math.MaxInt64))
+
+	if year := value.UTC().Year(); year < 0 || year > 9999 || (unit == UnixTimestampUnitNanoseconds && outOfNanoRange) {
+		return 0, fmt.Errorf("time %s is out of range for a Unix timestamp in %s", value.Format(time.RFC3339Nano), unit)
+	}
+
+	switch unit {
+	case UnixTimestampUnitMilliseconds:
+		return value.UnixMilli(), nil
+	case UnixTimestampUnitMicroseconds:
+		return value.UnixMicro(), nil
+	case UnixTimestampUnitNanoseconds:
+		return value.UnixNano(), nil
+	default:
+		return value.Unix(), nil
+	}
+}
+
+// unixTimestampTime returns the time.Time in UTC of the given Unix timestamp in the given unit.
+func unixTimestampTime(value int64, unit UnixTimestampUnit) time.Time {
+	switch unit {
+	case UnixTimestampUnitMilliseconds:
+		return time.UnixMilli(value).UTC()
+	case UnixTimestampUnitMicroseconds:
+		return time.UnixMicro(value).UTC()
+	case UnixTimestampUnitNanoseconds:
+		return time.Unix(0, value).UTC()
+	default:
+		return time.Unix(value, 0).UTC()
+	}
+}
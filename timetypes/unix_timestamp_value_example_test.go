@@ -0,0 +1,34 @@
+// Copyright IBM Corp. 2023, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package timetypes_test
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework-timetypes/timetypes"
+)
+
+type TokenResourceModel struct {
+	ExpiresAt timetypes.UnixTimestamp `tfsdk:"expires_at"`
+}
+
+func ExampleUnixTimestamp_ValueTime() {
+	// For example purposes, typically the data model would be populated automatically by Plugin Framework via Config, Plan or State.
+	// https://developer.hashicorp.com/terraform/plugin/framework/handling-data/accessing-values
+	data := TokenResourceModel{
+		ExpiresAt: timetypes.NewUnixTimestampValueMust(1690328596250, timetypes.UnixTimestampUnitMilliseconds),
+	}
+
+	// Check that the Unix timestamp data is known and able to be converted to time.Time
+	if !data.ExpiresAt.IsNull() && !data.ExpiresAt.IsUnknown() {
+		t, diags := data.ExpiresAt.ValueTime()
+		if diags.HasError() {
+			return
+		}
+
+		// Output: 2023-07-25T23:43:16.25Z
+		fmt.Println(t.Format(time.RFC3339Nano))
+	}
+}
@@ -0,0 +1,439 @@
+// Copyright IBM Corp. 2023, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package timetypes_test
+
+import (
+	"context"
+	"math"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-framework/attr/xattr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+
+	"github.com/hashicorp/terraform-plugin-framework-timetypes/timetypes"
+)
+
+func TestUnixTimestampValidateAttribute(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		UnixTimestamp timetypes.UnixTimestamp
+		expectedDiags diag.Diagnostics
+	}{
+		"empty-struct": {
+			UnixTimestamp: timetypes.UnixTimestamp{},
+		},
+		"null": {
+			UnixTimestamp: timetypes.NewUnixTimestampNull(timetypes.UnixTimestampUnitSeconds),
+		},
+		"unknown": {
+			UnixTimestamp: timetypes.NewUnixTimestampUnknown(timetypes.UnixTimestampUnitSeconds),
+		},
+		"valid seconds": {
+			UnixTimestamp: timetypes.NewUnixTimestampValueMust(1690328596, timetypes.UnixTimestampUnitSeconds),
+		},
+		"valid seconds - negative": {
+			UnixTimestamp: timetypes.NewUnixTimestampValueMust(-1690328596, timetypes.UnixTimestampUnitSeconds),
+		},
+		"valid seconds - lower bound": {
+			UnixTimestamp: timetypes.NewUnixTimestampValueMust(-62167219200, timetypes.UnixTimestampUnitSeconds),
+		},
+		"valid seconds - upper bound": {
+			UnixTimestamp: timetypes.NewUnixTimestampValueMust(253402300799, timetypes.UnixTimestampUnitSeconds),
+		},
+		"valid milliseconds - upper bound": {
+			UnixTimestamp: timetypes.NewUnixTimestampValueMust(253402300799999, timetypes.UnixTimestampUnitMilliseconds),
+		},
+		"invalid seconds - below lower bound": {
+			UnixTimestamp: timetypes.UnixTimestamp{
+				Int64Value: basetypes.NewInt64Value(-62167219201),
+			},
+			expectedDiags: diag.Diagnostics{
+				diag.NewAttributeErrorDiagnostic(
+					path.Root("test"),
+					"Invalid Unix Timestamp Value",
+					"An integer value was provided that is not a valid Unix timestamp. "+
+						"Unix timestamps must represent a time between 0000-01-01T00:00:00Z and 9999-12-31T23:59:59Z.\n\n"+
+						"Given Value: -62167219201\n"+
+						"Error: value -62167219201 is out of range [-62167219200, 253402300799] for a Unix timestamp in seconds",
+				),
+			},
+		},
+		"invalid milliseconds - above upper bound": {
+			UnixTimestamp: unixTimestampValueFromInt64(253402300800000, timetypes.UnixTimestampUnitMilliseconds),
+			expectedDiags: diag.Diagnostics{
+				diag.NewAttributeErrorDiagnostic(
+					path.Root("test"),
+					"Invalid Unix Timestamp Value",
+					"An integer value was provided that is not a valid Unix timestamp. "+
+						"Unix timestamps must represent a time between 0000-01-01T00:00:00Z and 9999-12-31T23:59:59Z.\n\n"+
+						"Given Value: 253402300800000\n"+
+						"Error: value 253402300800000 is out of range [-62167219200000, 253402300799999] for a Unix timestamp in milliseconds",
+				),
+			},
+		},
+		"invalid unit": {
+			UnixTimestamp: unixTimestampValueFromInt64(1690328596, timetypes.UnixTimestampUnit(7)),
+			expectedDiags: diag.Diagnostics{
+				diag.NewAttributeErrorDiagnostic(
+					path.Root("test"),
+					"Invalid Unix Timestamp Value",
+					"An integer value was provided that is not a valid Unix timestamp. "+
+						"Unix timestamps must represent a time between 0000-01-01T00:00:00Z and 9999-12-31T23:59:59Z.\n\n"+
+						"Given Value: 1690328596\n"+
+						"Error: unknown unit UnixTimestampUnit(7), expected seconds, milliseconds, microseconds or nanoseconds",
+				),
+			},
+		},
+		"valid nanoseconds - max int64": {
+			UnixTimestamp: timetypes.NewUnixTimestampValueMust(math.MaxInt64, timetypes.UnixTimestampUnitNanoseconds),
+		},
+		"invalid seconds - above upper bound": {
+			UnixTimestamp: timetypes.UnixTimestamp{
+				Int64Value: basetypes.NewInt64Value(253402300799999),
+			},
+			expectedDiags: diag.Diagnostics{
+				diag.NewAttributeErrorDiagnostic(
+					path.Root("test"),
+					"Invalid Unix Timestamp Value",
+					"An integer value was provided that is not a valid Unix timestamp. "+
+						"Unix timestamps must represent a time between 0000-01-01T00:00:00Z and 9999-12-31T23:59:59Z.\n\n"+
+						"Given Value: 253402300799999\n"+
+						"Error: value 253402300799999 is out of range [-62167219200, 253402300799] for a Unix timestamp in seconds",
+				),
+			},
+		},
+	}
+	for name, testCase := range testCases {
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			resp := xattr.ValidateAttributeResponse{}
+
+			testCase.UnixTimestamp.ValidateAttribute(
+				context.Background(),
+				xattr.ValidateAttributeRequest{
+					Path: path.Root("test"),
+				},
+				&resp,
+			)
+
+			if diff := cmp.Diff(resp.Diagnostics, testCase.expectedDiags); diff != "" {
+				t.Errorf("Unexpected diagnostics (-got, +expected): %s", diff)
+			}
+		})
+	}
+}
+
+func TestUnixTimestampValidateParameter(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		UnixTimestamp   timetypes.UnixTimestamp
+		expectedFuncErr *function.FuncError
+	}{
+		"empty-struct": {
+			UnixTimestamp: timetypes.UnixTimestamp{},
+		},
+		"null": {
+			UnixTimestamp: timetypes.NewUnixTimestampNull(timetypes.UnixTimestampUnitSeconds),
+		},
+		"unknown": {
+			UnixTimestamp: timetypes.NewUnixTimestampUnknown(timetypes.UnixTimestampUnitSeconds),
+		},
+		"valid microseconds": {
+			UnixTimestamp: timetypes.NewUnixTimestampValueMust(1690328596250000, timetypes.UnixTimestampUnitMicroseconds),
+		},
+		"invalid seconds": {
+			UnixTimestamp: timetypes.UnixTimestamp{
+				Int64Value: basetypes.NewInt64Value(1690328596250),
+			},
+			expectedFuncErr: function.NewArgumentFuncError(
+				0,
+				"Invalid Unix Timestamp Value: "+
+					"An integer value was provided that is not a valid Unix timestamp. "+
+					"Unix timestamps must represent a time between 0000-01-01T00:00:00Z and 9999-12-31T23:59:59Z.\n\n"+
+					"Given Value: 1690328596250\n"+
+					"Error: value 1690328596250 is out of range [-62167219200, 253402300799] for a Unix timestamp in seconds",
+			),
+		},
+	}
+	for name, testCase := range testCases {
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			resp := function.ValidateParameterResponse{}
+
+			testCase.UnixTimestamp.ValidateParameter(
+				context.Background(),
+				function.ValidateParameterRequest{
+					Position: int64(0),
+				},
+				&resp,
+			)
+
+			if diff := cmp.Diff(resp.Error, testCase.expectedFuncErr); diff != "" {
+				t.Errorf("Unexpected diagnostics (-got, +expected): %s", diff)
+			}
+		})
+	}
+}
+
+func TestUnixTimestamp_ValueTime(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		UnixTimestamp timetypes.UnixTimestamp
+		expectedTime  time.Time
+		expectedDiags diag.Diagnostics
+	}{
+		"Unix timestamp value is null ": {
+			UnixTimestamp: timetypes.NewUnixTimestampNull(timetypes.UnixTimestampUnitSeconds),
+			expectedDiags: diag.Diagnostics{
+				diag.NewErrorDiagnostic(
+					"Unix Timestamp ValueTime Error",
+					"Unix timestamp value is null",
+				),
+			},
+		},
+		"Unix timestamp value is unknown ": {
+			UnixTimestamp: timetypes.NewUnixTimestampUnknown(timetypes.UnixTimestampUnitSeconds),
+			expectedDiags: diag.Diagnostics{
+				diag.NewErrorDiagnostic(
+					"Unix Timestamp ValueTime Error",
+					"Unix timestamp value is unknown",
+				),
+			},
+		},
+		"Unix timestamp value is out of range ": {
+			UnixTimestamp: timetypes.UnixTimestamp{
+				Int64Value: basetypes.NewInt64Value(math.MaxInt64),
+			},
+			expectedDiags: diag.Diagnostics{
+				diag.NewErrorDiagnostic(
+					"Unix Timestamp ValueTime Error",
+					"value 9223372036854775807 is out of range [-62167219200, 253402300799] for a Unix timestamp in seconds",
+				),
+			},
+		},
+		"seconds": {
+			UnixTimestamp: timetypes.NewUnixTimestampValueMust(1690328596, timetypes.UnixTimestampUnitSeconds),
+			expectedTime:  time.Date(2023, time.July, 25, 23, 43, 16, 0, time.UTC),
+		},
+		"seconds - lower bound": {
+			UnixTimestamp: timetypes.NewUnixTimestampValueMust(-62167219200, timetypes.UnixTimestampUnitSeconds),
+			expectedTime:  time.Date(0, time.January, 1, 0, 0, 0, 0, time.UTC),
+		},
+		"milliseconds": {
+			UnixTimestamp: timetypes.NewUnixTimestampValueMust(1690328596250, timetypes.UnixTimestampUnitMilliseconds),
+			expectedTime:  time.Date(2023, time.July, 25, 23, 43, 16, 250000000, time.UTC),
+		},
+		"microseconds": {
+			UnixTimestamp: timetypes.NewUnixTimestampValueMust(-1000001, timetypes.UnixTimestampUnitMicroseconds),
+			expectedTime:  time.Date(1969, time.December, 31, 23, 59, 58, 999999000, time.UTC),
+		},
+		"nanoseconds": {
+			UnixTimestamp: timetypes.NewUnixTimestampValueMust(1690328596000000001, timetypes.UnixTimestampUnitNanoseconds),
+			expectedTime:  time.Date(2023, time.July, 25, 23, 43, 16, 1, time.UTC),
+		},
+	}
+	for name, testCase := range testCases {
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got, diags := testCase.UnixTimestamp.ValueTime()
+
+			if !got.Equal(testCase.expectedTime) {
+				t.Errorf("Unexpected time, got: %s, expected: %s", got, testCase.expectedTime)
+			}
+
+			if !got.IsZero() && got.Location() != time.UTC {
+				t.Errorf("Expected UTC location, got: %s", got.Location())
+			}
+
+			if diff := cmp.Diff(diags, testCase.expectedDiags); diff != "" {
+				t.Errorf("Unexpected diagnostics (-got, +expected): %s", diff)
+			}
+		})
+	}
+}
+
+func TestUnixTimestamp_ToRFC3339Value(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		UnixTimestamp timetypes.UnixTimestamp
+		expected      timetypes.RFC3339
+		expectedDiags diag.Diagnostics
+	}{
+		"null": {
+			UnixTimestamp: timetypes.NewUnixTimestampNull(timetypes.UnixTimestampUnitSeconds),
+			expected:      timetypes.NewRFC3339Null(),
+		},
+		"unknown": {
+			UnixTimestamp: timetypes.NewUnixTimestampUnknown(timetypes.UnixTimestampUnitSeconds),
+			expected:      timetypes.NewRFC3339Unknown(),
+		},
+		"seconds": {
+			UnixTimestamp: timetypes.NewUnixTimestampValueMust(1690328596, timetypes.UnixTimestampUnitSeconds),
+			expected:      timetypes.NewRFC3339ValueMust("2023-07-25T23:43:16Z"),
+		},
+		"milliseconds - fractional seconds": {
+			UnixTimestamp: timetypes.NewUnixTimestampValueMust(1690328596999, timetypes.UnixTimestampUnitMilliseconds),
+			expected:      timetypes.NewRFC3339ValueMust("2023-07-25T23:43:16.999Z"),
+		},
+		"upper bound": {
+			UnixTimestamp: timetypes.NewUnixTimestampValueMust(253402300799, timetypes.UnixTimestampUnitSeconds),
+			expected:      timetypes.NewRFC3339ValueMust("9999-12-31T23:59:59Z"),
+		},
+		"out of range": {
+			UnixTimestamp: timetypes.UnixTimestamp{
+				Int64Value: basetypes.NewInt64Value(253402300800),
+			},
+			expected: timetypes.NewRFC3339Unknown(),
+			expectedDiags: diag.Diagnostics{
+				diag.NewErrorDiagnostic(
+					"Unix Timestamp ValueTime Error",
+					"value 253402300800 is out of range [-62167219200, 253402300799] for a Unix timestamp in seconds",
+				),
+			},
+		},
+	}
+	for name, testCase := range testCases {
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got, diags := testCase.UnixTimestamp.ToRFC3339Value()
+
+			if !got.Equal(testCase.expected) {
+				t.Errorf("Expected %s, got %s", testCase.expected, got)
+			}
+
+			if diff := cmp.Diff(diags, testCase.expectedDiags); diff != "" {
+				t.Errorf("Unexpected diagnostics (-got, +expected): %s", diff)
+			}
+		})
+	}
+}
+
+func TestNewUnixTimestampTimeValue(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		time          time.Time
+		unit          timetypes.UnixTimestampUnit
+		expected      timetypes.UnixTimestamp
+		expectedDiags diag.Diagnostics
+	}{
+		"seconds": {
+			time:     time.Date(2023, time.July, 25, 20, 43, 16, 999999999, time.FixedZone("", -3*60*60)),
+			unit:     timetypes.UnixTimestampUnitSeconds,
+			expected: timetypes.NewUnixTimestampValueMust(1690328596, timetypes.UnixTimestampUnitSeconds),
+		},
+		"milliseconds": {
+			time:     time.Date(2023, time.July, 25, 23, 43, 16, 250999999, time.UTC),
+			unit:     timetypes.UnixTimestampUnitMilliseconds,
+			expected: timetypes.NewUnixTimestampValueMust(1690328596250, timetypes.UnixTimestampUnitMilliseconds),
+		},
+		"microseconds - before epoch": {
+			time:     time.Date(1969, time.December, 31, 23, 59, 58, 999999999, time.UTC),
+			unit:     timetypes.UnixTimestampUnitMicroseconds,
+			expected: timetypes.NewUnixTimestampValueMust(-1000001, timetypes.UnixTimestampUnitMicroseconds),
+		},
+		"nanoseconds": {
+			time:     time.Date(2023, time.July, 25, 23, 43, 16, 1, time.UTC),
+			unit:     timetypes.UnixTimestampUnitNanoseconds,
+			expected: timetypes.NewUnixTimestampValueMust(1690328596000000001, timetypes.UnixTimestampUnitNanoseconds),
+		},
+		"nanoseconds - out of range": {
+			time:     time.Date(2300, time.January, 1, 0, 0, 0, 0, time.UTC),
+			unit:     timetypes.UnixTimestampUnitNanoseconds,
+			expected: timetypes.NewUnixTimestampUnknown(timetypes.UnixTimestampUnitNanoseconds),
+			expectedDiags: diag.Diagnostics{
+				diag.NewErrorDiagnostic(
+					"Invalid Unix Timestamp Time Value",
+					"A time value was provided that cannot be represented as a Unix timestamp. "+
+						"Unix timestamps must represent a time between 0000-01-01T00:00:00Z and 9999-12-31T23:59:59Z.\n\n"+
+						"Given Value: 2300-01-01T00:00:00Z\n"+
+						"Error: time 2300-01-01T00:00:00Z is out of range for a Unix timestamp in nanoseconds",
+				),
+			},
+		},
+		"invalid unit": {
+			time:     time.Date(2023, time.July, 25, 23, 43, 16, 0, time.UTC),
+			unit:     timetypes.UnixTimestampUnit(-1),
+			expected: timetypes.NewUnixTimestampUnknown(timetypes.UnixTimestampUnit(-1)),
+			expectedDiags: diag.Diagnostics{
+				diag.NewErrorDiagnostic(
+					"Invalid Unix Timestamp Time Value",
+					"A time value was provided that cannot be represented as a Unix timestamp. "+
+						"Unix timestamps must represent a time between 0000-01-01T00:00:00Z and 9999-12-31T23:59:59Z.\n\n"+
+						"Given Value: 2023-07-25T23:43:16Z\n"+
+						"Error: unknown unit UnixTimestampUnit(-1), expected seconds, milliseconds, microseconds or nanoseconds",
+				),
+			},
+		},
+		"seconds - out of range": {
+			time:     time.Date(9999, time.December, 31, 23, 0, 0, 0, time.FixedZone("", -2*60*60)),
+			unit:     timetypes.UnixTimestampUnitSeconds,
+			expected: timetypes.NewUnixTimestampUnknown(timetypes.UnixTimestampUnitSeconds),
+			expectedDiags: diag.Diagnostics{
+				diag.NewErrorDiagnostic(
+					"Invalid Unix Timestamp Time Value",
+					"A time value was provided that cannot be represented as a Unix timestamp. "+
+						"Unix timestamps must represent a time between 0000-01-01T00:00:00Z and 9999-12-31T23:59:59Z.\n\n"+
+						"Given Value: 9999-12-31T23:00:00-02:00\n"+
+						"Error: time 9999-12-31T23:00:00-02:00 is out of range for a Unix timestamp in seconds",
+				),
+			},
+		},
+	}
+	for name, testCase := range testCases {
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got, diags := timetypes.NewUnixTimestampTimeValue(testCase.time, testCase.unit)
+
+			if !got.Equal(testCase.expected) {
+				t.Errorf("Expected %s, got %s", testCase.expected, got)
+			}
+
+			if diff := cmp.Diff(diags, testCase.expectedDiags); diff != "" {
+				t.Errorf("Unexpected diagnostics (-got, +expected): %s", diff)
+			}
+		})
+	}
+}
+
+func TestUnixTimestamp_Type(t *testing.T) {
+	t.Parallel()
+
+	got := timetypes.NewUnixTimestampValueMust(1690328596250, timetypes.UnixTimestampUnitMilliseconds).Type(context.Background())
+	expected := timetypes.UnixTimestampType{Unit: timetypes.UnixTimestampUnitMilliseconds}
+
+	if !got.Equal(expected) {
+		t.Errorf("Expected %s, got %s", expected, got)
+	}
+
+	if got.Equal(timetypes.UnixTimestampType{}) {
+		t.Errorf("Expected types with different units to not be equal")
+	}
+}
+
+// unixTimestampValueFromInt64 returns a UnixTimestamp in the given unit without validating the value.
+func unixTimestampValueFromInt64(value int64, unit timetypes.UnixTimestampUnit) timetypes.UnixTimestamp {
+	valuable, _ := timetypes.UnixTimestampType{Unit: unit}.ValueFromInt64(context.Background(), basetypes.NewInt64Value(value))
+
+	return valuable.(timetypes.UnixTimestamp)
+}
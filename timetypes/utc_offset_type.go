@@ -0,0 +1,76 @@
+// Copyright IBM Corp. 2023, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package timetypes
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+var (
+	_ basetypes.StringTypable = (*UTCOffsetType)(nil)
+)
+
+// UTCOffsetType is an attribute type that represents a valid fixed UTC offset string, such as `+05:30`, `-08:00` or
+// `Z`. Semantic equality logic is defined for UTCOffsetType such that `Z`, `+00:00` and `-00:00` are considered equal.
+type UTCOffsetType struct {
+	basetypes.StringType
+}
+
+// String returns a human-readable string of the type name.
+func (t UTCOffsetType) String() string {
+	return "timetypes.UTCOffsetType"
+}
+
+// ValueType returns the Value type.
+func (t UTCOffsetType) ValueType(ctx context.Context) attr.Value {
+	return UTCOffset{}
+}
+
+// Equal returns true if the given type is equivalent.
+func (t UTCOffsetType) Equal(o attr.Type) bool {
+	other, ok := o.(UTCOffsetType)
+
+	if !ok {
+		return false
+	}
+
+	return t.StringType.Equal(other.StringType)
+}
+
+// ValueFromString returns a StringValuable type given a StringValue.
+func (t UTCOffsetType) ValueFromString(ctx context.Context, in basetypes.StringValue) (basetypes.StringValuable, diag.Diagnostics) {
+	return UTCOffset{
+		StringValue: in,
+	}, nil
+}
+
+// ValueFromTerraform returns a Value given a tftypes.Value.  This is meant to convert the tftypes.Value into a more convenient Go type
+// for the provider to consume the data with.
+func (t UTCOffsetType) ValueFromTerraform(ctx context.Context, in tftypes.Value) (attr.Value, error) {
+	attrValue, err := t.StringType.ValueFromTerraform(ctx, in)
+
+	if err != nil {
+		return nil, err
+	}
+
+	stringValue, ok := attrValue.(basetypes.StringValue)
+
+	if !ok {
+		return nil, fmt.Errorf("unexpected value type of %T", attrValue)
+	}
+
+	stringValuable, diags := t.ValueFromString(ctx, stringValue)
+
+	if diags.HasError() {
+		return nil, fmt.Errorf("unexpected error converting StringValue to StringValuable: %v", diags)
+	}
+
+	return stringValuable, nil
+}
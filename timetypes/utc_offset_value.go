@@ -0,0 +1,261 @@
+// Copyright IBM Corp. 2023, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package timetypes
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/attr/xattr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+)
+
+var (
+	_ basetypes.StringValuableWithSemanticEquals = (*UTCOffset)(nil)
+	_ xattr.ValidateableAttribute                = (*UTCOffset)(nil)
+	_ function.ValidateableParameter             = (*UTCOffset)(nil)
+)
+
+// UTCOffset represents a valid fixed UTC offset string of either `Z` or `+hh:mm`/`-hh:mm`, in the style of an
+// RFC 3339 time-offset, such as `+05:30` or `-08:00`.
+//
+// Semantic equality logic is defined for UTCOffset such that inconsequential differences between the `Z` suffix
+// and a `00:00` UTC offset are ignored, consistent with RFC3339.
+type UTCOffset struct {
+	basetypes.StringValue
+}
+
+// Type returns a UTCOffsetType.
+func (v UTCOffset) Type(_ context.Context) attr.Type {
+	return UTCOffsetType{}
+}
+
+// Equal returns true if the given value is equivalent.
+func (v UTCOffset) Equal(o attr.Value) bool {
+	other, ok := o.(UTCOffset)
+
+	if !ok {
+		return false
+	}
+
+	return v.StringValue.Equal(other.StringValue)
+}
+
+// StringSemanticEquals returns true if the given UTC offset string value is semantically equal to the current UTC
+// offset string value. Both values are parsed into a number of seconds east of UTC, which are then compared.
+//
+// Examples:
+//   - `Z` is semantically equal to `+00:00`
+//   - `-00:00` is semantically equal to `Z` - while RFC 3339 defines an unknown local offset (`-00:00`) to be
+//     different from an offset of `Z`, this matches the behavior of RFC3339 semantic equality.
+//
+// Counterexamples:
+//   - `+05:30` is NOT semantically equal to `-05:30`
+func (v UTCOffset) StringSemanticEquals(_ context.Context, newValuable basetypes.StringValuable) (bool, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	newValue, ok := newValuable.(UTCOffset)
+	if !ok {
+		diags.AddError(
+			"Semantic Equality Check Error",
+			"An unexpected value type was received while performing semantic equality checks. "+
+				"Please report this to the provider developers.\n\n"+
+				"Expected Value Type: "+fmt.Sprintf("%T", v)+"\n"+
+				"Got Value Type: "+fmt.Sprintf("%T", newValuable),
+		)
+
+		return false, diags
+	}
+
+	// UTC offset strings are already validated at this point, ignoring errors
+	currentOffset, _ := parseUTCOffset(v.ValueString())
+	newOffset, _ := parseUTCOffset(newValue.ValueString())
+
+	return currentOffset == newOffset, diags
+}
+
+// ValidateAttribute implements attribute value validation. This type requires the value to be a String value that
+// is a valid UTC offset.
+func (v UTCOffset) ValidateAttribute(ctx context.Context, req xattr.ValidateAttributeRequest, resp *xattr.ValidateAttributeResponse) {
+	if v.IsUnknown() || v.IsNull() {
+		return
+	}
+
+	if _, err := parseUTCOffset(v.ValueString()); err != nil {
+		resp.Diagnostics.Append(diag.WithPath(req.Path, utcOffsetInvalidStringDiagnostic(v.ValueString(), err)))
+
+		return
+	}
+}
+
+// ValidateParameter implements provider-defined function parameter value validation. This type requires the value to
+// be a String value that is a valid UTC offset.
+func (v UTCOffset) ValidateParameter(ctx context.Context, req function.ValidateParameterRequest, resp *function.ValidateParameterResponse) {
+	if v.IsUnknown() || v.IsNull() {
+		return
+	}
+
+	if _, err := parseUTCOffset(v.ValueString()); err != nil {
+		resp.Error = function.NewArgumentFuncError(
+			req.Position,
+			"Invalid UTC Offset String Value: "+
+				"A string value was provided that is not valid UTC offset string format. "+
+				`A UTC offset has the format "Z", "+05:30" or "-08:00".`+"\n\n"+
+				"Given Value: "+v.ValueString()+"\n"+
+				"Error: "+err.Error(),
+		)
+
+		return
+	}
+}
+
+// ValueLocation creates a new fixed time.Location instance with the UTCOffset StringValue via time.FixedZone. A zero
+// offset is returned as time.UTC. A null or unknown value will produce an error diagnostic.
+func (v UTCOffset) ValueLocation() (*time.Location, diag.Diagnostics) {
+	offset, diags := v.valueOffset("ValueLocation")
+	if diags.HasError() {
+		return nil, diags
+	}
+
+	return utcOffsetLocation(offset), nil
+}
+
+// ValueOffsetSeconds returns the UTC offset of the UTCOffset StringValue in seconds east of UTC, such as `19800` for
+// `+05:30` or `-28800` for `-08:00`. A null or unknown value will produce an error diagnostic.
+func (v UTCOffset) ValueOffsetSeconds() (int, diag.Diagnostics) {
+	return v.valueOffset("ValueOffsetSeconds")
+}
+
+// valueOffset parses the UTCOffset StringValue, using the given accessor name in error diagnostics.
+func (v UTCOffset) valueOffset(accessor string) (int, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	if v.IsNull() {
+		diags.Append(diag.NewErrorDiagnostic("UTC Offset "+accessor+" Error", "UTC offset string value is null"))
+		return 0, diags
+	}
+
+	if v.IsUnknown() {
+		diags.Append(diag.NewErrorDiagnostic("UTC Offset "+accessor+" Error", "UTC offset string value is unknown"))
+		return 0, diags
+	}
+
+	offset, err := parseUTCOffset(v.ValueString())
+	if err != nil {
+		diags.Append(diag.NewErrorDiagnostic("UTC Offset "+accessor+" Error", err.Error()))
+		return 0, diags
+	}
+
+	return offset, nil
+}
+
+// NewUTCOffsetNull creates a UTCOffset with a null value. Determine whether the value is null via IsNull method.
+func NewUTCOffsetNull() UTCOffset {
+	return UTCOffset{
+		StringValue: basetypes.NewStringNull(),
+	}
+}
+
+// NewUTCOffsetUnknown creates a UTCOffset with an unknown value. Determine whether the value is unknown via IsUnknown method.
+func NewUTCOffsetUnknown() UTCOffset {
+	return UTCOffset{
+		StringValue: basetypes.NewStringUnknown(),
+	}
+}
+
+// NewUTCOffsetSecondsValue creates a UTCOffset with a known value of the given offset in seconds east of UTC or
+// raises an error diagnostic if the offset is not a whole number of minutes within a day, as UTC offset strings only
+// support whole minutes. A zero offset is formatted as `Z`.
+func NewUTCOffsetSecondsValue(value int) (UTCOffset, diag.Diagnostics) {
+	if value%60 != 0 {
+		// Returning an unknown value will guarantee that, as a last resort,
+		// Terraform will return an error if attempting to store into state.
+		return NewUTCOffsetUnknown(), diag.Diagnostics{
+			utcOffsetInvalidSecondsDiagnostic(value, fmt.Errorf("offset of %d seconds is not a whole number of minutes", value)),
+		}
+	}
+
+	return NewUTCOffsetValue(formatUTCOffset(value))
+}
+
+// NewUTCOffsetValue creates a UTCOffset with a known value or raises an error
+// diagnostic if the string is not UTC offset format.
+func NewUTCOffsetValue(value string) (UTCOffset, diag.Diagnostics) {
+	_, err := parseUTCOffset(value)
+
+	if err != nil {
+		// Returning an unknown value will guarantee that, as a last resort,
+		// Terraform will return an error if attempting to store into state.
+		return NewUTCOffsetUnknown(), diag.Diagnostics{utcOffsetInvalidStringDiagnostic(value, err)}
+	}
+
+	return UTCOffset{
+		StringValue: basetypes.NewStringValue(value),
+	}, nil
+}
+
+// NewUTCOffsetValueMust creates a UTCOffset with a known value or raises a panic
+// if the string is not UTC offset format.
+//
+// This creation function is only recommended to create UTCOffset values which
+// either will not potentially affect practitioners, such as testing, or within
+// exhaustively tested provider logic.
+func NewUTCOffsetValueMust(value string) UTCOffset {
+	_, err := parseUTCOffset(value)
+
+	if err != nil {
+		panic(fmt.Sprintf("Invalid UTC Offset String Value (%s): %s", value, err))
+	}
+
+	return UTCOffset{
+		StringValue: basetypes.NewStringValue(value),
+	}
+}
+
+// NewUTCOffsetPointerValue creates a UTCOffset with a null value if nil, a known
+// value, or raises an error diagnostic if the string is not UTC offset format.
+func NewUTCOffsetPointerValue(value *string) (UTCOffset, diag.Diagnostics) {
+	if value == nil {
+		return NewUTCOffsetNull(), nil
+	}
+
+	return NewUTCOffsetValue(*value)
+}
+
+// NewUTCOffsetPointerValueMust creates a UTCOffset with a null value if nil, a
+// known value, or raises a panic if the string is not UTC offset format.
+//
+// This creation function is only recommended to create UTCOffset values which
+// either will not potentially affect practitioners, such as testing, or within
+// exhaustively tested provider logic.
+func NewUTCOffsetPointerValueMust(value *string) UTCOffset {
+	if value == nil {
+		return NewUTCOffsetNull()
+	}
+
+	return NewUTCOffsetValueMust(*value)
+}
+
+// formatUTCOffset formats a UTC offset in seconds as `Z` if zero, otherwise as `+hh:mm` or `-hh:mm`. The offset is
+// expected to be a whole number of minutes.
+func formatUTCOffset(offset int) string {
+	minutes := offset / 60
+
+	if minutes == 0 {
+		return "Z"
+	}
+
+	sign := '+'
+
+	if minutes < 0 {
+		sign = '-'
+		minutes = -minutes
+	}
+
+	return fmt.Sprintf("%c%02d:%02d", sign, minutes/60, minutes%60)
+}
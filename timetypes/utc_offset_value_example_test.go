@@ -0,0 +1,36 @@
+// Copyright IBM Corp. 2023, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package timetypes_test
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework-timetypes/timetypes"
+)
+
+type ReportResourceModel struct {
+	UTCOffset timetypes.UTCOffset `tfsdk:"utc_offset"`
+}
+
+func ExampleUTCOffset_ValueLocation() {
+	// For example purposes, typically the data model would be populated automatically by Plugin Framework via Config, Plan or State.
+	// https://developer.hashicorp.com/terraform/plugin/framework/handling-data/accessing-values
+	data := ReportResourceModel{
+		UTCOffset: timetypes.NewUTCOffsetValueMust("+05:30"),
+	}
+
+	// Check that the UTC offset data is known and able to be converted to time.Location
+	if !data.UTCOffset.IsNull() && !data.UTCOffset.IsUnknown() {
+		loc, diags := data.UTCOffset.ValueLocation()
+		if diags.HasError() {
+			return
+		}
+
+		t := time.Date(2023, time.July, 25, 12, 0, 0, 0, time.UTC)
+
+		// Output: 2023-07-25T17:30:00+05:30
+		fmt.Println(t.In(loc).Format(time.RFC3339))
+	}
+}
@@ -0,0 +1,437 @@
+// Copyright IBM Corp. 2023, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package timetypes_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-framework/attr/xattr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+
+	"github.com/hashicorp/terraform-plugin-framework-timetypes/timetypes"
+)
+
+func TestUTCOffset_StringSemanticEquals(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		currentUTCOffset timetypes.UTCOffset
+		givenUTCOffset   basetypes.StringValuable
+		expectedMatch    bool
+		expectedDiags    diag.Diagnostics
+	}{
+		"not equal - different offsets": {
+			currentUTCOffset: timetypes.NewUTCOffsetValueMust("+05:30"),
+			givenUTCOffset:   timetypes.NewUTCOffsetValueMust("+05:00"),
+			expectedMatch:    false,
+		},
+		"not equal - different signs": {
+			currentUTCOffset: timetypes.NewUTCOffsetValueMust("+05:30"),
+			givenUTCOffset:   timetypes.NewUTCOffsetValueMust("-05:30"),
+			expectedMatch:    false,
+		},
+		"semantically equal - Z suffix and positive zero num offset": {
+			currentUTCOffset: timetypes.NewUTCOffsetValueMust("Z"),
+			givenUTCOffset:   timetypes.NewUTCOffsetValueMust("+00:00"),
+			expectedMatch:    true,
+		},
+		"semantically equal - Z suffix and negative zero num offset": {
+			currentUTCOffset: timetypes.NewUTCOffsetValueMust("Z"),
+			givenUTCOffset:   timetypes.NewUTCOffsetValueMust("-00:00"),
+			expectedMatch:    true,
+		},
+		"semantically equal - negative zero and positive zero num offset": {
+			currentUTCOffset: timetypes.NewUTCOffsetValueMust("-00:00"),
+			givenUTCOffset:   timetypes.NewUTCOffsetValueMust("+00:00"),
+			expectedMatch:    true,
+		},
+		"semantically equal - byte for byte match": {
+			currentUTCOffset: timetypes.NewUTCOffsetValueMust("-08:00"),
+			givenUTCOffset:   timetypes.NewUTCOffsetValueMust("-08:00"),
+			expectedMatch:    true,
+		},
+		"error - not given UTCOffset value": {
+			currentUTCOffset: timetypes.NewUTCOffsetValueMust("Z"),
+			givenUTCOffset:   basetypes.NewStringValue("Z"),
+			expectedMatch:    false,
+			expectedDiags: diag.Diagnostics{
+				diag.NewErrorDiagnostic(
+					"Semantic Equality Check Error",
+					"An unexpected value type was received while performing semantic equality checks. "+
+						"Please report this to the provider developers.\n\n"+
+						"Expected Value Type: timetypes.UTCOffset\n"+
+						"Got Value Type: basetypes.StringValue",
+				),
+			},
+		},
+	}
+	for name, testCase := range testCases {
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			match, diags := testCase.currentUTCOffset.StringSemanticEquals(context.Background(), testCase.givenUTCOffset)
+
+			if testCase.expectedMatch != match {
+				t.Errorf("Expected StringSemanticEquals to return: %t, but got: %t", testCase.expectedMatch, match)
+			}
+
+			if diff := cmp.Diff(diags, testCase.expectedDiags); diff != "" {
+				t.Errorf("Unexpected diagnostics (-got, +expected): %s", diff)
+			}
+		})
+	}
+}
+
+func TestUTCOffsetValidateAttribute(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		UTCOffset     timetypes.UTCOffset
+		expectedDiags diag.Diagnostics
+	}{
+		"empty-struct": {
+			UTCOffset: timetypes.UTCOffset{},
+		},
+		"null": {
+			UTCOffset: timetypes.NewUTCOffsetNull(),
+		},
+		"unknown": {
+			UTCOffset: timetypes.NewUTCOffsetUnknown(),
+		},
+		"valid UTC offset - Z": {
+			UTCOffset: timetypes.NewUTCOffsetValueMust("Z"),
+		},
+		"valid UTC offset - positive": {
+			UTCOffset: timetypes.NewUTCOffsetValueMust("+05:30"),
+		},
+		"valid UTC offset - negative": {
+			UTCOffset: timetypes.NewUTCOffsetValueMust("-08:00"),
+		},
+		"invalid UTC offset - lowercase z": {
+			UTCOffset: timetypes.UTCOffset{
+				StringValue: basetypes.NewStringValue("z"),
+			},
+			expectedDiags: diag.Diagnostics{
+				diag.NewAttributeErrorDiagnostic(
+					path.Root("test"),
+					"Invalid UTC Offset String Value",
+					"A string value was provided that is not valid UTC offset string format. "+
+						`A UTC offset has the format "Z", "+05:30" or "-08:00".`+"\n\n"+
+						"Given Value: z\n"+
+						`Error: expected a "Z" or "+hh:mm" UTC offset, got "z"`,
+				),
+			},
+		},
+		"invalid UTC offset - missing minutes": {
+			UTCOffset: timetypes.UTCOffset{
+				StringValue: basetypes.NewStringValue("+05"),
+			},
+			expectedDiags: diag.Diagnostics{
+				diag.NewAttributeErrorDiagnostic(
+					path.Root("test"),
+					"Invalid UTC Offset String Value",
+					"A string value was provided that is not valid UTC offset string format. "+
+						`A UTC offset has the format "Z", "+05:30" or "-08:00".`+"\n\n"+
+						"Given Value: +05\n"+
+						`Error: expected ':' after offset hour, got ""`,
+				),
+			},
+		},
+		"invalid UTC offset - out of range": {
+			UTCOffset: timetypes.UTCOffset{
+				StringValue: basetypes.NewStringValue("+24:00"),
+			},
+			expectedDiags: diag.Diagnostics{
+				diag.NewAttributeErrorDiagnostic(
+					path.Root("test"),
+					"Invalid UTC Offset String Value",
+					"A string value was provided that is not valid UTC offset string format. "+
+						`A UTC offset has the format "Z", "+05:30" or "-08:00".`+"\n\n"+
+						"Given Value: +24:00\n"+
+						`Error: offset hour "24" is out of range [0, 23]`,
+				),
+			},
+		},
+	}
+	for name, testCase := range testCases {
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			resp := xattr.ValidateAttributeResponse{}
+
+			testCase.UTCOffset.ValidateAttribute(
+				context.Background(),
+				xattr.ValidateAttributeRequest{
+					Path: path.Root("test"),
+				},
+				&resp,
+			)
+
+			if diff := cmp.Diff(resp.Diagnostics, testCase.expectedDiags); diff != "" {
+				t.Errorf("Unexpected diagnostics (-got, +expected): %s", diff)
+			}
+		})
+	}
+}
+
+func TestUTCOffsetValidateParameter(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		UTCOffset       timetypes.UTCOffset
+		expectedFuncErr *function.FuncError
+	}{
+		"empty-struct": {
+			UTCOffset: timetypes.UTCOffset{},
+		},
+		"null": {
+			UTCOffset: timetypes.NewUTCOffsetNull(),
+		},
+		"unknown": {
+			UTCOffset: timetypes.NewUTCOffsetUnknown(),
+		},
+		"valid UTC offset": {
+			UTCOffset: timetypes.NewUTCOffsetValueMust("+05:30"),
+		},
+		"invalid UTC offset": {
+			UTCOffset: timetypes.UTCOffset{
+				StringValue: basetypes.NewStringValue("+0530"),
+			},
+			expectedFuncErr: function.NewArgumentFuncError(
+				0,
+				"Invalid UTC Offset String Value: "+
+					"A string value was provided that is not valid UTC offset string format. "+
+					`A UTC offset has the format "Z", "+05:30" or "-08:00".`+"\n\n"+
+					"Given Value: +0530\n"+
+					`Error: expected ':' after offset hour, got "30"`,
+			),
+		},
+	}
+	for name, testCase := range testCases {
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			resp := function.ValidateParameterResponse{}
+
+			testCase.UTCOffset.ValidateParameter(
+				context.Background(),
+				function.ValidateParameterRequest{
+					Position: int64(0),
+				},
+				&resp,
+			)
+
+			if diff := cmp.Diff(resp.Error, testCase.expectedFuncErr); diff != "" {
+				t.Errorf("Unexpected diagnostics (-got, +expected): %s", diff)
+			}
+		})
+	}
+}
+
+func TestUTCOffset_ValueOffsetSeconds(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		UTCOffset      timetypes.UTCOffset
+		expectedOffset int
+		expectedDiags  diag.Diagnostics
+	}{
+		"UTC offset string value is null ": {
+			UTCOffset: timetypes.NewUTCOffsetNull(),
+			expectedDiags: diag.Diagnostics{
+				diag.NewErrorDiagnostic(
+					"UTC Offset ValueOffsetSeconds Error",
+					"UTC offset string value is null",
+				),
+			},
+		},
+		"UTC offset string value is unknown ": {
+			UTCOffset: timetypes.NewUTCOffsetUnknown(),
+			expectedDiags: diag.Diagnostics{
+				diag.NewErrorDiagnostic(
+					"UTC Offset ValueOffsetSeconds Error",
+					"UTC offset string value is unknown",
+				),
+			},
+		},
+		"valid UTC offset - Z": {
+			UTCOffset:      timetypes.NewUTCOffsetValueMust("Z"),
+			expectedOffset: 0,
+		},
+		"valid UTC offset - positive": {
+			UTCOffset:      timetypes.NewUTCOffsetValueMust("+05:30"),
+			expectedOffset: 19800,
+		},
+		"valid UTC offset - negative": {
+			UTCOffset:      timetypes.NewUTCOffsetValueMust("-08:00"),
+			expectedOffset: -28800,
+		},
+	}
+	for name, testCase := range testCases {
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			offset, diags := testCase.UTCOffset.ValueOffsetSeconds()
+
+			if offset != testCase.expectedOffset {
+				t.Errorf("Unexpected offset, got: %d, expected: %d", offset, testCase.expectedOffset)
+			}
+
+			if diff := cmp.Diff(diags, testCase.expectedDiags); diff != "" {
+				t.Errorf("Unexpected diagnostics (-got, +expected): %s", diff)
+			}
+		})
+	}
+}
+
+func TestUTCOffset_ValueLocation(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		UTCOffset      timetypes.UTCOffset
+		expectedOffset int
+		expectedDiags  diag.Diagnostics
+	}{
+		"UTC offset string value is null ": {
+			UTCOffset: timetypes.NewUTCOffsetNull(),
+			expectedDiags: diag.Diagnostics{
+				diag.NewErrorDiagnostic(
+					"UTC Offset ValueLocation Error",
+					"UTC offset string value is null",
+				),
+			},
+		},
+		"UTC offset string value is unknown ": {
+			UTCOffset: timetypes.NewUTCOffsetUnknown(),
+			expectedDiags: diag.Diagnostics{
+				diag.NewErrorDiagnostic(
+					"UTC Offset ValueLocation Error",
+					"UTC offset string value is unknown",
+				),
+			},
+		},
+		"valid UTC offset - Z": {
+			UTCOffset:      timetypes.NewUTCOffsetValueMust("Z"),
+			expectedOffset: 0,
+		},
+		"valid UTC offset - negative": {
+			UTCOffset:      timetypes.NewUTCOffsetValueMust("-03:30"),
+			expectedOffset: -12600,
+		},
+	}
+	for name, testCase := range testCases {
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			loc, diags := testCase.UTCOffset.ValueLocation()
+
+			if diff := cmp.Diff(diags, testCase.expectedDiags); diff != "" {
+				t.Errorf("Unexpected diagnostics (-got, +expected): %s", diff)
+			}
+
+			if diags.HasError() {
+				if loc != nil {
+					t.Errorf("Expected nil location, got: %s", loc)
+				}
+
+				return
+			}
+
+			_, offset := time.Date(2023, time.July, 25, 0, 0, 0, 0, loc).Zone()
+
+			if offset != testCase.expectedOffset {
+				t.Errorf("Unexpected offset, got: %d, expected: %d", offset, testCase.expectedOffset)
+			}
+		})
+	}
+}
+
+func TestNewUTCOffsetSecondsValue(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		seconds       int
+		expected      timetypes.UTCOffset
+		expectedDiags diag.Diagnostics
+	}{
+		"zero": {
+			seconds:  0,
+			expected: timetypes.NewUTCOffsetValueMust("Z"),
+		},
+		"positive": {
+			seconds:  19800,
+			expected: timetypes.NewUTCOffsetValueMust("+05:30"),
+		},
+		"negative": {
+			seconds:  -28800,
+			expected: timetypes.NewUTCOffsetValueMust("-08:00"),
+		},
+		"seconds": {
+			seconds:  -(9*60*60 + 30*60 + 45),
+			expected: timetypes.NewUTCOffsetUnknown(),
+			expectedDiags: diag.Diagnostics{
+				diag.NewErrorDiagnostic(
+					"Invalid UTC Offset Seconds Value",
+					"An integer value was provided that is not a valid UTC offset in seconds. "+
+						"UTC offsets must be a whole number of minutes.\n\n"+
+						"Given Value: -34245\n"+
+						"Error: offset of -34245 seconds is not a whole number of minutes",
+				),
+			},
+		},
+		"seconds - less than a minute": {
+			seconds:  30,
+			expected: timetypes.NewUTCOffsetUnknown(),
+			expectedDiags: diag.Diagnostics{
+				diag.NewErrorDiagnostic(
+					"Invalid UTC Offset Seconds Value",
+					"An integer value was provided that is not a valid UTC offset in seconds. "+
+						"UTC offsets must be a whole number of minutes.\n\n"+
+						"Given Value: 30\n"+
+						"Error: offset of 30 seconds is not a whole number of minutes",
+				),
+			},
+		},
+		"out of range": {
+			seconds:  24 * 60 * 60,
+			expected: timetypes.NewUTCOffsetUnknown(),
+			expectedDiags: diag.Diagnostics{
+				diag.NewErrorDiagnostic(
+					"Invalid UTC Offset String Value",
+					"A string value was provided that is not valid UTC offset string format. "+
+						`A UTC offset has the format "Z", "+05:30" or "-08:00".`+"\n\n"+
+						"Given Value: +24:00\n"+
+						`Error: offset hour "24" is out of range [0, 23]`,
+				),
+			},
+		},
+	}
+	for name, testCase := range testCases {
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got, diags := timetypes.NewUTCOffsetSecondsValue(testCase.seconds)
+
+			if !got.Equal(testCase.expected) {
+				t.Errorf("Expected %s, got %s", testCase.expected, got)
+			}
+
+			if diff := cmp.Diff(diags, testCase.expectedDiags); diff != "" {
+				t.Errorf("Unexpected diagnostics (-got, +expected): %s", diff)
+			}
+		})
+	}
+}
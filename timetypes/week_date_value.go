@@ -0,0 +1,309 @@
+// Copyright IBM Corp. 2023, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package timetypes
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/attr/xattr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+)
+
+var (
+	_ basetypes.StringValuable       = (*WeekDate)(nil)
+	_ xattr.ValidateableAttribute    = (*WeekDate)(nil)
+	_ function.ValidateableParameter = (*WeekDate)(nil)
+)
+
+// weekDate is a parsed ISO 8601 week date. The day is the ISO day of the week from 1 (Monday) to 7 (Sunday), or zero
+// if the week date does not include a day.
+type weekDate struct {
+	year int
+	week int
+	day  int
+}
+
+// WeekDate represents a valid ISO 8601 week date string, which is either a week, such as `2026-W42`, or a day within
+// a week, such as `2026-W42-5` for the Friday of that week. Weeks start on Monday and the first week of a year is the
+// week containing its first Thursday, such that a year has either 52 or 53 weeks and the week number is validated
+// against the number of weeks in the given year.
+//
+// See https://en.wikipedia.org/wiki/ISO_week_date for more details on the string format.
+type WeekDate struct {
+	basetypes.StringValue
+}
+
+// Type returns a WeekDateType.
+func (v WeekDate) Type(_ context.Context) attr.Type {
+	return WeekDateType{}
+}
+
+// Equal returns true if the given value is equivalent.
+func (v WeekDate) Equal(o attr.Value) bool {
+	other, ok := o.(WeekDate)
+
+	if !ok {
+		return false
+	}
+
+	return v.StringValue.Equal(other.StringValue)
+}
+
+// ValidateAttribute implements attribute value validation. This type requires the value to be a String value that
+// is a valid ISO 8601 week date, including a valid week for the given year.
+func (v WeekDate) ValidateAttribute(ctx context.Context, req xattr.ValidateAttributeRequest, resp *xattr.ValidateAttributeResponse) {
+	if v.IsUnknown() || v.IsNull() {
+		return
+	}
+
+	if _, err := parseWeekDate(v.ValueString()); err != nil {
+		resp.Diagnostics.Append(diag.WithPath(req.Path, weekDateInvalidStringDiagnostic(v.ValueString(), err)))
+
+		return
+	}
+}
+
+// ValidateParameter implements provider-defined function parameter value validation. This type requires the value to
+// be a String value that is a valid ISO 8601 week date, including a valid week for the given year.
+func (v WeekDate) ValidateParameter(ctx context.Context, req function.ValidateParameterRequest, resp *function.ValidateParameterResponse) {
+	if v.IsUnknown() || v.IsNull() {
+		return
+	}
+
+	if _, err := parseWeekDate(v.ValueString()); err != nil {
+		resp.Error = function.NewArgumentFuncError(
+			req.Position,
+			"Invalid Week Date String Value: "+
+				"A string value was provided that is not valid ISO 8601 week date string format, such as \"2026-W42\" or \"2026-W42-5\".\n\n"+
+				"Given Value: "+v.ValueString()+"\n"+
+				"Error: "+err.Error(),
+		)
+
+		return
+	}
+}
+
+// ValueDate creates a new time.Time instance with the WeekDate StringValue at midnight in the given location. A week
+// without a day is converted to the Monday of that week. A nil location is treated as UTC. A null or unknown value
+// will produce an error diagnostic.
+func (v WeekDate) ValueDate(loc *time.Location) (time.Time, diag.Diagnostics) {
+	date, diags := v.valueWeekDate("ValueDate")
+	if diags.HasError() {
+		return time.Time{}, diags
+	}
+
+	if loc == nil {
+		loc = time.UTC
+	}
+
+	return date.time(loc), nil
+}
+
+// ValueISOWeek returns the ISO 8601 week-numbering year and week number of the WeekDate StringValue, such as 2026 and
+// 42 for `2026-W42-5`, which match the results of time.Time.ISOWeek. A null or unknown value will produce an error
+// diagnostic.
+func (v WeekDate) ValueISOWeek() (int, int, diag.Diagnostics) {
+	date, diags := v.valueWeekDate("ValueISOWeek")
+	if diags.HasError() {
+		return 0, 0, diags
+	}
+
+	return date.year, date.week, nil
+}
+
+// valueWeekDate returns the parsed WeekDate, with error diagnostics summarized by the given accessor method name.
+func (v WeekDate) valueWeekDate(accessor string) (weekDate, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	summary := "WeekDate " + accessor + " Error"
+
+	if v.IsNull() {
+		diags.Append(diag.NewErrorDiagnostic(summary, "Week date string value is null"))
+		return weekDate{}, diags
+	}
+
+	if v.IsUnknown() {
+		diags.Append(diag.NewErrorDiagnostic(summary, "Week date string value is unknown"))
+		return weekDate{}, diags
+	}
+
+	date, err := parseWeekDate(v.ValueString())
+	if err != nil {
+		diags.Append(diag.NewErrorDiagnostic(summary, err.Error()))
+		return weekDate{}, diags
+	}
+
+	return date, nil
+}
+
+// NewWeekDateNull creates a WeekDate with a null value. Determine whether the value is null via IsNull method.
+func NewWeekDateNull() WeekDate {
+	return WeekDate{
+		StringValue: basetypes.NewStringNull(),
+	}
+}
+
+// NewWeekDateUnknown creates a WeekDate with an unknown value. Determine whether the value is unknown via IsUnknown method.
+func NewWeekDateUnknown() WeekDate {
+	return WeekDate{
+		StringValue: basetypes.NewStringUnknown(),
+	}
+}
+
+// NewWeekDateTimeValue creates a WeekDate with a known value, such as `2026-W42-5`. The date is taken from the
+// time.Time in its own location and any time of day is discarded.
+func NewWeekDateTimeValue(value time.Time) WeekDate {
+	return WeekDate{
+		StringValue: basetypes.NewStringValue(formatWeekDate(value)),
+	}
+}
+
+// NewWeekDateTimePointerValue creates a WeekDate with a null value if nil or
+// a known value, such as `2026-W42-5`. The date is taken from the time.Time in
+// its own location and any time of day is discarded.
+func NewWeekDateTimePointerValue(value *time.Time) WeekDate {
+	if value == nil {
+		return NewWeekDateNull()
+	}
+
+	return NewWeekDateTimeValue(*value)
+}
+
+// NewWeekDateValue creates a WeekDate with a known value or raises an error
+// diagnostic if the string is not ISO 8601 week date format.
+func NewWeekDateValue(value string) (WeekDate, diag.Diagnostics) {
+	_, err := parseWeekDate(value)
+
+	if err != nil {
+		// Returning an unknown value will guarantee that, as a last resort,
+		// Terraform will return an error if attempting to store into state.
+		return NewWeekDateUnknown(), diag.Diagnostics{weekDateInvalidStringDiagnostic(value, err)}
+	}
+
+	return WeekDate{
+		StringValue: basetypes.NewStringValue(value),
+	}, nil
+}
+
+// NewWeekDateValueMust creates a WeekDate with a known value or raises a panic
+// if the string is not ISO 8601 week date format.
+//
+// This creation function is only recommended to create WeekDate values which
+// either will not potentially affect practitioners, such as testing, or within
+// exhaustively tested provider logic.
+func NewWeekDateValueMust(value string) WeekDate {
+	_, err := parseWeekDate(value)
+
+	if err != nil {
+		panic(fmt.Sprintf("Invalid Week Date String Value (%s): %s", value, err))
+	}
+
+	return WeekDate{
+		StringValue: basetypes.NewStringValue(value),
+	}
+}
+
+// NewWeekDatePointerValue creates a WeekDate with a null value if nil, a known
+// value, or raises an error diagnostic if the string is not ISO 8601 week date format.
+func NewWeekDatePointerValue(value *string) (WeekDate, diag.Diagnostics) {
+	if value == nil {
+		return NewWeekDateNull(), nil
+	}
+
+	return NewWeekDateValue(*value)
+}
+
+// NewWeekDatePointerValueMust creates a WeekDate with a null value if nil, a
+// known value, or raises a panic if the string is not ISO 8601 week date format.
+//
+// This creation function is only recommended to create WeekDate values which
+// either will not potentially affect practitioners, such as testing, or within
+// exhaustively tested provider logic.
+func NewWeekDatePointerValueMust(value *string) WeekDate {
+	if value == nil {
+		return NewWeekDateNull()
+	}
+
+	return NewWeekDateValueMust(*value)
+}
+
+// parseWeekDate parses an ISO 8601 week date in the extended format, such as `2026-W42` or `2026-W42-5`.
+func parseWeekDate(value string) (weekDate, error) {
+	year, rest, err := parseFixedDigits(value, 4, "year", 0, 9999)
+	if err != nil {
+		return weekDate{}, err
+	}
+
+	if rest, err = parseSeparator(rest, '-', "year"); err != nil {
+		return weekDate{}, err
+	}
+
+	if rest, err = parseSeparator(rest, 'W', `"-"`); err != nil {
+		return weekDate{}, err
+	}
+
+	week, rest, err := parseFixedDigits(rest, 2, "week", 1, 53)
+	if err != nil {
+		return weekDate{}, err
+	}
+
+	if weeks := isoWeeksInYear(year); week > weeks {
+		return weekDate{}, fmt.Errorf("week %d is out of range for %d, which has %d ISO weeks", week, year, weeks)
+	}
+
+	date := weekDate{
+		year: year,
+		week: week,
+	}
+
+	if rest == "" {
+		return date, nil
+	}
+
+	if rest, err = parseSeparator(rest, '-', "week"); err != nil {
+		return weekDate{}, err
+	}
+
+	if date.day, rest, err = parseFixedDigits(rest, 1, "day", 1, 7); err != nil {
+		return weekDate{}, err
+	}
+
+	if rest != "" {
+		return weekDate{}, fmt.Errorf("unexpected text after day: %q", rest)
+	}
+
+	return date, nil
+}
+
+// time returns the week date at midnight in the given location, where a week without a day is the Monday of that week.
+func (d weekDate) time(loc *time.Location) time.Time {
+	day := max(d.day, 1)
+
+	// January 4th is always in the first ISO week of its year.
+	january4 := time.Date(d.year, time.January, 4, 0, 0, 0, 0, time.UTC)
+	offset := (int(january4.Weekday())+6)%7 + 1
+
+	return time.Date(d.year, time.January, 4-offset+(d.week-1)*7+day, 0, 0, 0, 0, loc)
+}
+
+// formatWeekDate returns the ISO 8601 week date of the given time in its own location, such as `2026-W42-5`.
+func formatWeekDate(t time.Time) string {
+	year, week := t.ISOWeek()
+	day := (int(t.Weekday())+6)%7 + 1
+
+	return fmt.Sprintf("%04d-W%02d-%d", year, week, day)
+}
+
+// isoWeeksInYear returns the number of ISO 8601 weeks in the given week-numbering year, which is either 52 or 53.
+func isoWeeksInYear(year int) int {
+	// December 28th is always in the last ISO week of its year.
+	_, weeks := time.Date(year, time.December, 28, 0, 0, 0, 0, time.UTC).ISOWeek()
+
+	return weeks
+}
@@ -0,0 +1,34 @@
+// Copyright IBM Corp. 2023, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package timetypes_test
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework-timetypes/timetypes"
+)
+
+type ReleaseResourceModel struct {
+	FreezeWeek timetypes.WeekDate `tfsdk:"freeze_week"`
+}
+
+func ExampleWeekDate_ValueDate() {
+	// For example purposes, typically the data model would be populated automatically by Plugin Framework via Config, Plan or State.
+	// https://developer.hashicorp.com/terraform/plugin/framework/handling-data/accessing-values
+	data := ReleaseResourceModel{
+		FreezeWeek: timetypes.NewWeekDateValueMust("2026-W42"),
+	}
+
+	// Check that the week date data is known and able to be converted to time.Time
+	if !data.FreezeWeek.IsNull() && !data.FreezeWeek.IsUnknown() {
+		t, diags := data.FreezeWeek.ValueDate(time.UTC)
+		if diags.HasError() {
+			return
+		}
+
+		// Output: 2026-10-12T00:00:00Z
+		fmt.Println(t.Format(time.RFC3339))
+	}
+}
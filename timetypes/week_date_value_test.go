@@ -0,0 +1,308 @@
+// Copyright IBM Corp. 2023, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package timetypes_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-framework/attr/xattr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+
+	"github.com/hashicorp/terraform-plugin-framework-timetypes/timetypes"
+)
+
+func TestWeekDateValidateAttribute(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		weekDate      timetypes.WeekDate
+		expectedDiags diag.Diagnostics
+	}{
+		"empty-struct": {
+			weekDate: timetypes.WeekDate{},
+		},
+		"null": {
+			weekDate: timetypes.NewWeekDateNull(),
+		},
+		"unknown": {
+			weekDate: timetypes.NewWeekDateUnknown(),
+		},
+		"valid week date - week": {
+			weekDate: timetypes.NewWeekDateValueMust("2026-W42"),
+		},
+		"valid week date - day": {
+			weekDate: timetypes.NewWeekDateValueMust("2026-W42-5"),
+		},
+		"valid week date - 53 week year": {
+			weekDate: timetypes.NewWeekDateValueMust("2026-W53-7"),
+		},
+		"invalid week date - week 53 in 52 week year": {
+			weekDate: timetypes.WeekDate{
+				StringValue: basetypes.NewStringValue("2027-W53"),
+			},
+			expectedDiags: diag.Diagnostics{
+				diag.NewAttributeErrorDiagnostic(
+					path.Root("test"),
+					"Invalid Week Date String Value",
+					"A string value was provided that is not valid ISO 8601 week date string format, such as \"2026-W42\" or \"2026-W42-5\".\n\n"+
+						"Given Value: 2027-W53\n"+
+						"Error: week 53 is out of range for 2027, which has 52 ISO weeks",
+				),
+			},
+		},
+		"invalid week date - week 0": {
+			weekDate: timetypes.WeekDate{
+				StringValue: basetypes.NewStringValue("2026-W00"),
+			},
+			expectedDiags: diag.Diagnostics{
+				diag.NewAttributeErrorDiagnostic(
+					path.Root("test"),
+					"Invalid Week Date String Value",
+					"A string value was provided that is not valid ISO 8601 week date string format, such as \"2026-W42\" or \"2026-W42-5\".\n\n"+
+						"Given Value: 2026-W00\n"+
+						"Error: week \"00\" is out of range [1, 53]",
+				),
+			},
+		},
+		"invalid week date - day 8": {
+			weekDate: timetypes.WeekDate{
+				StringValue: basetypes.NewStringValue("2026-W42-8"),
+			},
+			expectedDiags: diag.Diagnostics{
+				diag.NewAttributeErrorDiagnostic(
+					path.Root("test"),
+					"Invalid Week Date String Value",
+					"A string value was provided that is not valid ISO 8601 week date string format, such as \"2026-W42\" or \"2026-W42-5\".\n\n"+
+						"Given Value: 2026-W42-8\n"+
+						"Error: day \"8\" is out of range [1, 7]",
+				),
+			},
+		},
+		"invalid week date - calendar date": {
+			weekDate: timetypes.WeekDate{
+				StringValue: basetypes.NewStringValue("2026-10-16"),
+			},
+			expectedDiags: diag.Diagnostics{
+				diag.NewAttributeErrorDiagnostic(
+					path.Root("test"),
+					"Invalid Week Date String Value",
+					"A string value was provided that is not valid ISO 8601 week date string format, such as \"2026-W42\" or \"2026-W42-5\".\n\n"+
+						"Given Value: 2026-10-16\n"+
+						"Error: expected 'W' after \"-\", got \"10-16\"",
+				),
+			},
+		},
+		"invalid week date - trailing text": {
+			weekDate: timetypes.WeekDate{
+				StringValue: basetypes.NewStringValue("2026-W42-5T00:00:00Z"),
+			},
+			expectedDiags: diag.Diagnostics{
+				diag.NewAttributeErrorDiagnostic(
+					path.Root("test"),
+					"Invalid Week Date String Value",
+					"A string value was provided that is not valid ISO 8601 week date string format, such as \"2026-W42\" or \"2026-W42-5\".\n\n"+
+						"Given Value: 2026-W42-5T00:00:00Z\n"+
+						"Error: unexpected text after day: \"T00:00:00Z\"",
+				),
+			},
+		},
+	}
+	for name, testCase := range testCases {
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			resp := xattr.ValidateAttributeResponse{}
+
+			testCase.weekDate.ValidateAttribute(
+				context.Background(),
+				xattr.ValidateAttributeRequest{
+					Path: path.Root("test"),
+				},
+				&resp,
+			)
+
+			if diff := cmp.Diff(resp.Diagnostics, testCase.expectedDiags); diff != "" {
+				t.Errorf("Unexpected diagnostics (-got, +expected): %s", diff)
+			}
+		})
+	}
+}
+
+func TestWeekDateValidateParameter(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		weekDate        timetypes.WeekDate
+		expectedFuncErr *function.FuncError
+	}{
+		"empty-struct": {
+			weekDate: timetypes.WeekDate{},
+		},
+		"null": {
+			weekDate: timetypes.NewWeekDateNull(),
+		},
+		"unknown": {
+			weekDate: timetypes.NewWeekDateUnknown(),
+		},
+		"valid week date": {
+			weekDate: timetypes.NewWeekDateValueMust("2026-W42-5"),
+		},
+		"invalid week date": {
+			weekDate: timetypes.WeekDate{
+				StringValue: basetypes.NewStringValue("2026W42"),
+			},
+			expectedFuncErr: function.NewArgumentFuncError(
+				0,
+				"Invalid Week Date String Value: "+
+					"A string value was provided that is not valid ISO 8601 week date string format, such as \"2026-W42\" or \"2026-W42-5\".\n\n"+
+					"Given Value: 2026W42\n"+
+					"Error: expected '-' after year, got \"W42\"",
+			),
+		},
+	}
+	for name, testCase := range testCases {
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			resp := function.ValidateParameterResponse{}
+
+			testCase.weekDate.ValidateParameter(
+				context.Background(),
+				function.ValidateParameterRequest{
+					Position: int64(0),
+				},
+				&resp,
+			)
+
+			if diff := cmp.Diff(resp.Error, testCase.expectedFuncErr); diff != "" {
+				t.Errorf("Unexpected diagnostics (-got, +expected): %s", diff)
+			}
+		})
+	}
+}
+
+func TestWeekDate_ValueDate(t *testing.T) {
+	t.Parallel()
+
+	berlin, err := time.LoadLocation("Europe/Berlin")
+	if err != nil {
+		t.Fatalf("Unexpected error loading location: %s", err)
+	}
+
+	testCases := map[string]struct {
+		weekDate      timetypes.WeekDate
+		location      *time.Location
+		expectedTime  time.Time
+		expectedDiags diag.Diagnostics
+	}{
+		"week date string value is null": {
+			weekDate: timetypes.NewWeekDateNull(),
+			expectedDiags: diag.Diagnostics{
+				diag.NewErrorDiagnostic("WeekDate ValueDate Error", "Week date string value is null"),
+			},
+		},
+		"week date string value is unknown": {
+			weekDate: timetypes.NewWeekDateUnknown(),
+			expectedDiags: diag.Diagnostics{
+				diag.NewErrorDiagnostic("WeekDate ValueDate Error", "Week date string value is unknown"),
+			},
+		},
+		"week - nil location": {
+			weekDate:     timetypes.NewWeekDateValueMust("2026-W42"),
+			expectedTime: time.Date(2026, time.October, 12, 0, 0, 0, 0, time.UTC),
+		},
+		"day - location": {
+			weekDate:     timetypes.NewWeekDateValueMust("2026-W42-5"),
+			location:     berlin,
+			expectedTime: time.Date(2026, time.October, 16, 0, 0, 0, 0, berlin),
+		},
+		"week starting in the previous year": {
+			weekDate:     timetypes.NewWeekDateValueMust("2026-W01-1"),
+			expectedTime: time.Date(2025, time.December, 29, 0, 0, 0, 0, time.UTC),
+		},
+		"day in the next year": {
+			weekDate:     timetypes.NewWeekDateValueMust("2026-W53-5"),
+			expectedTime: time.Date(2027, time.January, 1, 0, 0, 0, 0, time.UTC),
+		},
+	}
+	for name, testCase := range testCases {
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got, diags := testCase.weekDate.ValueDate(testCase.location)
+
+			if !got.Equal(testCase.expectedTime) || got.Location() != testCase.expectedTime.Location() {
+				t.Errorf("Unexpected difference in time.Time, got: %s, expected: %s", got, testCase.expectedTime)
+			}
+
+			if diff := cmp.Diff(diags, testCase.expectedDiags); diff != "" {
+				t.Errorf("Unexpected diagnostics (-got, +expected): %s", diff)
+			}
+		})
+	}
+}
+
+func TestWeekDate_ValueISOWeek(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		weekDate      timetypes.WeekDate
+		expectedYear  int
+		expectedWeek  int
+		expectedDiags diag.Diagnostics
+	}{
+		"week date string value is null": {
+			weekDate: timetypes.NewWeekDateNull(),
+			expectedDiags: diag.Diagnostics{
+				diag.NewErrorDiagnostic("WeekDate ValueISOWeek Error", "Week date string value is null"),
+			},
+		},
+		"week": {
+			weekDate:     timetypes.NewWeekDateValueMust("2026-W42"),
+			expectedYear: 2026,
+			expectedWeek: 42,
+		},
+		"day": {
+			weekDate:     timetypes.NewWeekDateValueMust("2026-W53-5"),
+			expectedYear: 2026,
+			expectedWeek: 53,
+		},
+	}
+	for name, testCase := range testCases {
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			year, week, diags := testCase.weekDate.ValueISOWeek()
+
+			if year != testCase.expectedYear || week != testCase.expectedWeek {
+				t.Errorf("Unexpected ISO week, got: %d-W%02d, expected: %d-W%02d", year, week, testCase.expectedYear, testCase.expectedWeek)
+			}
+
+			if diff := cmp.Diff(diags, testCase.expectedDiags); diff != "" {
+				t.Errorf("Unexpected diagnostics (-got, +expected): %s", diff)
+			}
+		})
+	}
+}
+
+func TestNewWeekDateTimeValue(t *testing.T) {
+	t.Parallel()
+
+	got := timetypes.NewWeekDateTimeValue(time.Date(2027, time.January, 1, 23, 43, 16, 0, time.FixedZone("", -4*60*60)))
+	expected := timetypes.NewWeekDateValueMust("2026-W53-5")
+
+	if !got.Equal(expected) {
+		t.Errorf("Expected %s, got %s", expected, got)
+	}
+}
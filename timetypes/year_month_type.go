@@ -0,0 +1,77 @@
+// Copyright IBM Corp. 2023, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package timetypes
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+var (
+	_ basetypes.StringTypable = (*YearMonthType)(nil)
+)
+
+// YearMonthType is an attribute type that represents a valid ISO 8601 year and month string, such as `2026-10`.
+// Semantic equality logic is defined for YearMonthType such that values with the same year and month are considered
+// equal.
+type YearMonthType struct {
+	basetypes.StringType
+}
+
+// String returns a human-readable string of the type name.
+func (t YearMonthType) String() string {
+	return "timetypes.YearMonthType"
+}
+
+// ValueType returns the Value type.
+func (t YearMonthType) ValueType(ctx context.Context) attr.Value {
+	return YearMonth{}
+}
+
+// Equal returns true if the given type is equivalent.
+func (t YearMonthType) Equal(o attr.Type) bool {
+	other, ok := o.(YearMonthType)
+
+	if !ok {
+		return false
+	}
+
+	return t.StringType.Equal(other.StringType)
+}
+
+// ValueFromString returns a StringValuable type given a StringValue.
+func (t YearMonthType) ValueFromString(ctx context.Context, in basetypes.StringValue) (basetypes.StringValuable, diag.Diagnostics) {
+	return YearMonth{
+		StringValue: in,
+	}, nil
+}
+
+// ValueFromTerraform returns a Value given a tftypes.Value.  This is meant to convert the tftypes.Value into a more convenient Go type
+// for the provider to consume the data with.
+func (t YearMonthType) ValueFromTerraform(ctx context.Context, in tftypes.Value) (attr.Value, error) {
+	attrValue, err := t.StringType.ValueFromTerraform(ctx, in)
+
+	if err != nil {
+		return nil, err
+	}
+
+	stringValue, ok := attrValue.(basetypes.StringValue)
+
+	if !ok {
+		return nil, fmt.Errorf("unexpected value type of %T", attrValue)
+	}
+
+	stringValuable, diags := t.ValueFromString(ctx, stringValue)
+
+	if diags.HasError() {
+		return nil, fmt.Errorf("unexpected error converting StringValue to StringValuable: %v", diags)
+	}
+
+	return stringValuable, nil
+}
@@ -0,0 +1,282 @@
+// Copyright IBM Corp. 2023, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package timetypes
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/attr/xattr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+)
+
+var (
+	_ basetypes.StringValuableWithSemanticEquals = (*YearMonth)(nil)
+	_ xattr.ValidateableAttribute                = (*YearMonth)(nil)
+	_ function.ValidateableParameter             = (*YearMonth)(nil)
+)
+
+// YearMonth represents a valid ISO 8601 year and month string, such as `2026-10`, which is a four digit year and a
+// two digit month.
+//
+// See https://en.wikipedia.org/wiki/ISO_8601#Calendar_dates for more details on the string format.
+type YearMonth struct {
+	basetypes.StringValue
+}
+
+// Type returns a YearMonthType.
+func (v YearMonth) Type(_ context.Context) attr.Type {
+	return YearMonthType{}
+}
+
+// Equal returns true if the given value is equivalent.
+func (v YearMonth) Equal(o attr.Value) bool {
+	other, ok := o.(YearMonth)
+
+	if !ok {
+		return false
+	}
+
+	return v.StringValue.Equal(other.StringValue)
+}
+
+// StringSemanticEquals returns true if the given year and month string value is semantically equal to the current
+// year and month string value, which is when both values have the same year and month.
+func (v YearMonth) StringSemanticEquals(_ context.Context, newValuable basetypes.StringValuable) (bool, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	newValue, ok := newValuable.(YearMonth)
+	if !ok {
+		diags.AddError(
+			"Semantic Equality Check Error",
+			"An unexpected value type was received while performing semantic equality checks. "+
+				"Please report this to the provider developers.\n\n"+
+				"Expected Value Type: "+fmt.Sprintf("%T", v)+"\n"+
+				"Got Value Type: "+fmt.Sprintf("%T", newValuable),
+		)
+
+		return false, diags
+	}
+
+	// Year and month strings are already validated at this point, ignoring errors
+	currentYear, currentMonth, _ := parseYearMonth(v.ValueString())
+	newYear, newMonth, _ := parseYearMonth(newValue.ValueString())
+
+	return currentYear == newYear && currentMonth == newMonth, diags
+}
+
+// ValidateAttribute implements attribute value validation. This type requires the value to be a String value that
+// is a valid ISO 8601 year and month.
+func (v YearMonth) ValidateAttribute(ctx context.Context, req xattr.ValidateAttributeRequest, resp *xattr.ValidateAttributeResponse) {
+	if v.IsUnknown() || v.IsNull() {
+		return
+	}
+
+	if _, _, err := parseYearMonth(v.ValueString()); err != nil {
+		resp.Diagnostics.Append(diag.WithPath(req.Path, yearMonthInvalidStringDiagnostic(v.ValueString(), err)))
+
+		return
+	}
+}
+
+// ValidateParameter implements provider-defined function parameter value validation. This type requires the value to
+// be a String value that is a valid ISO 8601 year and month.
+func (v YearMonth) ValidateParameter(ctx context.Context, req function.ValidateParameterRequest, resp *function.ValidateParameterResponse) {
+	if v.IsUnknown() || v.IsNull() {
+		return
+	}
+
+	if _, _, err := parseYearMonth(v.ValueString()); err != nil {
+		resp.Error = function.NewArgumentFuncError(
+			req.Position,
+			"Invalid Year Month String Value: "+
+				"A string value was provided that is not valid ISO 8601 year and month string format, such as \"2026-10\".\n\n"+
+				"Given Value: "+v.ValueString()+"\n"+
+				"Error: "+err.Error(),
+		)
+
+		return
+	}
+}
+
+// ValueYearMonth returns the year and month of the YearMonth StringValue. A null or unknown value will produce an error
+// diagnostic.
+func (v YearMonth) ValueYearMonth() (int, time.Month, diag.Diagnostics) {
+	return v.valueYearMonth("ValueYearMonth")
+}
+
+// ValueFirstInstant creates a new time.Time instance with the first instant of the YearMonth StringValue, which is
+// midnight on the first day of the month in the given location. A nil location is treated as UTC. A null or unknown
+// value will produce an error diagnostic.
+func (v YearMonth) ValueFirstInstant(loc *time.Location) (time.Time, diag.Diagnostics) {
+	year, month, diags := v.valueYearMonth("ValueFirstInstant")
+	if diags.HasError() {
+		return time.Time{}, diags
+	}
+
+	if loc == nil {
+		loc = time.UTC
+	}
+
+	return time.Date(year, month, 1, 0, 0, 0, 0, loc), nil
+}
+
+// ValueLastInstant creates a new time.Time instance with the last instant of the YearMonth StringValue, which is one
+// nanosecond before midnight on the first day of the following month in the given location. A nil location is treated
+// as UTC. A null or unknown value will produce an error diagnostic.
+func (v YearMonth) ValueLastInstant(loc *time.Location) (time.Time, diag.Diagnostics) {
+	year, month, diags := v.valueYearMonth("ValueLastInstant")
+	if diags.HasError() {
+		return time.Time{}, diags
+	}
+
+	if loc == nil {
+		loc = time.UTC
+	}
+
+	return time.Date(year, month+1, 1, 0, 0, 0, 0, loc).Add(-time.Nanosecond), nil
+}
+
+// valueYearMonth returns the parsed YearMonth, with error diagnostics summarized by the given accessor method name.
+func (v YearMonth) valueYearMonth(accessor string) (int, time.Month, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	summary := "YearMonth " + accessor + " Error"
+
+	if v.IsNull() {
+		diags.Append(diag.NewErrorDiagnostic(summary, "Year month string value is null"))
+		return 0, 0, diags
+	}
+
+	if v.IsUnknown() {
+		diags.Append(diag.NewErrorDiagnostic(summary, "Year month string value is unknown"))
+		return 0, 0, diags
+	}
+
+	year, month, err := parseYearMonth(v.ValueString())
+	if err != nil {
+		diags.Append(diag.NewErrorDiagnostic(summary, err.Error()))
+		return 0, 0, diags
+	}
+
+	return year, month, nil
+}
+
+// NewYearMonthNull creates a YearMonth with a null value. Determine whether the value is null via IsNull method.
+func NewYearMonthNull() YearMonth {
+	return YearMonth{
+		StringValue: basetypes.NewStringNull(),
+	}
+}
+
+// NewYearMonthUnknown creates a YearMonth with an unknown value. Determine whether the value is unknown via IsUnknown method.
+func NewYearMonthUnknown() YearMonth {
+	return YearMonth{
+		StringValue: basetypes.NewStringUnknown(),
+	}
+}
+
+// NewYearMonthTimeValue creates a YearMonth with a known value. The year and month are taken from the time.Time in its
+// own location.
+func NewYearMonthTimeValue(value time.Time) YearMonth {
+	return YearMonth{
+		StringValue: basetypes.NewStringValue(value.Format("2006-01")),
+	}
+}
+
+// NewYearMonthTimePointerValue creates a YearMonth with a null value if nil or
+// a known value. The year and month are taken from the time.Time in its own
+// location.
+func NewYearMonthTimePointerValue(value *time.Time) YearMonth {
+	if value == nil {
+		return NewYearMonthNull()
+	}
+
+	return NewYearMonthTimeValue(*value)
+}
+
+// NewYearMonthValue creates a YearMonth with a known value or raises an error
+// diagnostic if the string is not ISO 8601 year and month format.
+func NewYearMonthValue(value string) (YearMonth, diag.Diagnostics) {
+	_, _, err := parseYearMonth(value)
+
+	if err != nil {
+		// Returning an unknown value will guarantee that, as a last resort,
+		// Terraform will return an error if attempting to store into state.
+		return NewYearMonthUnknown(), diag.Diagnostics{yearMonthInvalidStringDiagnostic(value, err)}
+	}
+
+	return YearMonth{
+		StringValue: basetypes.NewStringValue(value),
+	}, nil
+}
+
+// NewYearMonthValueMust creates a YearMonth with a known value or raises a panic
+// if the string is not ISO 8601 year and month format.
+//
+// This creation function is only recommended to create YearMonth values which
+// either will not potentially affect practitioners, such as testing, or within
+// exhaustively tested provider logic.
+func NewYearMonthValueMust(value string) YearMonth {
+	_, _, err := parseYearMonth(value)
+
+	if err != nil {
+		panic(fmt.Sprintf("Invalid Year Month String Value (%s): %s", value, err))
+	}
+
+	return YearMonth{
+		StringValue: basetypes.NewStringValue(value),
+	}
+}
+
+// NewYearMonthPointerValue creates a YearMonth with a null value if nil, a known
+// value, or raises an error diagnostic if the string is not ISO 8601 year and month format.
+func NewYearMonthPointerValue(value *string) (YearMonth, diag.Diagnostics) {
+	if value == nil {
+		return NewYearMonthNull(), nil
+	}
+
+	return NewYearMonthValue(*value)
+}
+
+// NewYearMonthPointerValueMust creates a YearMonth with a null value if nil, a
+// known value, or raises a panic if the string is not ISO 8601 year and month format.
+//
+// This creation function is only recommended to create YearMonth values which
+// either will not potentially affect practitioners, such as testing, or within
+// exhaustively tested provider logic.
+func NewYearMonthPointerValueMust(value *string) YearMonth {
+	if value == nil {
+		return NewYearMonthNull()
+	}
+
+	return NewYearMonthValueMust(*value)
+}
+
+// parseYearMonth parses an ISO 8601 year and month in the extended format, such as `2026-10`.
+func parseYearMonth(value string) (int, time.Month, error) {
+	year, rest, err := parseFixedDigits(value, 4, "year", 0, 9999)
+	if err != nil {
+		return 0, 0, err
+	}
+
+	if rest, err = parseSeparator(rest, '-', "year"); err != nil {
+		return 0, 0, err
+	}
+
+	month, rest, err := parseFixedDigits(rest, 2, "month", 1, 12)
+	if err != nil {
+		return 0, 0, err
+	}
+
+	if rest != "" {
+		return 0, 0, fmt.Errorf("unexpected text after month: %q", rest)
+	}
+
+	return year, time.Month(month), nil
+}
@@ -0,0 +1,34 @@
+// Copyright IBM Corp. 2023, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package timetypes_test
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework-timetypes/timetypes"
+)
+
+type BudgetResourceModel struct {
+	BillingPeriod timetypes.YearMonth `tfsdk:"billing_period"`
+}
+
+func ExampleYearMonth_ValueLastInstant() {
+	// For example purposes, typically the data model would be populated automatically by Plugin Framework via Config, Plan or State.
+	// https://developer.hashicorp.com/terraform/plugin/framework/handling-data/accessing-values
+	data := BudgetResourceModel{
+		BillingPeriod: timetypes.NewYearMonthValueMust("2026-10"),
+	}
+
+	// Check that the year month data is known and able to be converted to time.Time
+	if !data.BillingPeriod.IsNull() && !data.BillingPeriod.IsUnknown() {
+		t, diags := data.BillingPeriod.ValueLastInstant(time.UTC)
+		if diags.HasError() {
+			return
+		}
+
+		// Output: 2026-10-31T23:59:59.999999999Z
+		fmt.Println(t.Format(time.RFC3339Nano))
+	}
+}
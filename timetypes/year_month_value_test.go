@@ -0,0 +1,308 @@
+// Copyright IBM Corp. 2023, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package timetypes_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-framework/attr/xattr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+
+	"github.com/hashicorp/terraform-plugin-framework-timetypes/timetypes"
+)
+
+func TestYearMonth_StringSemanticEquals(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		currentYearMonth timetypes.YearMonth
+		givenYearMonth   basetypes.StringValuable
+		expectedMatch    bool
+		expectedDiags    diag.Diagnostics
+	}{
+		"not equal - different month": {
+			currentYearMonth: timetypes.NewYearMonthValueMust("2026-10"),
+			givenYearMonth:   timetypes.NewYearMonthValueMust("2026-11"),
+			expectedMatch:    false,
+		},
+		"not equal - different year": {
+			currentYearMonth: timetypes.NewYearMonthValueMust("2026-10"),
+			givenYearMonth:   timetypes.NewYearMonthValueMust("2027-10"),
+			expectedMatch:    false,
+		},
+		"semantically equal - byte for byte match": {
+			currentYearMonth: timetypes.NewYearMonthValueMust("2026-10"),
+			givenYearMonth:   timetypes.NewYearMonthValueMust("2026-10"),
+			expectedMatch:    true,
+		},
+		"error - not given YearMonth value": {
+			currentYearMonth: timetypes.NewYearMonthValueMust("2026-10"),
+			givenYearMonth:   basetypes.NewStringValue("2026-10"),
+			expectedMatch:    false,
+			expectedDiags: diag.Diagnostics{
+				diag.NewErrorDiagnostic(
+					"Semantic Equality Check Error",
+					"An unexpected value type was received while performing semantic equality checks. "+
+						"Please report this to the provider developers.\n\n"+
+						"Expected Value Type: timetypes.YearMonth\n"+
+						"Got Value Type: basetypes.StringValue",
+				),
+			},
+		},
+	}
+	for name, testCase := range testCases {
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			match, diags := testCase.currentYearMonth.StringSemanticEquals(context.Background(), testCase.givenYearMonth)
+
+			if testCase.expectedMatch != match {
+				t.Errorf("Expected StringSemanticEquals to return: %t, but got: %t", testCase.expectedMatch, match)
+			}
+
+			if diff := cmp.Diff(diags, testCase.expectedDiags); diff != "" {
+				t.Errorf("Unexpected diagnostics (-got, +expected): %s", diff)
+			}
+		})
+	}
+}
+
+func TestYearMonthValidateAttribute(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		yearMonth     timetypes.YearMonth
+		expectedDiags diag.Diagnostics
+	}{
+		"empty-struct": {
+			yearMonth: timetypes.YearMonth{},
+		},
+		"null": {
+			yearMonth: timetypes.NewYearMonthNull(),
+		},
+		"unknown": {
+			yearMonth: timetypes.NewYearMonthUnknown(),
+		},
+		"valid year month": {
+			yearMonth: timetypes.NewYearMonthValueMust("2026-10"),
+		},
+		"invalid year month - month 13": {
+			yearMonth: timetypes.YearMonth{
+				StringValue: basetypes.NewStringValue("2026-13"),
+			},
+			expectedDiags: diag.Diagnostics{
+				diag.NewAttributeErrorDiagnostic(
+					path.Root("test"),
+					"Invalid Year Month String Value",
+					"A string value was provided that is not valid ISO 8601 year and month string format, such as \"2026-10\".\n\n"+
+						"Given Value: 2026-13\n"+
+						"Error: month \"13\" is out of range [1, 12]",
+				),
+			},
+		},
+		"invalid year month - one digit month": {
+			yearMonth: timetypes.YearMonth{
+				StringValue: basetypes.NewStringValue("2026-1"),
+			},
+			expectedDiags: diag.Diagnostics{
+				diag.NewAttributeErrorDiagnostic(
+					path.Root("test"),
+					"Invalid Year Month String Value",
+					"A string value was provided that is not valid ISO 8601 year and month string format, such as \"2026-10\".\n\n"+
+						"Given Value: 2026-1\n"+
+						"Error: expected 2 digit month, got \"1\"",
+				),
+			},
+		},
+		"invalid year month - full date": {
+			yearMonth: timetypes.YearMonth{
+				StringValue: basetypes.NewStringValue("2026-10-16"),
+			},
+			expectedDiags: diag.Diagnostics{
+				diag.NewAttributeErrorDiagnostic(
+					path.Root("test"),
+					"Invalid Year Month String Value",
+					"A string value was provided that is not valid ISO 8601 year and month string format, such as \"2026-10\".\n\n"+
+						"Given Value: 2026-10-16\n"+
+						"Error: unexpected text after month: \"-16\"",
+				),
+			},
+		},
+	}
+	for name, testCase := range testCases {
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			resp := xattr.ValidateAttributeResponse{}
+
+			testCase.yearMonth.ValidateAttribute(
+				context.Background(),
+				xattr.ValidateAttributeRequest{
+					Path: path.Root("test"),
+				},
+				&resp,
+			)
+
+			if diff := cmp.Diff(resp.Diagnostics, testCase.expectedDiags); diff != "" {
+				t.Errorf("Unexpected diagnostics (-got, +expected): %s", diff)
+			}
+		})
+	}
+}
+
+func TestYearMonthValidateParameter(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		yearMonth       timetypes.YearMonth
+		expectedFuncErr *function.FuncError
+	}{
+		"empty-struct": {
+			yearMonth: timetypes.YearMonth{},
+		},
+		"null": {
+			yearMonth: timetypes.NewYearMonthNull(),
+		},
+		"unknown": {
+			yearMonth: timetypes.NewYearMonthUnknown(),
+		},
+		"valid year month": {
+			yearMonth: timetypes.NewYearMonthValueMust("2026-10"),
+		},
+		"invalid year month": {
+			yearMonth: timetypes.YearMonth{
+				StringValue: basetypes.NewStringValue("202610"),
+			},
+			expectedFuncErr: function.NewArgumentFuncError(
+				0,
+				"Invalid Year Month String Value: "+
+					"A string value was provided that is not valid ISO 8601 year and month string format, such as \"2026-10\".\n\n"+
+					"Given Value: 202610\n"+
+					"Error: expected '-' after year, got \"10\"",
+			),
+		},
+	}
+	for name, testCase := range testCases {
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			resp := function.ValidateParameterResponse{}
+
+			testCase.yearMonth.ValidateParameter(
+				context.Background(),
+				function.ValidateParameterRequest{
+					Position: int64(0),
+				},
+				&resp,
+			)
+
+			if diff := cmp.Diff(resp.Error, testCase.expectedFuncErr); diff != "" {
+				t.Errorf("Unexpected diagnostics (-got, +expected): %s", diff)
+			}
+		})
+	}
+}
+
+func TestYearMonth_ValueYearMonth(t *testing.T) {
+	t.Parallel()
+
+	year, month, diags := timetypes.NewYearMonthValueMust("2026-10").ValueYearMonth()
+	if diags.HasError() {
+		t.Fatalf("Unexpected diagnostics: %v", diags)
+	}
+
+	if year != 2026 || month != time.October {
+		t.Errorf("Unexpected year and month, got: %d %s, expected: 2026 October", year, month)
+	}
+}
+
+func TestYearMonth_ValueFirstInstant_ValueLastInstant(t *testing.T) {
+	t.Parallel()
+
+	berlin, err := time.LoadLocation("Europe/Berlin")
+	if err != nil {
+		t.Fatalf("Unexpected error loading location: %s", err)
+	}
+
+	testCases := map[string]struct {
+		yearMonth     timetypes.YearMonth
+		location      *time.Location
+		expectedFirst time.Time
+		expectedLast  time.Time
+		expectedDiags diag.Diagnostics
+	}{
+		"year month string value is null": {
+			yearMonth: timetypes.NewYearMonthNull(),
+			expectedDiags: diag.Diagnostics{
+				diag.NewErrorDiagnostic("YearMonth ValueFirstInstant Error", "Year month string value is null"),
+				diag.NewErrorDiagnostic("YearMonth ValueLastInstant Error", "Year month string value is null"),
+			},
+		},
+		"year month string value is unknown": {
+			yearMonth: timetypes.NewYearMonthUnknown(),
+			expectedDiags: diag.Diagnostics{
+				diag.NewErrorDiagnostic("YearMonth ValueFirstInstant Error", "Year month string value is unknown"),
+				diag.NewErrorDiagnostic("YearMonth ValueLastInstant Error", "Year month string value is unknown"),
+			},
+		},
+		"nil location": {
+			yearMonth:     timetypes.NewYearMonthValueMust("2028-02"),
+			expectedFirst: time.Date(2028, time.February, 1, 0, 0, 0, 0, time.UTC),
+			expectedLast:  time.Date(2028, time.February, 29, 23, 59, 59, 999999999, time.UTC),
+		},
+		"location across daylight saving time": {
+			yearMonth:     timetypes.NewYearMonthValueMust("2026-10"),
+			location:      berlin,
+			expectedFirst: time.Date(2026, time.October, 1, 0, 0, 0, 0, berlin),
+			expectedLast:  time.Date(2026, time.October, 31, 23, 59, 59, 999999999, berlin),
+		},
+		"end of year": {
+			yearMonth:     timetypes.NewYearMonthValueMust("2026-12"),
+			expectedFirst: time.Date(2026, time.December, 1, 0, 0, 0, 0, time.UTC),
+			expectedLast:  time.Date(2026, time.December, 31, 23, 59, 59, 999999999, time.UTC),
+		},
+	}
+	for name, testCase := range testCases {
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			first, diags := testCase.yearMonth.ValueFirstInstant(testCase.location)
+			last, lastDiags := testCase.yearMonth.ValueLastInstant(testCase.location)
+			diags.Append(lastDiags...)
+
+			if !first.Equal(testCase.expectedFirst) {
+				t.Errorf("Unexpected first instant, got: %s, expected: %s", first, testCase.expectedFirst)
+			}
+
+			if !last.Equal(testCase.expectedLast) {
+				t.Errorf("Unexpected last instant, got: %s, expected: %s", last, testCase.expectedLast)
+			}
+
+			if diff := cmp.Diff(diags, testCase.expectedDiags); diff != "" {
+				t.Errorf("Unexpected diagnostics (-got, +expected): %s", diff)
+			}
+		})
+	}
+}
+
+func TestNewYearMonthTimeValue(t *testing.T) {
+	t.Parallel()
+
+	got := timetypes.NewYearMonthTimeValue(time.Date(2026, time.October, 31, 23, 43, 16, 0, time.FixedZone("", -4*60*60)))
+	expected := timetypes.NewYearMonthValueMust("2026-10")
+
+	if !got.Equal(expected) {
+		t.Errorf("Expected %s, got %s", expected, got)
+	}
+}
@@ -0,0 +1,77 @@
+// Copyright IBM Corp. 2023, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package timetypes
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+var (
+	_ basetypes.StringTypable = (*YearQuarterType)(nil)
+)
+
+// YearQuarterType is an attribute type that represents a valid year and quarter string, such as `2026-Q4`. Semantic
+// equality logic is defined for YearQuarterType such that values with the same year and quarter are considered equal,
+// regardless of the case of the quarter designator.
+type YearQuarterType struct {
+	basetypes.StringType
+}
+
+// String returns a human-readable string of the type name.
+func (t YearQuarterType) String() string {
+	return "timetypes.YearQuarterType"
+}
+
+// ValueType returns the Value type.
+func (t YearQuarterType) ValueType(ctx context.Context) attr.Value {
+	return YearQuarter{}
+}
+
+// Equal returns true if the given type is equivalent.
+func (t YearQuarterType) Equal(o attr.Type) bool {
+	other, ok := o.(YearQuarterType)
+
+	if !ok {
+		return false
+	}
+
+	return t.StringType.Equal(other.StringType)
+}
+
+// ValueFromString returns a StringValuable type given a StringValue.
+func (t YearQuarterType) ValueFromString(ctx context.Context, in basetypes.StringValue) (basetypes.StringValuable, diag.Diagnostics) {
+	return YearQuarter{
+		StringValue: in,
+	}, nil
+}
+
+// ValueFromTerraform returns a Value given a tftypes.Value.  This is meant to convert the tftypes.Value into a more convenient Go type
+// for the provider to consume the data with.
+func (t YearQuarterType) ValueFromTerraform(ctx context.Context, in tftypes.Value) (attr.Value, error) {
+	attrValue, err := t.StringType.ValueFromTerraform(ctx, in)
+
+	if err != nil {
+		return nil, err
+	}
+
+	stringValue, ok := attrValue.(basetypes.StringValue)
+
+	if !ok {
+		return nil, fmt.Errorf("unexpected value type of %T", attrValue)
+	}
+
+	stringValuable, diags := t.ValueFromString(ctx, stringValue)
+
+	if diags.HasError() {
+		return nil, fmt.Errorf("unexpected error converting StringValue to StringValuable: %v", diags)
+	}
+
+	return stringValuable, nil
+}
@@ -0,0 +1,298 @@
+// Copyright IBM Corp. 2023, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package timetypes
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/attr/xattr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+)
+
+var (
+	_ basetypes.StringValuableWithSemanticEquals = (*YearQuarter)(nil)
+	_ xattr.ValidateableAttribute                = (*YearQuarter)(nil)
+	_ function.ValidateableParameter             = (*YearQuarter)(nil)
+)
+
+// YearQuarter represents a valid year and quarter string, such as `2026-Q4`, which is a four digit year and a
+// calendar quarter from Q1 (January to March) to Q4 (October to December). The quarter designator is
+// case-insensitive, such that `2026-q4` is also valid. Quarters are not defined by ISO 8601, but this format is
+// commonly used alongside ISO 8601 dates.
+type YearQuarter struct {
+	basetypes.StringValue
+}
+
+// Type returns a YearQuarterType.
+func (v YearQuarter) Type(_ context.Context) attr.Type {
+	return YearQuarterType{}
+}
+
+// Equal returns true if the given value is equivalent.
+func (v YearQuarter) Equal(o attr.Value) bool {
+	other, ok := o.(YearQuarter)
+
+	if !ok {
+		return false
+	}
+
+	return v.StringValue.Equal(other.StringValue)
+}
+
+// StringSemanticEquals returns true if the given year and quarter string value is semantically equal to the current
+// year and quarter string value, which is when both values have the same year and quarter.
+//
+// Examples:
+//   - `2026-Q4` is semantically equal to `2026-q4`
+func (v YearQuarter) StringSemanticEquals(_ context.Context, newValuable basetypes.StringValuable) (bool, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	newValue, ok := newValuable.(YearQuarter)
+	if !ok {
+		diags.AddError(
+			"Semantic Equality Check Error",
+			"An unexpected value type was received while performing semantic equality checks. "+
+				"Please report this to the provider developers.\n\n"+
+				"Expected Value Type: "+fmt.Sprintf("%T", v)+"\n"+
+				"Got Value Type: "+fmt.Sprintf("%T", newValuable),
+		)
+
+		return false, diags
+	}
+
+	// Year and quarter strings are already validated at this point, ignoring errors
+	currentYear, currentQuarter, _ := parseYearQuarter(v.ValueString())
+	newYear, newQuarter, _ := parseYearQuarter(newValue.ValueString())
+
+	return currentYear == newYear && currentQuarter == newQuarter, diags
+}
+
+// ValidateAttribute implements attribute value validation. This type requires the value to be a String value that
+// is a valid year and quarter.
+func (v YearQuarter) ValidateAttribute(ctx context.Context, req xattr.ValidateAttributeRequest, resp *xattr.ValidateAttributeResponse) {
+	if v.IsUnknown() || v.IsNull() {
+		return
+	}
+
+	if _, _, err := parseYearQuarter(v.ValueString()); err != nil {
+		resp.Diagnostics.Append(diag.WithPath(req.Path, yearQuarterInvalidStringDiagnostic(v.ValueString(), err)))
+
+		return
+	}
+}
+
+// ValidateParameter implements provider-defined function parameter value validation. This type requires the value to
+// be a String value that is a valid year and quarter.
+func (v YearQuarter) ValidateParameter(ctx context.Context, req function.ValidateParameterRequest, resp *function.ValidateParameterResponse) {
+	if v.IsUnknown() || v.IsNull() {
+		return
+	}
+
+	if _, _, err := parseYearQuarter(v.ValueString()); err != nil {
+		resp.Error = function.NewArgumentFuncError(
+			req.Position,
+			"Invalid Year Quarter String Value: "+
+				"A string value was provided that is not valid year and quarter string format, such as \"2026-Q4\".\n\n"+
+				"Given Value: "+v.ValueString()+"\n"+
+				"Error: "+err.Error(),
+		)
+
+		return
+	}
+}
+
+// ValueYearQuarter returns the year and quarter, from 1 to 4, of the YearQuarter StringValue. A null or unknown value
+// will produce an error diagnostic.
+func (v YearQuarter) ValueYearQuarter() (int, int, diag.Diagnostics) {
+	return v.valueYearQuarter("ValueYearQuarter")
+}
+
+// ValueFirstInstant creates a new time.Time instance with the first instant of the YearQuarter StringValue, which is
+// midnight on the first day of the quarter in the given location. A nil location is treated as UTC. A null or unknown
+// value will produce an error diagnostic.
+func (v YearQuarter) ValueFirstInstant(loc *time.Location) (time.Time, diag.Diagnostics) {
+	year, quarter, diags := v.valueYearQuarter("ValueFirstInstant")
+	if diags.HasError() {
+		return time.Time{}, diags
+	}
+
+	if loc == nil {
+		loc = time.UTC
+	}
+
+	return time.Date(year, yearQuarterFirstMonth(quarter), 1, 0, 0, 0, 0, loc), nil
+}
+
+// ValueLastInstant creates a new time.Time instance with the last instant of the YearQuarter StringValue, which is one
+// nanosecond before midnight on the first day of the following quarter in the given location. A nil location is treated
+// as UTC. A null or unknown value will produce an error diagnostic.
+func (v YearQuarter) ValueLastInstant(loc *time.Location) (time.Time, diag.Diagnostics) {
+	year, quarter, diags := v.valueYearQuarter("ValueLastInstant")
+	if diags.HasError() {
+		return time.Time{}, diags
+	}
+
+	if loc == nil {
+		loc = time.UTC
+	}
+
+	return time.Date(year, yearQuarterFirstMonth(quarter)+3, 1, 0, 0, 0, 0, loc).Add(-time.Nanosecond), nil
+}
+
+// valueYearQuarter returns the parsed YearQuarter, with error diagnostics summarized by the given accessor method name.
+func (v YearQuarter) valueYearQuarter(accessor string) (int, int, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	summary := "YearQuarter " + accessor + " Error"
+
+	if v.IsNull() {
+		diags.Append(diag.NewErrorDiagnostic(summary, "Year quarter string value is null"))
+		return 0, 0, diags
+	}
+
+	if v.IsUnknown() {
+		diags.Append(diag.NewErrorDiagnostic(summary, "Year quarter string value is unknown"))
+		return 0, 0, diags
+	}
+
+	year, quarter, err := parseYearQuarter(v.ValueString())
+	if err != nil {
+		diags.Append(diag.NewErrorDiagnostic(summary, err.Error()))
+		return 0, 0, diags
+	}
+
+	return year, quarter, nil
+}
+
+// NewYearQuarterNull creates a YearQuarter with a null value. Determine whether the value is null via IsNull method.
+func NewYearQuarterNull() YearQuarter {
+	return YearQuarter{
+		StringValue: basetypes.NewStringNull(),
+	}
+}
+
+// NewYearQuarterUnknown creates a YearQuarter with an unknown value. Determine whether the value is unknown via IsUnknown method.
+func NewYearQuarterUnknown() YearQuarter {
+	return YearQuarter{
+		StringValue: basetypes.NewStringUnknown(),
+	}
+}
+
+// NewYearQuarterTimeValue creates a YearQuarter with a known value, such as `2026-Q4`. The year and quarter are taken
+// from the time.Time in its own location.
+func NewYearQuarterTimeValue(value time.Time) YearQuarter {
+	return YearQuarter{
+		StringValue: basetypes.NewStringValue(fmt.Sprintf("%04d-Q%d", value.Year(), (int(value.Month())-1)/3+1)),
+	}
+}
+
+// NewYearQuarterTimePointerValue creates a YearQuarter with a null value if nil or
+// a known value, such as `2026-Q4`. The year and quarter are taken from the
+// time.Time in its own location.
+func NewYearQuarterTimePointerValue(value *time.Time) YearQuarter {
+	if value == nil {
+		return NewYearQuarterNull()
+	}
+
+	return NewYearQuarterTimeValue(*value)
+}
+
+// NewYearQuarterValue creates a YearQuarter with a known value or raises an error
+// diagnostic if the string is not year and quarter format.
+func NewYearQuarterValue(value string) (YearQuarter, diag.Diagnostics) {
+	_, _, err := parseYearQuarter(value)
+
+	if err != nil {
+		// Returning an unknown value will guarantee that, as a last resort,
+		// Terraform will return an error if attempting to store into state.
+		return NewYearQuarterUnknown(), diag.Diagnostics{yearQuarterInvalidStringDiagnostic(value, err)}
+	}
+
+	return YearQuarter{
+		StringValue: basetypes.NewStringValue(value),
+	}, nil
+}
+
+// NewYearQuarterValueMust creates a YearQuarter with a known value or raises a panic
+// if the string is not year and quarter format.
+//
+// This creation function is only recommended to create YearQuarter values which
+// either will not potentially affect practitioners, such as testing, or within
+// exhaustively tested provider logic.
+func NewYearQuarterValueMust(value string) YearQuarter {
+	_, _, err := parseYearQuarter(value)
+
+	if err != nil {
+		panic(fmt.Sprintf("Invalid Year Quarter String Value (%s): %s", value, err))
+	}
+
+	return YearQuarter{
+		StringValue: basetypes.NewStringValue(value),
+	}
+}
+
+// NewYearQuarterPointerValue creates a YearQuarter with a null value if nil, a known
+// value, or raises an error diagnostic if the string is not year and quarter format.
+func NewYearQuarterPointerValue(value *string) (YearQuarter, diag.Diagnostics) {
+	if value == nil {
+		return NewYearQuarterNull(), nil
+	}
+
+	return NewYearQuarterValue(*value)
+}
+
+// NewYearQuarterPointerValueMust creates a YearQuarter with a null value if nil, a
+// known value, or raises a panic if the string is not year and quarter format.
+//
+// This creation function is only recommended to create YearQuarter values which
+// either will not potentially affect practitioners, such as testing, or within
+// exhaustively tested provider logic.
+func NewYearQuarterPointerValueMust(value *string) YearQuarter {
+	if value == nil {
+		return NewYearQuarterNull()
+	}
+
+	return NewYearQuarterValueMust(*value)
+}
+
+// parseYearQuarter parses a year and quarter, such as `2026-Q4`, returning the year and the quarter from 1 to 4.
+func parseYearQuarter(value string) (int, int, error) {
+	year, rest, err := parseFixedDigits(value, 4, "year", 0, 9999)
+	if err != nil {
+		return 0, 0, err
+	}
+
+	if rest, err = parseSeparator(rest, '-', "year"); err != nil {
+		return 0, 0, err
+	}
+
+	if rest != "" && rest[0] == 'q' {
+		rest = "Q" + rest[1:]
+	}
+
+	if rest, err = parseSeparator(rest, 'Q', `"-"`); err != nil {
+		return 0, 0, err
+	}
+
+	quarter, rest, err := parseFixedDigits(rest, 1, "quarter", 1, 4)
+	if err != nil {
+		return 0, 0, err
+	}
+
+	if rest != "" {
+		return 0, 0, fmt.Errorf("unexpected text after quarter: %q", rest)
+	}
+
+	return year, quarter, nil
+}
+
+// yearQuarterFirstMonth returns the first month of the given quarter from 1 to 4.
+func yearQuarterFirstMonth(quarter int) time.Month {
+	return time.Month((quarter-1)*3 + 1)
+}
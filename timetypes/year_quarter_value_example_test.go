@@ -0,0 +1,34 @@
+// Copyright IBM Corp. 2023, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package timetypes_test
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework-timetypes/timetypes"
+)
+
+type FiscalReportResourceModel struct {
+	Quarter timetypes.YearQuarter `tfsdk:"quarter"`
+}
+
+func ExampleYearQuarter_ValueFirstInstant() {
+	// For example purposes, typically the data model would be populated automatically by Plugin Framework via Config, Plan or State.
+	// https://developer.hashicorp.com/terraform/plugin/framework/handling-data/accessing-values
+	data := FiscalReportResourceModel{
+		Quarter: timetypes.NewYearQuarterValueMust("2026-Q4"),
+	}
+
+	// Check that the year quarter data is known and able to be converted to time.Time
+	if !data.Quarter.IsNull() && !data.Quarter.IsUnknown() {
+		t, diags := data.Quarter.ValueFirstInstant(time.UTC)
+		if diags.HasError() {
+			return
+		}
+
+		// Output: 2026-10-01T00:00:00Z
+		fmt.Println(t.Format(time.RFC3339))
+	}
+}
@@ -0,0 +1,308 @@
+// Copyright IBM Corp. 2023, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package timetypes_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-framework/attr/xattr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+
+	"github.com/hashicorp/terraform-plugin-framework-timetypes/timetypes"
+)
+
+func TestYearQuarter_StringSemanticEquals(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		currentYearQuarter timetypes.YearQuarter
+		givenYearQuarter   basetypes.StringValuable
+		expectedMatch      bool
+		expectedDiags      diag.Diagnostics
+	}{
+		"not equal - different quarter": {
+			currentYearQuarter: timetypes.NewYearQuarterValueMust("2026-Q4"),
+			givenYearQuarter:   timetypes.NewYearQuarterValueMust("2026-Q3"),
+			expectedMatch:      false,
+		},
+		"not equal - different year": {
+			currentYearQuarter: timetypes.NewYearQuarterValueMust("2026-Q4"),
+			givenYearQuarter:   timetypes.NewYearQuarterValueMust("2027-Q4"),
+			expectedMatch:      false,
+		},
+		"semantically equal - byte for byte match": {
+			currentYearQuarter: timetypes.NewYearQuarterValueMust("2026-Q4"),
+			givenYearQuarter:   timetypes.NewYearQuarterValueMust("2026-Q4"),
+			expectedMatch:      true,
+		},
+		"semantically equal - lower case quarter designator": {
+			currentYearQuarter: timetypes.NewYearQuarterValueMust("2026-Q4"),
+			givenYearQuarter:   timetypes.NewYearQuarterValueMust("2026-q4"),
+			expectedMatch:      true,
+		},
+		"error - not given YearQuarter value": {
+			currentYearQuarter: timetypes.NewYearQuarterValueMust("2026-Q4"),
+			givenYearQuarter:   basetypes.NewStringValue("2026-Q4"),
+			expectedMatch:      false,
+			expectedDiags: diag.Diagnostics{
+				diag.NewErrorDiagnostic(
+					"Semantic Equality Check Error",
+					"An unexpected value type was received while performing semantic equality checks. "+
+						"Please report this to the provider developers.\n\n"+
+						"Expected Value Type: timetypes.YearQuarter\n"+
+						"Got Value Type: basetypes.StringValue",
+				),
+			},
+		},
+	}
+	for name, testCase := range testCases {
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			match, diags := testCase.currentYearQuarter.StringSemanticEquals(context.Background(), testCase.givenYearQuarter)
+
+			if testCase.expectedMatch != match {
+				t.Errorf("Expected StringSemanticEquals to return: %t, but got: %t", testCase.expectedMatch, match)
+			}
+
+			if diff := cmp.Diff(diags, testCase.expectedDiags); diff != "" {
+				t.Errorf("Unexpected diagnostics (-got, +expected): %s", diff)
+			}
+		})
+	}
+}
+
+func TestYearQuarterValidateAttribute(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		yearQuarter   timetypes.YearQuarter
+		expectedDiags diag.Diagnostics
+	}{
+		"empty-struct": {
+			yearQuarter: timetypes.YearQuarter{},
+		},
+		"null": {
+			yearQuarter: timetypes.NewYearQuarterNull(),
+		},
+		"unknown": {
+			yearQuarter: timetypes.NewYearQuarterUnknown(),
+		},
+		"valid year quarter": {
+			yearQuarter: timetypes.NewYearQuarterValueMust("2026-Q4"),
+		},
+		"invalid year quarter - quarter 5": {
+			yearQuarter: timetypes.YearQuarter{
+				StringValue: basetypes.NewStringValue("2026-Q5"),
+			},
+			expectedDiags: diag.Diagnostics{
+				diag.NewAttributeErrorDiagnostic(
+					path.Root("test"),
+					"Invalid Year Quarter String Value",
+					"A string value was provided that is not valid year and quarter string format, such as \"2026-Q4\".\n\n"+
+						"Given Value: 2026-Q5\n"+
+						"Error: quarter \"5\" is out of range [1, 4]",
+				),
+			},
+		},
+		"invalid year quarter - missing designator": {
+			yearQuarter: timetypes.YearQuarter{
+				StringValue: basetypes.NewStringValue("2026-4"),
+			},
+			expectedDiags: diag.Diagnostics{
+				diag.NewAttributeErrorDiagnostic(
+					path.Root("test"),
+					"Invalid Year Quarter String Value",
+					"A string value was provided that is not valid year and quarter string format, such as \"2026-Q4\".\n\n"+
+						"Given Value: 2026-4\n"+
+						"Error: expected 'Q' after \"-\", got \"4\"",
+				),
+			},
+		},
+		"invalid year quarter - trailing text": {
+			yearQuarter: timetypes.YearQuarter{
+				StringValue: basetypes.NewStringValue("2026-Q4-10"),
+			},
+			expectedDiags: diag.Diagnostics{
+				diag.NewAttributeErrorDiagnostic(
+					path.Root("test"),
+					"Invalid Year Quarter String Value",
+					"A string value was provided that is not valid year and quarter string format, such as \"2026-Q4\".\n\n"+
+						"Given Value: 2026-Q4-10\n"+
+						"Error: unexpected text after quarter: \"-10\"",
+				),
+			},
+		},
+	}
+	for name, testCase := range testCases {
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			resp := xattr.ValidateAttributeResponse{}
+
+			testCase.yearQuarter.ValidateAttribute(
+				context.Background(),
+				xattr.ValidateAttributeRequest{
+					Path: path.Root("test"),
+				},
+				&resp,
+			)
+
+			if diff := cmp.Diff(resp.Diagnostics, testCase.expectedDiags); diff != "" {
+				t.Errorf("Unexpected diagnostics (-got, +expected): %s", diff)
+			}
+		})
+	}
+}
+
+func TestYearQuarterValidateParameter(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		yearQuarter     timetypes.YearQuarter
+		expectedFuncErr *function.FuncError
+	}{
+		"empty-struct": {
+			yearQuarter: timetypes.YearQuarter{},
+		},
+		"null": {
+			yearQuarter: timetypes.NewYearQuarterNull(),
+		},
+		"unknown": {
+			yearQuarter: timetypes.NewYearQuarterUnknown(),
+		},
+		"valid year quarter": {
+			yearQuarter: timetypes.NewYearQuarterValueMust("2026-Q4"),
+		},
+		"invalid year quarter": {
+			yearQuarter: timetypes.YearQuarter{
+				StringValue: basetypes.NewStringValue("Q4-2026"),
+			},
+			expectedFuncErr: function.NewArgumentFuncError(
+				0,
+				"Invalid Year Quarter String Value: "+
+					"A string value was provided that is not valid year and quarter string format, such as \"2026-Q4\".\n\n"+
+					"Given Value: Q4-2026\n"+
+					"Error: expected 4 digit year, got \"Q4-2\"",
+			),
+		},
+	}
+	for name, testCase := range testCases {
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			resp := function.ValidateParameterResponse{}
+
+			testCase.yearQuarter.ValidateParameter(
+				context.Background(),
+				function.ValidateParameterRequest{
+					Position: int64(0),
+				},
+				&resp,
+			)
+
+			if diff := cmp.Diff(resp.Error, testCase.expectedFuncErr); diff != "" {
+				t.Errorf("Unexpected diagnostics (-got, +expected): %s", diff)
+			}
+		})
+	}
+}
+
+func TestYearQuarter_ValueYearQuarter(t *testing.T) {
+	t.Parallel()
+
+	year, quarter, diags := timetypes.NewYearQuarterValueMust("2026-q4").ValueYearQuarter()
+	if diags.HasError() {
+		t.Fatalf("Unexpected diagnostics: %v", diags)
+	}
+
+	if year != 2026 || quarter != 4 {
+		t.Errorf("Unexpected year and quarter, got: %d-Q%d, expected: 2026-Q4", year, quarter)
+	}
+}
+
+func TestYearQuarter_ValueFirstInstant_ValueLastInstant(t *testing.T) {
+	t.Parallel()
+
+	berlin, err := time.LoadLocation("Europe/Berlin")
+	if err != nil {
+		t.Fatalf("Unexpected error loading location: %s", err)
+	}
+
+	testCases := map[string]struct {
+		yearQuarter   timetypes.YearQuarter
+		location      *time.Location
+		expectedFirst time.Time
+		expectedLast  time.Time
+		expectedDiags diag.Diagnostics
+	}{
+		"year quarter string value is null": {
+			yearQuarter: timetypes.NewYearQuarterNull(),
+			expectedDiags: diag.Diagnostics{
+				diag.NewErrorDiagnostic("YearQuarter ValueFirstInstant Error", "Year quarter string value is null"),
+				diag.NewErrorDiagnostic("YearQuarter ValueLastInstant Error", "Year quarter string value is null"),
+			},
+		},
+		"year quarter string value is unknown": {
+			yearQuarter: timetypes.NewYearQuarterUnknown(),
+			expectedDiags: diag.Diagnostics{
+				diag.NewErrorDiagnostic("YearQuarter ValueFirstInstant Error", "Year quarter string value is unknown"),
+				diag.NewErrorDiagnostic("YearQuarter ValueLastInstant Error", "Year quarter string value is unknown"),
+			},
+		},
+		"first quarter - nil location": {
+			yearQuarter:   timetypes.NewYearQuarterValueMust("2026-Q1"),
+			expectedFirst: time.Date(2026, time.January, 1, 0, 0, 0, 0, time.UTC),
+			expectedLast:  time.Date(2026, time.March, 31, 23, 59, 59, 999999999, time.UTC),
+		},
+		"last quarter - location": {
+			yearQuarter:   timetypes.NewYearQuarterValueMust("2026-Q4"),
+			location:      berlin,
+			expectedFirst: time.Date(2026, time.October, 1, 0, 0, 0, 0, berlin),
+			expectedLast:  time.Date(2026, time.December, 31, 23, 59, 59, 999999999, berlin),
+		},
+	}
+	for name, testCase := range testCases {
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			first, diags := testCase.yearQuarter.ValueFirstInstant(testCase.location)
+			last, lastDiags := testCase.yearQuarter.ValueLastInstant(testCase.location)
+			diags.Append(lastDiags...)
+
+			if !first.Equal(testCase.expectedFirst) {
+				t.Errorf("Unexpected first instant, got: %s, expected: %s", first, testCase.expectedFirst)
+			}
+
+			if !last.Equal(testCase.expectedLast) {
+				t.Errorf("Unexpected last instant, got: %s, expected: %s", last, testCase.expectedLast)
+			}
+
+			if diff := cmp.Diff(diags, testCase.expectedDiags); diff != "" {
+				t.Errorf("Unexpected diagnostics (-got, +expected): %s", diff)
+			}
+		})
+	}
+}
+
+func TestNewYearQuarterTimeValue(t *testing.T) {
+	t.Parallel()
+
+	got := timetypes.NewYearQuarterTimeValue(time.Date(2026, time.September, 30, 23, 43, 16, 0, time.FixedZone("", -4*60*60)))
+	expected := timetypes.NewYearQuarterValueMust("2026-Q3")
+
+	if !got.Equal(expected) {
+		t.Errorf("Expected %s, got %s", expected, got)
+	}
+}